@@ -0,0 +1,7 @@
+data "gitlab_application_settings" "this" {}
+
+resource "gitlab_project" "this" {
+  name             = "example"
+  default_branch   = data.gitlab_application_settings.this.default_branch_name
+  visibility_level = contains(data.gitlab_application_settings.this.restricted_visibility_levels, "internal") ? "private" : "internal"
+}
@@ -0,0 +1,12 @@
+data "gitlab_cluster_agents" "this" {
+  project = "foo/bar"
+}
+
+# Create a token for each registered agent
+resource "gitlab_cluster_agent_token" "this" {
+  for_each = { for agent in data.gitlab_cluster_agents.this.cluster_agents : agent.name => agent }
+
+  project  = "foo/bar"
+  agent_id = each.value.agent_id
+  name     = "${each.key}-token"
+}
@@ -0,0 +1,9 @@
+data "gitlab_current_user" "this" {}
+
+# Require an approval of the automation identity
+resource "gitlab_project_approval_rule" "automation" {
+  project            = "foo/bar"
+  name               = "Automation"
+  approvals_required = 1
+  user_ids           = [data.gitlab_current_user.this.user_id]
+}
@@ -0,0 +1,11 @@
+data "gitlab_group_access_tokens" "this" {
+  group = "foo"
+}
+
+# Active access tokens expiring within the next 30 days
+output "expiring_access_tokens" {
+  value = [
+    for token in data.gitlab_group_access_tokens.this.access_tokens : token.name
+    if token.active && token.expires_at != "" && timecmp("${token.expires_at}T00:00:00Z", timeadd(plantimestamp(), "720h")) < 0
+  ]
+}
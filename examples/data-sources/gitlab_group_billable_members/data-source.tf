@@ -0,0 +1,15 @@
+data "gitlab_group_billable_members" "this" {
+  group = "foo"
+}
+
+output "billable_seats" {
+  value = length(data.gitlab_group_billable_members.this.billable_members)
+}
+
+# Billable members which have not been active for the last 90 days
+output "inactive_billable_members" {
+  value = [
+    for member in data.gitlab_group_billable_members.this.billable_members : member.username
+    if member.last_activity_on == "" || timecmp("${member.last_activity_on}T00:00:00Z", timeadd(plantimestamp(), "-2160h")) < 0
+  ]
+}
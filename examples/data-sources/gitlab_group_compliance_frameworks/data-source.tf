@@ -0,0 +1,13 @@
+data "gitlab_group_compliance_frameworks" "this" {
+  group = "foo"
+}
+
+locals {
+  compliance_framework_ids = {
+    for framework in data.gitlab_group_compliance_frameworks.this.compliance_frameworks : framework.name => framework.id
+  }
+}
+
+output "sox_compliance_framework_id" {
+  value = local.compliance_framework_ids["SOX"]
+}
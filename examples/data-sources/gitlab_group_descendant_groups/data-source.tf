@@ -0,0 +1,19 @@
+data "gitlab_group_descendant_groups" "example" {
+  group = "foo/bar"
+}
+
+# Only the groups the authenticated user maintains, excluding an archive group.
+data "gitlab_group_descendant_groups" "maintained" {
+  group            = "foo/bar"
+  skip_groups      = [42]
+  min_access_level = "maintainer"
+}
+
+# Fan out resources across the whole group tree
+resource "gitlab_group_label" "triage" {
+  for_each = { for g in data.gitlab_group_descendant_groups.example.groups : g.full_path => g.group_id }
+
+  group = each.value
+  name  = "triage"
+  color = "#ff0000"
+}
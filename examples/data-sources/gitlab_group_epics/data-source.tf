@@ -0,0 +1,11 @@
+data "gitlab_group_epics" "roadmap" {
+  group  = "foo/bar"
+  state  = "opened"
+  labels = ["roadmap"]
+}
+
+data "gitlab_group_epics" "search" {
+  group                     = "foo/bar"
+  search                    = "migration"
+  include_descendant_groups = false
+}
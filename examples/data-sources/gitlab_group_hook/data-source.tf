@@ -0,0 +1,8 @@
+data "gitlab_group" "example" {
+  full_path = "foo/bar/baz"
+}
+
+data "gitlab_group_hook" "example" {
+  group   = data.gitlab_group.example.id
+  hook_id = 1
+}
@@ -0,0 +1,7 @@
+data "gitlab_group" "example" {
+  full_path = "foo/bar/baz"
+}
+
+data "gitlab_group_hooks" "examples" {
+  group = data.gitlab_group.example.id
+}
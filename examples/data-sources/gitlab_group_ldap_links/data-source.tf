@@ -0,0 +1,3 @@
+data "gitlab_group_ldap_links" "example" {
+  group = "foo/bar"
+}
@@ -0,0 +1,14 @@
+# Direct members and pending invitations of a group
+data "gitlab_group_members" "example" {
+  group = "foo/bar"
+}
+
+# All members, including those inherited from ancestor groups
+data "gitlab_group_members" "all" {
+  group     = "foo/bar"
+  inherited = true
+}
+
+output "inherited_owners" {
+  value = [for m in data.gitlab_group_members.all.members : m.username if m.access_level == "owner" && m.membership_type == "inherited"]
+}
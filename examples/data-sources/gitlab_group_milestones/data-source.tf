@@ -0,0 +1,10 @@
+data "gitlab_group_milestones" "example" {
+  group = "foo"
+  state = "active"
+}
+
+# Look up milestones by their internal IDs
+data "gitlab_group_milestones" "by_iid" {
+  group = "foo"
+  iids  = [1, 2]
+}
@@ -0,0 +1,13 @@
+# All projects in the group tree, excluding projects shared with it
+data "gitlab_group_projects" "example" {
+  group             = "foo/bar"
+  with_shared       = false
+  include_subgroups = true
+  archived          = false
+}
+
+# Projects in the group and shared with it, matching a search
+data "gitlab_group_projects" "search" {
+  group  = "foo/bar"
+  search = "api"
+}
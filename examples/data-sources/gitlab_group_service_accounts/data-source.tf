@@ -0,0 +1,14 @@
+data "gitlab_group_service_accounts" "example" {
+  group          = "my-group"
+  include_tokens = true
+}
+
+# Active tokens of the service accounts expiring within the next 30 days
+output "expiring_tokens" {
+  value = flatten([
+    for sa in data.gitlab_group_service_accounts.example.service_accounts : [
+      for token in sa.tokens : "${sa.username}/${token.name}"
+      if token.active && timecmp("${token.expires_at}T00:00:00Z", timeadd(timestamp(), "720h")) < 0
+    ]
+  ])
+}
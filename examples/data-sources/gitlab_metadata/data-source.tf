@@ -0,0 +1,15 @@
+data "gitlab_metadata" "this" {}
+
+# Only manage EE-only resources on Enterprise Edition instances
+resource "gitlab_project_approval_rule" "this" {
+  count = data.gitlab_metadata.this.enterprise ? 1 : 0
+
+  project            = "foo/bar"
+  name               = "Security"
+  approvals_required = 1
+}
+
+# Configure the agent installation without hardcoding the KAS URL
+output "kas_address" {
+  value = data.gitlab_metadata.this.kas[0].external_url
+}
@@ -0,0 +1,12 @@
+data "gitlab_namespace" "this" {
+  full_path = "foo/bar"
+}
+
+resource "gitlab_project" "this" {
+  name         = "example"
+  namespace_id = data.gitlab_namespace.this.namespace_id
+}
+
+output "is_group_namespace" {
+  value = data.gitlab_namespace.this.kind == "group"
+}
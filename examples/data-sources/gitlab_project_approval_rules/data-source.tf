@@ -0,0 +1,18 @@
+data "gitlab_project_approval_rules" "example" {
+  project = "foo/bar"
+}
+
+# Fail the plan if the security approval rule is missing or too lax
+locals {
+  security_rules = [
+    for rule in data.gitlab_project_approval_rules.example.approval_rules : rule
+    if rule.name == "security"
+  ]
+}
+
+check "security_approvals" {
+  assert {
+    condition     = length(local.security_rules) == 1 && local.security_rules[0].approvals_required >= 2
+    error_message = "The security approval rule must require at least 2 approvals."
+  }
+}
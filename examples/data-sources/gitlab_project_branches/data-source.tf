@@ -0,0 +1,14 @@
+# All branches of a project
+data "gitlab_project_branches" "example" {
+  project = "foo/bar/baz"
+}
+
+# Only the release branches
+data "gitlab_project_branches" "releases" {
+  project = "foo/bar/baz"
+  regex   = "^release/[0-9]+\\.[0-9]+$"
+}
+
+locals {
+  has_develop_branch = contains([for b in data.gitlab_project_branches.example.branches : b.name], "develop")
+}
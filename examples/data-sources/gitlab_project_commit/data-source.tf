@@ -0,0 +1,10 @@
+data "gitlab_project_commit" "this" {
+  project = "foo/bar"
+  ref     = "main"
+}
+
+resource "gitlab_project_tag" "release" {
+  project = "foo/bar"
+  name    = "release-${data.gitlab_project_commit.this.short_id}"
+  ref     = data.gitlab_project_commit.this.sha
+}
@@ -0,0 +1,10 @@
+# The latest commit changing the `docs` directory
+data "gitlab_project_commits" "docs" {
+  project  = "foo/bar"
+  ref_name = "main"
+  path     = "docs"
+}
+
+output "docs_cache_key" {
+  value = data.gitlab_project_commits.docs.commits[0].short_id
+}
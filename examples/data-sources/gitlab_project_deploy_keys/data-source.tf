@@ -0,0 +1,7 @@
+data "gitlab_project_deploy_keys" "this" {
+  project = "foo/bar"
+}
+
+output "deploy_keys_with_write_access" {
+  value = [for key in data.gitlab_project_deploy_keys.this.deploy_keys : key.title if key.can_push]
+}
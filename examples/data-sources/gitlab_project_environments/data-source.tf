@@ -0,0 +1,14 @@
+data "gitlab_project_environments" "this" {
+  project = "foo/bar"
+  states  = "available"
+}
+
+# Create an environment-scoped variable for each environment
+resource "gitlab_project_variable" "deploy_target" {
+  for_each = { for env in data.gitlab_project_environments.this.environments : env.name => env }
+
+  project           = "foo/bar"
+  key               = "DEPLOY_TARGET"
+  value             = each.value.external_url
+  environment_scope = each.key
+}
@@ -6,3 +6,11 @@ data "gitlab_project_issues" "all_with_foo" {
   project = data.gitlab_project.foo.id
   search  = "foo"
 }
+
+data "gitlab_project_issues" "open_bugs_in_milestone" {
+  project           = data.gitlab_project.foo.id
+  state             = "opened"
+  labels            = ["bug"]
+  milestone         = "v1.0"
+  assignee_username = "jane"
+}
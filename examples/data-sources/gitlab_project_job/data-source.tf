@@ -0,0 +1,10 @@
+# Locate the artifacts of the latest successful `build` job on `main`
+data "gitlab_project_job" "build" {
+  project = "foo/bar"
+  ref     = "main"
+  name    = "build"
+}
+
+output "build_artifacts_url" {
+  value = data.gitlab_project_job.build.artifacts_download_url
+}
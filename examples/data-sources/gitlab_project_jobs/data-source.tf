@@ -0,0 +1,4 @@
+data "gitlab_project_jobs" "failed" {
+  project = "foo/bar"
+  scope   = ["failed", "canceled"]
+}
@@ -0,0 +1,7 @@
+data "gitlab_project_languages" "this" {
+  project = "foo/bar"
+}
+
+output "is_go_project" {
+  value = lookup(data.gitlab_project_languages.this.languages, "Go", 0) > 50
+}
@@ -0,0 +1,18 @@
+# Direct members of a project
+data "gitlab_project_members" "direct" {
+  project = "foo/bar"
+}
+
+# All members allowed to approve merge requests, including inherited ones
+data "gitlab_project_members" "maintainers" {
+  project          = "foo/bar"
+  inherited        = true
+  min_access_level = "maintainer"
+}
+
+resource "gitlab_project_approval_rule" "maintainers" {
+  project            = "foo/bar"
+  name               = "Maintainers"
+  approvals_required = 1
+  user_ids           = [for m in data.gitlab_project_members.maintainers.members : m.id]
+}
@@ -0,0 +1,13 @@
+data "gitlab_project_merge_request" "example" {
+  project = "foo/bar"
+  iid     = 42
+}
+
+# Only deploy once the merge request has been merged
+resource "null_resource" "deploy" {
+  count = data.gitlab_project_merge_request.example.state == "merged" ? 1 : 0
+
+  triggers = {
+    merge_commit_sha = data.gitlab_project_merge_request.example.merge_commit_sha
+  }
+}
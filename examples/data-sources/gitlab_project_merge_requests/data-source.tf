@@ -0,0 +1,10 @@
+data "gitlab_project_merge_requests" "example" {
+  project       = "foo/bar"
+  state         = "opened"
+  target_branch = "main"
+  labels        = ["release"]
+}
+
+output "mergeable_merge_requests" {
+  value = [for mr in data.gitlab_project_merge_requests.example.merge_requests : mr.web_url if mr.detailed_merge_status == "mergeable"]
+}
@@ -0,0 +1,14 @@
+data "gitlab_project_milestones" "example" {
+  project = "foo/bar"
+  state   = "active"
+}
+
+# Look up a milestone by its title
+data "gitlab_project_milestones" "release" {
+  project = "foo/bar"
+  title   = "v1.0"
+}
+
+output "release_milestone_id" {
+  value = data.gitlab_project_milestones.release.milestones[0].milestone_id
+}
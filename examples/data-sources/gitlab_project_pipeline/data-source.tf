@@ -0,0 +1,15 @@
+# By ID
+data "gitlab_project_pipeline" "example" {
+  project     = "foo/bar"
+  pipeline_id = 42
+}
+
+# Latest pipeline for a ref
+data "gitlab_project_pipeline" "latest" {
+  project = "foo/bar"
+  ref     = "main"
+}
+
+output "build_artifacts_url" {
+  value = [for job in data.gitlab_project_pipeline.latest.jobs : "${job.web_url}/artifacts/download" if job.name == "build" && length(job.artifacts) > 0]
+}
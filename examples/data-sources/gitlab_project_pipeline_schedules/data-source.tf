@@ -0,0 +1,11 @@
+data "gitlab_project_pipeline_schedules" "this" {
+  project = "foo/bar"
+}
+
+# Active pipeline schedules owned by users which are no longer active
+output "orphaned_pipeline_schedules" {
+  value = [
+    for schedule in data.gitlab_project_pipeline_schedules.this.pipeline_schedules : schedule.description
+    if schedule.active && schedule.owner_state != "active"
+  ]
+}
@@ -0,0 +1,11 @@
+data "gitlab_project_pipelines" "example" {
+  project = "foo/bar"
+  ref     = "main"
+  status  = "success"
+}
+
+# Locate the artifacts of the last successful pipeline
+data "gitlab_project_pipeline" "last_successful" {
+  project     = "foo/bar"
+  pipeline_id = data.gitlab_project_pipelines.example.pipelines[0].id
+}
@@ -0,0 +1,3 @@
+data "gitlab_project_protected_tags" "example" {
+  project = "foo/bar/baz"
+}
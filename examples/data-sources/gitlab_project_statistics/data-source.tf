@@ -0,0 +1,8 @@
+data "gitlab_project_statistics" "this" {
+  project = "foo/bar"
+}
+
+# Flag projects using more than 10 GiB of storage
+output "exceeds_storage_quota" {
+  value = data.gitlab_project_statistics.this.storage_size > 10 * 1024 * 1024 * 1024
+}
@@ -7,3 +7,15 @@ data "gitlab_project_tags" "example" {
 data "gitlab_project_tags" "example" {
   project = "foo/bar"
 }
+
+# Latest semantic version tag
+data "gitlab_project_tags" "latest" {
+  project  = "foo/bar"
+  order_by = "version"
+  sort     = "desc"
+  search   = "^v"
+}
+
+output "latest_version" {
+  value = data.gitlab_project_tags.latest.tags[0].name
+}
@@ -15,3 +15,23 @@ data "gitlab_projects" "projects" {
   search     = "postgresql"
   visibility = "private"
 }
+
+# List recently active projects with a given topic, bounded to at most 500 results
+data "gitlab_projects" "recent" {
+  topic               = "terraform"
+  last_activity_after = "2022-01-01T00:00:00Z"
+  order_by            = "id"
+  sort                = "asc"
+  per_page            = 100
+  max_results         = 500
+}
+
+# Continue with the next chunk of projects after the last one of the previous query
+data "gitlab_projects" "next" {
+  topic       = "terraform"
+  order_by    = "id"
+  sort        = "asc"
+  per_page    = 100
+  max_results = 500
+  id_after    = element(data.gitlab_projects.recent.projects, length(data.gitlab_projects.recent.projects) - 1).id
+}
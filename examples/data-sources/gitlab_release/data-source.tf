@@ -0,0 +1,9 @@
+data "gitlab_release" "example" {
+  project  = "foo/bar"
+  tag_name = "v1.2.3"
+}
+
+# Resolve the download URL of an artifact published by CI
+output "artifact_url" {
+  value = one([for link in data.gitlab_release.example.assets[0].links : link.direct_asset_url if link.name == "app.tar.gz"])
+}
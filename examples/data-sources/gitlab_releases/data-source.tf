@@ -0,0 +1,8 @@
+data "gitlab_releases" "example" {
+  project = "foo/bar"
+}
+
+# Latest release
+output "latest_release" {
+  value = data.gitlab_releases.example.releases[0].tag_name
+}
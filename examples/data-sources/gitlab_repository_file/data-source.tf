@@ -3,3 +3,20 @@ data "gitlab_repository_file" "example" {
   ref       = "main"
   file_path = "README.md"
 }
+
+# Consume a configuration file stored in the repository
+data "gitlab_repository_file" "config" {
+  project   = "example"
+  ref       = "v1.0.0"
+  file_path = "deploy/config.yaml"
+}
+
+resource "kubernetes_config_map" "example" {
+  metadata {
+    name = "example"
+  }
+
+  data = {
+    "config.yaml" = data.gitlab_repository_file.config.raw_content
+  }
+}
@@ -0,0 +1,17 @@
+data "gitlab_repository_tree" "this" {
+  project   = "example"
+  ref       = "main"
+  path      = "ExampleSubFolder"
+  recursive = true
+}
+
+# Iterate over the environment folders of a GitOps repository
+data "gitlab_repository_tree" "environments" {
+  project = "example"
+  ref     = "main"
+  path    = "environments"
+}
+
+output "environments" {
+  value = [for node in data.gitlab_repository_tree.environments.tree : node.name if node.type == "tree"]
+}
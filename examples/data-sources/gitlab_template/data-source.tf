@@ -0,0 +1,26 @@
+data "gitlab_template" "gitignore" {
+  type = "gitignores"
+  key  = "Go"
+}
+
+data "gitlab_template" "license" {
+  type     = "licenses"
+  key      = "mit"
+  fullname = "ACME Inc."
+}
+
+resource "gitlab_repository_file" "gitignore" {
+  project        = "foo/bar"
+  file_path      = ".gitignore"
+  branch         = "main"
+  content        = base64encode(data.gitlab_template.gitignore.content)
+  commit_message = "Add .gitignore"
+}
+
+resource "gitlab_repository_file" "license" {
+  project        = "foo/bar"
+  file_path      = "LICENSE"
+  branch         = "main"
+  content        = base64encode(data.gitlab_template.license.content)
+  commit_message = "Add LICENSE"
+}
@@ -0,0 +1,8 @@
+data "gitlab_templates" "licenses" {
+  type    = "licenses"
+  popular = true
+}
+
+output "popular_license_keys" {
+  value = data.gitlab_templates.licenses.templates[*].key
+}
@@ -0,0 +1,13 @@
+data "gitlab_topics" "approved" {
+  search = "approved-"
+}
+
+resource "gitlab_project" "this" {
+  name   = "example"
+  topics = [for topic in data.gitlab_topics.approved.topics : topic.name if topic.name == "approved-terraform"]
+}
+
+# Topics which are not assigned to any project anymore
+data "gitlab_topics" "unused" {
+  without_projects = true
+}
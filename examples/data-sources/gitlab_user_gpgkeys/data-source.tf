@@ -0,0 +1,7 @@
+data "gitlab_user" "example" {
+  username = "myuser"
+}
+
+data "gitlab_user_gpgkeys" "example" {
+  user_id = data.gitlab_user.example.id
+}
@@ -0,0 +1,15 @@
+data "gitlab_user" "example" {
+  username = "myuser"
+}
+
+data "gitlab_user_sshkeys" "example" {
+  user_id = data.gitlab_user.example.id
+}
+
+# SSH keys expiring within the next 30 days
+output "expiring_ssh_keys" {
+  value = [
+    for key in data.gitlab_user_sshkeys.example.keys : key.title
+    if key.expires_at != "" && timecmp(key.expires_at, timeadd(timestamp(), "720h")) < 0
+  ]
+}
@@ -7,3 +7,11 @@ data "gitlab_users" "example" {
 data "gitlab_users" "example-two" {
   search = "username"
 }
+
+# Human users without two-factor authentication, for access reviews
+data "gitlab_users" "without_2fa" {
+  active               = true
+  exclude_internal     = true
+  without_project_bots = true
+  two_factor           = "disabled"
+}
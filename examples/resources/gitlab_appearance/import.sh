@@ -0,0 +1,2 @@
+# The appearance is a singleton and can be imported with any id, e.g.
+terraform import gitlab_appearance.this gitlab
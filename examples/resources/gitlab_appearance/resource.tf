@@ -0,0 +1,18 @@
+resource "gitlab_appearance" "this" {
+  title       = "Example GitLab"
+  description = "Welcome to the **Example** GitLab instance."
+
+  logo      = "${path.module}/logo.png"
+  logo_hash = filesha256("${path.module}/logo.png")
+
+  favicon      = "${path.module}/favicon.png"
+  favicon_hash = filesha256("${path.module}/favicon.png")
+
+  # Message of the day
+  header_message           = "Scheduled maintenance on Saturday, 10:00 UTC"
+  footer_message           = "Managed by the platform team"
+  message_background_color = "#e75e40"
+  message_font_color       = "#ffffff"
+
+  new_project_guidelines = "Please follow the [project naming conventions](https://example.com/conventions)."
+}
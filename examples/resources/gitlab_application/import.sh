@@ -0,0 +1,3 @@
+# Gitlab applications can be imported with their id, e.g.
+terraform import gitlab_application.example "1"
+# NOTE: the secret and scopes cannot be imported
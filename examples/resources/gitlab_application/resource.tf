@@ -0,0 +1,11 @@
+resource "gitlab_application" "oidc" {
+  confidential = true
+  scopes       = ["openid"]
+  name         = "company_oidc"
+  redirect_uri = "https://mycompany.com"
+
+  # Changing any value in this map renews the application secret in-place.
+  secret_keepers = {
+    rotated_at = "2024-01-01"
+  }
+}
@@ -0,0 +1,2 @@
+# The application settings are a singleton and can be imported with any id, e.g.
+terraform import gitlab_application_settings.this gitlab
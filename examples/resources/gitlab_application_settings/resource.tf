@@ -0,0 +1,28 @@
+resource "gitlab_application_settings" "this" {
+  # Sign-up restrictions
+  signup_enabled                           = true
+  require_admin_approval_after_user_signup = true
+  domain_allowlist                         = ["example.com"]
+
+  # Terms of service
+  enforce_terms = true
+  terms         = "By using this instance you agree to the acceptable use policy."
+
+  # Outbound requests
+  allow_local_requests_from_web_hooks_and_services = false
+  outbound_local_requests_whitelist                = ["10.0.0.0/8", "internal.example.com"]
+
+  # Rate limits
+  throttle_authenticated_api_enabled             = true
+  throttle_authenticated_api_requests_per_period = 2000
+  throttle_authenticated_api_period_in_seconds   = 60
+
+  # Defaults for new projects and groups
+  default_branch_name        = "main"
+  default_project_visibility = "private"
+  default_group_visibility   = "private"
+
+  # CI/CD defaults
+  auto_devops_enabled         = false
+  default_artifacts_expire_in = "7 days"
+}
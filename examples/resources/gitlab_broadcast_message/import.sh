@@ -0,0 +1,2 @@
+# Gitlab broadcast messages can be imported with their id, e.g.
+terraform import gitlab_broadcast_message.example "1"
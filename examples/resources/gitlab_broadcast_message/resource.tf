@@ -0,0 +1,17 @@
+resource "gitlab_broadcast_message" "maintenance" {
+  message     = "GitLab will be unavailable on Saturday from 10:00 to 12:00 UTC due to planned maintenance."
+  starts_at   = "2022-06-01T08:00:00Z"
+  ends_at     = "2022-06-04T12:00:00Z"
+  font        = "#ffffff"
+  color       = "#e75e40"
+  target_path = "*/welcome"
+
+  broadcast_type = "banner"
+  dismissable    = true
+}
+
+resource "gitlab_broadcast_message" "notification" {
+  message              = "Please migrate your pipelines to the new runners."
+  broadcast_type       = "notification"
+  target_access_levels = ["maintainer", "owner"]
+}
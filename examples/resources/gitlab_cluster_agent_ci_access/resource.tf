@@ -0,0 +1,13 @@
+resource "gitlab_cluster_agent_ci_access" "production" {
+  project    = "my-group/kubernetes-agents"
+  agent_name = "production"
+
+  projects {
+    id                = "my-group/my-app"
+    default_namespace = "my-app"
+  }
+
+  groups {
+    id = "my-group/platform"
+  }
+}
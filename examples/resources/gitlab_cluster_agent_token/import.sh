@@ -0,0 +1,3 @@
+# GitLab cluster agent tokens can be imported using an id made up of `project:agent_id:token_id`, e.g.
+# NOTE: the `token` attribute won't be available for imported resources.
+terraform import gitlab_cluster_agent_token.production 12345:1:42
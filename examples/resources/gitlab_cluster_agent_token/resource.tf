@@ -0,0 +1,21 @@
+# Rotate the token every 90 days
+resource "time_rotating" "agent_token" {
+  rotation_days = 90
+}
+
+resource "gitlab_cluster_agent_token" "production" {
+  project     = "12345"
+  agent_id    = 1
+  name        = "production-token"
+  description = "Token for the production cluster"
+  expires_at  = time_rotating.agent_token.rotation_rfc3339
+
+  # Manually rotate the token by changing any of the values
+  rotation_keepers = {
+    rotation = "1"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
@@ -0,0 +1,2 @@
+# GitLab cluster agent user access can be imported using an id made up of `project:agent_name`, e.g.
+terraform import gitlab_cluster_agent_user_access.production my-group/kubernetes-agents:production
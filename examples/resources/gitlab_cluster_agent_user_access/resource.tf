@@ -0,0 +1,6 @@
+resource "gitlab_cluster_agent_user_access" "production" {
+  project    = "my-group/kubernetes-agents"
+  agent_name = "production"
+  access_as  = "agent"
+  groups     = ["my-group/platform"]
+}
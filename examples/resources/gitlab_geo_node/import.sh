@@ -0,0 +1,2 @@
+# GitLab Geo nodes can be imported using the node id, e.g.
+terraform import gitlab_geo_node.eu 2
@@ -0,0 +1,9 @@
+resource "gitlab_geo_node" "eu" {
+  name               = "gitlab-eu"
+  url                = "https://gitlab-eu.example.com/"
+  files_max_capacity = 20
+
+  # Only replicate the projects of selected groups
+  selective_sync_type          = "namespaces"
+  selective_sync_namespace_ids = [42]
+}
@@ -10,3 +10,11 @@ resource "gitlab_project" "example" {
   description  = "An example project"
   namespace_id = gitlab_group.example.id
 }
+
+# Restrict the access to a top-level group (requires GitLab Premium)
+resource "gitlab_group" "restricted" {
+  name                       = "restricted"
+  path                       = "restricted"
+  ip_restriction_ranges      = ["192.168.0.0/24"]
+  allowed_email_domains_list = ["example.com"]
+}
@@ -0,0 +1,3 @@
+# The `secret_access_key` isn't returned by the GitLab API, thus it must be set in the configuration.
+# You can import a gitlab_group_audit_event_streaming_amazon_s3 state using `<group>:<destination-id>`, e.g.
+terraform import gitlab_group_audit_event_streaming_amazon_s3.example "my-group:42"
@@ -0,0 +1,2 @@
+# You can import a gitlab_group_audit_event_streaming_destination state using `<group>:<destination-id>`, e.g.
+terraform import gitlab_group_audit_event_streaming_destination.siem "my-group:42"
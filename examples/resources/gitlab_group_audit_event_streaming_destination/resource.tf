@@ -0,0 +1,11 @@
+resource "gitlab_group_audit_event_streaming_destination" "siem" {
+  group           = "my-group"
+  name            = "SIEM"
+  destination_url = "https://siem.example.com/audit-events"
+
+  headers = {
+    "Authorization" = "Bearer ${var.siem_token}"
+  }
+
+  event_type_filters = ["user_created", "project_created"]
+}
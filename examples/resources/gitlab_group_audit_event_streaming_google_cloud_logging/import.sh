@@ -0,0 +1,3 @@
+# The `private_key` isn't returned by the GitLab API, thus it must be set in the configuration.
+# You can import a gitlab_group_audit_event_streaming_google_cloud_logging state using `<group>:<destination-id>`, e.g.
+terraform import gitlab_group_audit_event_streaming_google_cloud_logging.example "my-group:42"
@@ -0,0 +1,8 @@
+resource "gitlab_group_audit_event_streaming_google_cloud_logging" "example" {
+  group                  = "my-group"
+  name                   = "Google Cloud Logging"
+  google_project_id_name = "my-project"
+  client_email           = "audit@my-project.iam.gserviceaccount.com"
+  private_key            = var.google_private_key
+  log_id_name            = "gitlab_audit_events"
+}
@@ -0,0 +1,2 @@
+# You can import a gitlab_group_dependency_proxy state using the group ID or full path, e.g.
+terraform import gitlab_group_dependency_proxy.this 1
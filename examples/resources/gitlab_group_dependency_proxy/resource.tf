@@ -0,0 +1,18 @@
+resource "gitlab_group" "awesome_group" {
+  name = "awesome_group"
+  path = "awesome_group"
+}
+
+resource "gitlab_group_dependency_proxy" "this" {
+  group   = gitlab_group.awesome_group.id
+  enabled = true
+
+  # Remove cached images which haven't been pulled for 30 days
+  ttl_policy_enabled = true
+  ttl                = 30
+
+  # Change the value to purge the cache
+  purge_cache_triggers = {
+    purged_at = "2024-01-01"
+  }
+}
@@ -0,0 +1,2 @@
+# GitLab epic issue assignments can be imported using an id made up of `group:epic_iid:issue_id`, e.g.
+terraform import gitlab_group_epic_issue.rollout my-group:1:42
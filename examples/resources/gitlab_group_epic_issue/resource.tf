@@ -0,0 +1,10 @@
+resource "gitlab_project_issue" "rollout" {
+  project = "12345"
+  title   = "Roll out the new deployment pipeline"
+}
+
+resource "gitlab_group_epic_issue" "rollout" {
+  group    = "my-group"
+  epic_iid = 1
+  issue_id = gitlab_project_issue.rollout.issue_id
+}
@@ -0,0 +1,4 @@
+# GitLab group integrations can be imported using an id made up of `group:integration_slug`, e.g.
+terraform import gitlab_group_integration.asana 1:asana
+
+# Note: the `settings` resource attribute is not available for imported resources, it is set on the next apply.
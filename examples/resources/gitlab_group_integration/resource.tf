@@ -0,0 +1,18 @@
+resource "gitlab_group" "awesome_group" {
+  name        = "awesome_group"
+  path        = "awesome_group"
+  description = "My awesome group."
+}
+
+# All projects in the group inherit this integration, unless they override it.
+resource "gitlab_group_integration" "asana" {
+  group            = gitlab_group.awesome_group.id
+  integration_slug = "asana"
+
+  settings = {
+    restrict_to_branch = "main"
+  }
+  sensitive_settings = {
+    api_key = var.asana_api_key
+  }
+}
@@ -0,0 +1,3 @@
+# The `secret_access_key` isn't returned by the GitLab API, thus it must be set in the configuration.
+# You can import a gitlab_instance_audit_event_streaming_amazon_s3 state using the destination ID, e.g.
+terraform import gitlab_instance_audit_event_streaming_amazon_s3.example 42
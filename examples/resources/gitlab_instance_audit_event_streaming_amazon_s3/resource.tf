@@ -0,0 +1,7 @@
+resource "gitlab_instance_audit_event_streaming_amazon_s3" "example" {
+  name              = "Amazon S3"
+  access_key_xid    = var.aws_access_key_id
+  secret_access_key = var.aws_secret_access_key
+  bucket_name       = "gitlab-audit-events"
+  aws_region        = "us-east-1"
+}
@@ -0,0 +1,2 @@
+# You can import a gitlab_instance_audit_event_streaming_destination state using the destination ID, e.g.
+terraform import gitlab_instance_audit_event_streaming_destination.siem 42
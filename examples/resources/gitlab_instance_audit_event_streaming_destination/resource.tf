@@ -0,0 +1,10 @@
+resource "gitlab_instance_audit_event_streaming_destination" "siem" {
+  name            = "SIEM"
+  destination_url = "https://siem.example.com/audit-events"
+
+  headers = {
+    "Authorization" = "Bearer ${var.siem_token}"
+  }
+
+  event_type_filters = ["user_created", "project_created"]
+}
@@ -0,0 +1,7 @@
+resource "gitlab_instance_audit_event_streaming_google_cloud_logging" "example" {
+  name                   = "Google Cloud Logging"
+  google_project_id_name = "my-project"
+  client_email           = "audit@my-project.iam.gserviceaccount.com"
+  private_key            = var.google_private_key
+  log_id_name            = "gitlab_audit_events"
+}
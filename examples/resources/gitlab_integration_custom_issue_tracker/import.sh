@@ -0,0 +1,2 @@
+# You can import a gitlab_integration_custom_issue_tracker state using the project ID, e.g.
+terraform import gitlab_integration_custom_issue_tracker.tracker 1
@@ -0,0 +1,18 @@
+resource "gitlab_project" "awesome_project" {
+  name             = "awesome_project"
+  description      = "My awesome project."
+  visibility_level = "public"
+}
+
+resource "gitlab_integration_datadog" "datadog" {
+  project      = gitlab_project.awesome_project.id
+  api_key      = var.datadog_api_key
+  datadog_site = "datadoghq.eu"
+
+  datadog_env     = "production"
+  datadog_service = "gitlab"
+  datadog_tags    = join("\n", ["team:platform", "cost-center:42"])
+
+  # Collect job logs in Datadog
+  archive_trace_events = true
+}
@@ -0,0 +1,17 @@
+resource "gitlab_project" "awesome_project" {
+  name             = "awesome_project"
+  description      = "My awesome project."
+  visibility_level = "public"
+}
+
+resource "gitlab_integration_discord" "discord" {
+  project = gitlab_project.awesome_project.id
+  webhook = var.discord_webhook
+
+  notify_only_broken_pipelines = true
+  branches_to_be_notified      = "default_and_protected"
+
+  push_events           = true
+  merge_requests_events = true
+  pipeline_events       = true
+}
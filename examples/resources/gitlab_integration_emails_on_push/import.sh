@@ -0,0 +1,2 @@
+# You can import a gitlab_integration_emails_on_push state using the project ID, e.g.
+terraform import gitlab_integration_emails_on_push.email 1
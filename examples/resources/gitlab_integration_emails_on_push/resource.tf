@@ -0,0 +1,12 @@
+resource "gitlab_project" "awesome_project" {
+  name             = "awesome_project"
+  description      = "My awesome project."
+  visibility_level = "public"
+}
+
+resource "gitlab_integration_emails_on_push" "email" {
+  project                 = gitlab_project.awesome_project.id
+  recipients              = ["myrecipient@example.com", "myotherrecipient@example.com"]
+  disable_diffs           = true
+  branches_to_be_notified = "default_and_protected"
+}
@@ -0,0 +1,13 @@
+resource "gitlab_project" "awesome_project" {
+  name             = "awesome_project"
+  description      = "My awesome project."
+  visibility_level = "public"
+}
+
+resource "gitlab_integration_harbor" "harbor" {
+  project      = gitlab_project.awesome_project.id
+  url          = "https://demo.goharbor.io"
+  project_name = "testproject"
+  username     = "harbor_username"
+  password     = var.harbor_password
+}
@@ -0,0 +1,2 @@
+# You can import a gitlab_integration_jira state using the project ID, e.g.
+terraform import gitlab_integration_jira.jira 1
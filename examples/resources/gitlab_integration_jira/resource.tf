@@ -0,0 +1,32 @@
+resource "gitlab_project" "awesome_project" {
+  name             = "awesome_project"
+  description      = "My awesome project."
+  visibility_level = "public"
+}
+
+# Jira Cloud with an email and API token
+resource "gitlab_integration_jira" "jira_cloud" {
+  project  = gitlab_project.awesome_project.id
+  url      = "https://example.atlassian.net"
+  username = "user@example.com"
+  password = "api-token"
+}
+
+resource "gitlab_project" "another_project" {
+  name             = "another_project"
+  description      = "My other project."
+  visibility_level = "public"
+}
+
+# Jira Data Center or Server with a personal access token
+resource "gitlab_integration_jira" "jira_server" {
+  project        = gitlab_project.another_project.id
+  url            = "https://jira.example.com"
+  jira_auth_type = 1
+  password       = "personal-access-token"
+
+  jira_issue_transition_id = "11,21"
+  jira_issue_prefix        = "PROJ-"
+  commit_events            = true
+  merge_requests_events    = true
+}
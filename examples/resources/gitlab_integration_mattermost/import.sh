@@ -0,0 +1,2 @@
+# You can import a gitlab_integration_mattermost state using the project ID, e.g.
+terraform import gitlab_integration_mattermost.mattermost 1
@@ -0,0 +1,22 @@
+resource "gitlab_project" "awesome_project" {
+  name             = "awesome_project"
+  description      = "My awesome project."
+  visibility_level = "public"
+}
+
+resource "gitlab_integration_mattermost" "mattermost" {
+  project  = gitlab_project.awesome_project.id
+  webhook  = "https://mattermost.example.com/hooks/xxx"
+  username = "gitlab"
+  channel  = "town-square"
+
+  push_events           = true
+  push_channel          = "commits"
+  merge_requests_events = true
+  merge_request_channel = "reviews"
+  pipeline_events       = true
+  pipeline_channel      = "ci"
+
+  notify_only_broken_pipelines = true
+  branches_to_be_notified      = "default_and_protected"
+}
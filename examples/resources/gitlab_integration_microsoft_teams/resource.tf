@@ -0,0 +1,11 @@
+resource "gitlab_project" "awesome_project" {
+  name             = "awesome_project"
+  description      = "My awesome project."
+  visibility_level = "public"
+}
+
+resource "gitlab_integration_microsoft_teams" "teams" {
+  project     = gitlab_project.awesome_project.id
+  webhook     = "https://testurl.com/?token=XYZ"
+  push_events = true
+}
@@ -0,0 +1,14 @@
+resource "gitlab_project" "awesome_project" {
+  name             = "awesome_project"
+  description      = "My awesome project."
+  visibility_level = "public"
+}
+
+resource "gitlab_integration_prometheus" "prometheus" {
+  project = gitlab_project.awesome_project.id
+  api_url = "https://prometheus.example.com"
+
+  # Only required if Prometheus is protected by Google Identity-Aware Proxy
+  google_iap_audience_client_id   = "IAP_CLIENT_ID.apps.googleusercontent.com"
+  google_iap_service_account_json = file("credentials.json")
+}
@@ -0,0 +1,20 @@
+resource "gitlab_project" "awesome_project" {
+  name             = "awesome_project"
+  description      = "My awesome project."
+  visibility_level = "public"
+}
+
+resource "gitlab_integration_slack" "slack" {
+  project  = gitlab_project.awesome_project.id
+  webhook  = "https://webhook.com"
+  username = "myuser"
+  channel  = "general"
+
+  push_events      = true
+  push_channel     = "push_chan"
+  pipeline_events  = true
+  pipeline_channel = "ci"
+
+  notify_only_broken_pipelines = true
+  branches_to_be_notified      = "default_and_protected"
+}
@@ -0,0 +1,2 @@
+# You can import a gitlab_integration_telegram state using the project ID, e.g.
+terraform import gitlab_integration_telegram.telegram 1
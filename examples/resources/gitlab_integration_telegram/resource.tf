@@ -0,0 +1,18 @@
+resource "gitlab_project" "awesome_project" {
+  name             = "awesome_project"
+  description      = "My awesome project."
+  visibility_level = "public"
+}
+
+resource "gitlab_integration_telegram" "telegram" {
+  project = gitlab_project.awesome_project.id
+  token   = var.telegram_bot_token
+  room    = "@my_channel"
+
+  notify_only_broken_pipelines = true
+  branches_to_be_notified      = "default"
+
+  push_events           = true
+  merge_requests_events = true
+  pipeline_events       = true
+}
@@ -0,0 +1,3 @@
+resource "gitlab_license" "this" {
+  license = trimspace(file("${path.module}/Gitlab-license.txt"))
+}
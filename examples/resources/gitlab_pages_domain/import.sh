@@ -0,0 +1,2 @@
+# GitLab pages domains can be imported using an id made up of `project:domain`, e.g.
+terraform import gitlab_pages_domain.docs 12345:docs.example.com
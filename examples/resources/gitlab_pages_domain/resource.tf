@@ -0,0 +1,19 @@
+# Custom domain with a certificate issued by Let's Encrypt
+resource "gitlab_pages_domain" "docs" {
+  project          = "12345"
+  domain           = "docs.example.com"
+  auto_ssl_enabled = true
+}
+
+# Custom domain with a user-provided certificate
+resource "gitlab_pages_domain" "www" {
+  project     = "12345"
+  domain      = "www.example.com"
+  certificate = file("${path.module}/www.example.com.crt")
+  key         = file("${path.module}/www.example.com.key")
+}
+
+# Value of the DNS TXT record to verify the domain
+output "docs_verification_code" {
+  value = gitlab_pages_domain.docs.verification_code
+}
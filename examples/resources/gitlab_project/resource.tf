@@ -27,3 +27,18 @@ resource "gitlab_project" "peters_repo" {
   description  = "This is a description"
   namespace_id = data.gitlab_user.peter_parker.namespace_id
 }
+
+# Fork a project, e.g. to contribute to an upstream project
+resource "gitlab_project" "fork" {
+  name                   = "my-fork"
+  description            = "This is a fork"
+  forked_from_project_id = gitlab_project.example.id
+  mr_default_target_self = true
+}
+
+# Create a project from a project export archive, e.g. to copy a "golden" project with its issues and wiki
+resource "gitlab_project" "from_export" {
+  name                = "from-export"
+  path                = "from-export"
+  import_archive_path = "${path.module}/golden-project-export.tar.gz"
+}
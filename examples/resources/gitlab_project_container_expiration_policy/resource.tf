@@ -0,0 +1,8 @@
+resource "gitlab_project_container_expiration_policy" "cleanup" {
+  project           = "12345"
+  cadence           = "1d"
+  keep_n            = 10
+  older_than        = "14d"
+  name_regex_delete = ".*"
+  name_regex_keep   = "^v.*"
+}
@@ -0,0 +1,2 @@
+# GitLab project container registry protection rules can be imported using an id made up of `project:rule_id`, e.g.
+terraform import gitlab_project_container_registry_protection_rule.production 12345:1
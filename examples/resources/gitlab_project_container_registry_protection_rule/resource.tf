@@ -0,0 +1,6 @@
+resource "gitlab_project_container_registry_protection_rule" "production" {
+  project                         = "12345"
+  repository_path_pattern         = "my-group/my-project/production*"
+  minimum_access_level_for_push   = "maintainer"
+  minimum_access_level_for_delete = "owner"
+}
@@ -0,0 +1,11 @@
+resource "gitlab_project" "awesome_project" {
+  name        = "awesome_project"
+  description = "My awesome project."
+}
+
+# Use the GitLab integrated error tracking
+resource "gitlab_project_error_tracking" "this" {
+  project    = gitlab_project.awesome_project.id
+  active     = true
+  integrated = true
+}
@@ -0,0 +1,2 @@
+# GitLab project feature flags can be imported using an id made up of `project:name`, e.g.
+terraform import gitlab_project_feature_flag.new_checkout 1:new_checkout
@@ -0,0 +1,26 @@
+resource "gitlab_project_feature_flag_user_list" "beta_testers" {
+  project   = "12345"
+  name      = "beta-testers"
+  user_xids = ["user1@example.com", "user2@example.com"]
+}
+
+resource "gitlab_project_feature_flag" "new_checkout" {
+  project     = "12345"
+  name        = "new_checkout"
+  description = "Enables the new checkout flow."
+
+  # Roll out to 25% of the users in production
+  strategy {
+    name               = "flexibleRollout"
+    rollout            = "25"
+    stickiness         = "userId"
+    environment_scopes = ["production"]
+  }
+
+  # Enable for all beta testers in staging
+  strategy {
+    name               = "gitlabUserList"
+    user_list_id       = gitlab_project_feature_flag_user_list.beta_testers.user_list_id
+    environment_scopes = ["staging"]
+  }
+}
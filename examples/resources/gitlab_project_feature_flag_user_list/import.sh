@@ -0,0 +1,2 @@
+# GitLab project feature flag user lists can be imported using an id made up of `project:iid`, e.g.
+terraform import gitlab_project_feature_flag_user_list.beta_testers 1:1
@@ -0,0 +1,5 @@
+resource "gitlab_project_feature_flag_user_list" "beta_testers" {
+  project   = "12345"
+  name      = "beta-testers"
+  user_xids = ["user1@example.com", "user2@example.com"]
+}
@@ -0,0 +1,4 @@
+# GitLab project integrations can be imported using an id made up of `project:integration_slug`, e.g.
+terraform import gitlab_project_integration.asana 1:asana
+
+# Note: the `settings` resource attribute is not available for imported resources, it is set on the next apply.
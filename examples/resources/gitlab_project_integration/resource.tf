@@ -0,0 +1,17 @@
+resource "gitlab_project" "awesome_project" {
+  name             = "awesome_project"
+  description      = "My awesome project."
+  visibility_level = "public"
+}
+
+resource "gitlab_project_integration" "asana" {
+  project          = gitlab_project.awesome_project.id
+  integration_slug = "asana"
+
+  settings = {
+    restrict_to_branch = "main"
+  }
+  sensitive_settings = {
+    api_key = var.asana_api_key
+  }
+}
@@ -0,0 +1,2 @@
+# GitLab project package protection rules can be imported using an id made up of `project:rule_id`, e.g.
+terraform import gitlab_project_package_protection_rule.release 12345:1
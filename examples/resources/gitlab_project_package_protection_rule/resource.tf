@@ -0,0 +1,6 @@
+resource "gitlab_project_package_protection_rule" "release" {
+  project                       = "12345"
+  package_name_pattern          = "@my-scope/release-*"
+  package_type                  = "npm"
+  minimum_access_level_for_push = "maintainer"
+}
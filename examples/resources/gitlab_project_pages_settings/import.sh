@@ -0,0 +1,2 @@
+# GitLab project pages settings can be imported using the project id or full path, e.g.
+terraform import gitlab_project_pages_settings.docs 12345
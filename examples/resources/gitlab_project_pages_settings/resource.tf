@@ -0,0 +1,15 @@
+resource "gitlab_pages_domain" "docs" {
+  project          = "12345"
+  domain           = "docs.example.com"
+  auto_ssl_enabled = true
+}
+
+resource "gitlab_project_pages_settings" "docs" {
+  project               = "12345"
+  unique_domain_enabled = false
+  https_only            = true
+  primary_domain        = "https://${gitlab_pages_domain.docs.domain}"
+
+  # Unpublish the Pages site when this resource is destroyed
+  force_delete_pages = true
+}
@@ -0,0 +1,2 @@
+# You can import a gitlab_project_service_desk state using the project ID, e.g.
+terraform import gitlab_project_service_desk.support 1
@@ -0,0 +1,12 @@
+resource "gitlab_project" "support" {
+  name        = "support"
+  description = "Support intake project."
+}
+
+resource "gitlab_project_service_desk" "support" {
+  project = gitlab_project.support.id
+}
+
+output "support_email" {
+  value = gitlab_project_service_desk.support.address
+}
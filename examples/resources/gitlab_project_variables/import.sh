@@ -0,0 +1,3 @@
+# GitLab project variables can be imported using an id made up of `project:environment_scope`, e.g.
+# All variables of the environment scope are imported.
+terraform import gitlab_project_variables.example '12345:*'
@@ -0,0 +1,17 @@
+resource "gitlab_project_variables" "example" {
+  project   = "12345"
+  protected = false
+  variables = {
+    DEPLOY_TARGET = "production"
+    LOG_LEVEL     = "info"
+    REGION        = "eu-central-1"
+  }
+}
+
+resource "gitlab_project_variables" "review" {
+  project           = "12345"
+  environment_scope = "review/*"
+  variables = {
+    DEPLOY_TARGET = "review"
+  }
+}
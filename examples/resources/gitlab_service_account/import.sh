@@ -0,0 +1,2 @@
+# You can import a gitlab_service_account state using the user ID of the service account, e.g.
+terraform import gitlab_service_account.example 42
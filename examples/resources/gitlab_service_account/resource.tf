@@ -0,0 +1,12 @@
+resource "gitlab_service_account" "example" {
+  name     = "CI bot"
+  username = "ci-bot"
+}
+
+# Manage a token of the service account
+resource "gitlab_personal_access_token" "example" {
+  user_id    = gitlab_service_account.example.id
+  name       = "ci"
+  expires_at = "2030-01-01"
+  scopes     = ["api"]
+}
@@ -9,3 +9,16 @@ resource "gitlab_user" "example" {
   is_external      = true
   reset_password   = false
 }
+
+resource "gitlab_user" "sso_example" {
+  name           = "Example SSO"
+  username       = "example-sso"
+  email          = "sso@user.create"
+  reset_password = true
+  note           = "Provisioned by Terraform for the SSO rollout"
+
+  identities {
+    provider   = "saml"
+    extern_uid = "example-sso@idp.example.com"
+  }
+}
@@ -0,0 +1,22 @@
+data "gitlab_user" "example" {
+  username = "example-user"
+}
+
+# Manages a GPG key for the specified user. An admin token is required if `user_id` is specified.
+resource "gitlab_user_gpgkey" "example" {
+  user_id = data.gitlab_user.example.id
+  key     = <<EOF
+-----BEGIN PGP PUBLIC KEY BLOCK-----
+...
+-----END PGP PUBLIC KEY BLOCK-----
+EOF
+}
+
+# Manages a GPG key for the current user
+resource "gitlab_user_gpgkey" "example_user" {
+  key = <<EOF
+-----BEGIN PGP PUBLIC KEY BLOCK-----
+...
+-----END PGP PUBLIC KEY BLOCK-----
+EOF
+}
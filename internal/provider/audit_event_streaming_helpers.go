@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// auditEventStreamingAPI describes the GraphQL API of a kind of audit event streaming destinations.
+// The APIs for top-level groups and for the instance only differ by the names of their fields and mutations.
+type auditEventStreamingAPI struct {
+	// gidType is the type of the global IDs of the destinations, e.g. `AuditEvents::ExternalAuditEventDestination`.
+	gidType string
+	// listField is the field of the group, or of the query root for the instance, which lists the destinations.
+	listField string
+	// selection are the fields queried for a destination.
+	selection string
+
+	createMutation  string
+	updateMutation  string
+	destroyMutation string
+	// payloadField is the field of the create and update mutation payloads which contains the destination.
+	payloadField string
+}
+
+// gid returns the global ID of the destination with the given numeric ID.
+func (api auditEventStreamingAPI) gid(id string) string {
+	return fmt.Sprintf("gid://gitlab/%s/%s", api.gidType, id)
+}
+
+// auditEventStreamingDestinationID returns the numeric ID of a destination from its global ID.
+func auditEventStreamingDestinationID(gid string) string {
+	return path.Base(gid)
+}
+
+// auditEventStreamingGroupPath returns the full path of the given group ID or full path,
+// as required by the GraphQL API.
+func auditEventStreamingGroupPath(ctx context.Context, client *gitlab.Client, group string) (string, error) {
+	g, _, err := client.Groups.GetGroup(group, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	return g.FullPath, nil
+}
+
+// auditEventStreamingFind returns the destination with the given numeric ID, or nil if it doesn't exist.
+// The destinations of the instance are queried if groupPath is empty.
+func auditEventStreamingFind(ctx context.Context, client *gitlab.Client, api auditEventStreamingAPI, groupPath string, id string) (json.RawMessage, error) {
+	type connection struct {
+		Nodes []json.RawMessage `json:"nodes"`
+	}
+
+	var nodes []json.RawMessage
+	if groupPath != "" {
+		var data struct {
+			Group map[string]*connection `json:"group"`
+		}
+		query := fmt.Sprintf(`query($fullPath: ID!) {
+			group(fullPath: $fullPath) {
+				%s { nodes { id %s } }
+			}
+		}`, api.listField, api.selection)
+		if err := graphQLClientFor(client).query(ctx, query, map[string]interface{}{"fullPath": groupPath}, &data); err != nil {
+			return nil, err
+		}
+		if data.Group == nil {
+			return nil, nil
+		}
+		if c := data.Group[api.listField]; c != nil {
+			nodes = c.Nodes
+		}
+	} else {
+		var data map[string]*connection
+		query := fmt.Sprintf(`query {
+			%s { nodes { id %s } }
+		}`, api.listField, api.selection)
+		if err := graphQLClientFor(client).query(ctx, query, nil, &data); err != nil {
+			return nil, err
+		}
+		if c := data[api.listField]; c != nil {
+			nodes = c.Nodes
+		}
+	}
+
+	gid := api.gid(id)
+	for _, node := range nodes {
+		var n struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(node, &n); err != nil {
+			return nil, err
+		}
+		if n.ID == gid {
+			return node, nil
+		}
+	}
+	return nil, nil
+}
+
+// auditEventStreamingMutate runs the given mutation with the given input and returns the
+// global ID of the object in the payloadField of its payload, if any.
+func auditEventStreamingMutate(ctx context.Context, client *gitlab.Client, mutation string, input map[string]interface{}, payloadField string) (string, error) {
+	selection := "errors"
+	if payloadField != "" {
+		selection = fmt.Sprintf("errors %s { id }", payloadField)
+	}
+	// The input types follow the naming convention of the GitLab GraphQL API, e.g. `ExternalAuditEventDestinationCreateInput`.
+	query := fmt.Sprintf(`mutation($input: %s%sInput!) {
+		%s(input: $input) { %s }
+	}`, strings.ToUpper(mutation[:1]), mutation[1:], mutation, selection)
+
+	var data map[string]map[string]json.RawMessage
+	if err := graphQLClientFor(client).mutate(ctx, query, map[string]interface{}{"input": input}, &data); err != nil {
+		return "", err
+	}
+
+	payload := data[mutation]
+	if payload == nil {
+		return "", fmt.Errorf("%s didn't return a payload", mutation)
+	}
+
+	if payloadField == "" {
+		return "", nil
+	}
+	var object *struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(payload[payloadField], &object); err != nil {
+		return "", err
+	}
+	if object == nil {
+		return "", fmt.Errorf("%s didn't return the %s", mutation, payloadField)
+	}
+	return object.ID, nil
+}
+
+// auditEventStreamingField maps an attribute of an audit event streaming destination to its GraphQL field.
+type auditEventStreamingField struct {
+	attribute string
+	field     string
+	// secret fields aren't returned by the GraphQL API, thus they are kept from the state.
+	secret bool
+}
+
+// auditEventStreamingCreate creates a destination from the given fields and the additional input,
+// and returns its numeric ID.
+func auditEventStreamingCreate(ctx context.Context, client *gitlab.Client, d *schema.ResourceData, api auditEventStreamingAPI, fields []auditEventStreamingField, input map[string]interface{}) (string, error) {
+	for _, f := range fields {
+		if v, ok := d.GetOk(f.attribute); ok {
+			input[f.field] = v
+		}
+	}
+
+	gid, err := auditEventStreamingMutate(ctx, client, api.createMutation, input, api.payloadField)
+	if err != nil {
+		return "", err
+	}
+	return auditEventStreamingDestinationID(gid), nil
+}
+
+// auditEventStreamingRead reads the given fields of a destination into the state.
+func auditEventStreamingRead(ctx context.Context, client *gitlab.Client, d *schema.ResourceData, api auditEventStreamingAPI, fields []auditEventStreamingField, groupPath string, id string) diag.Diagnostics {
+	node, err := auditEventStreamingFind(ctx, client, api, groupPath, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if node == nil {
+		log.Printf("[DEBUG] audit event streaming destination %s not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	var destination map[string]interface{}
+	if err := json.Unmarshal(node, &destination); err != nil {
+		return diag.FromErr(err)
+	}
+	for _, f := range fields {
+		if !f.secret {
+			d.Set(f.attribute, destination[f.field])
+		}
+	}
+	return nil
+}
+
+// auditEventStreamingUpdate updates the changed fields of a destination.
+func auditEventStreamingUpdate(ctx context.Context, client *gitlab.Client, d *schema.ResourceData, api auditEventStreamingAPI, fields []auditEventStreamingField, id string) error {
+	input := map[string]interface{}{"id": api.gid(id)}
+	for _, f := range fields {
+		if d.HasChange(f.attribute) {
+			input[f.field] = d.Get(f.attribute)
+		}
+	}
+
+	_, err := auditEventStreamingMutate(ctx, client, api.updateMutation, input, api.payloadField)
+	return err
+}
+
+// auditEventStreamingSelection returns the GraphQL selection of the non-secret fields.
+func auditEventStreamingSelection(fields []auditEventStreamingField) string {
+	var selection []string
+	for _, f := range fields {
+		if !f.secret {
+			selection = append(selection, f.field)
+		}
+	}
+	return strings.Join(selection, " ")
+}
+
+func resourceGitlabGroupAuditEventStreamingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, api auditEventStreamingAPI, fields []auditEventStreamingField) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	groupPath, err := auditEventStreamingGroupPath(ctx, client, group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] create audit event streaming destination for gitlab group %s", groupPath)
+	id, err := auditEventStreamingCreate(ctx, client, d, api, fields, map[string]interface{}{"groupPath": groupPath})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildTwoPartID(&group, &id))
+	return resourceGitlabGroupAuditEventStreamingRead(ctx, d, meta, api, fields)
+}
+
+func resourceGitlabGroupAuditEventStreamingRead(ctx context.Context, d *schema.ResourceData, meta interface{}, api auditEventStreamingAPI, fields []auditEventStreamingField) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, id, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read audit event streaming destination %s of gitlab group %s", id, group)
+
+	groupPath, err := auditEventStreamingGroupPath(ctx, client, group)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab group %s not found, removing audit event streaming destination from state", group)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("group", group)
+	return auditEventStreamingRead(ctx, client, d, api, fields, groupPath, id)
+}
+
+func resourceGitlabGroupAuditEventStreamingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, api auditEventStreamingAPI, fields []auditEventStreamingField) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	_, id, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] update audit event streaming destination %s", d.Id())
+	if err := auditEventStreamingUpdate(ctx, client, d, api, fields, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabGroupAuditEventStreamingRead(ctx, d, meta, api, fields)
+}
+
+func resourceGitlabGroupAuditEventStreamingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, api auditEventStreamingAPI) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	_, id, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete audit event streaming destination %s", d.Id())
+	if _, err := auditEventStreamingMutate(ctx, client, api.destroyMutation, map[string]interface{}{"id": api.gid(id)}, ""); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabInstanceAuditEventStreamingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, api auditEventStreamingAPI, fields []auditEventStreamingField) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] create audit event streaming destination for the gitlab instance")
+	id, err := auditEventStreamingCreate(ctx, client, d, api, fields, map[string]interface{}{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id)
+	return resourceGitlabInstanceAuditEventStreamingRead(ctx, d, meta, api, fields)
+}
+
+func resourceGitlabInstanceAuditEventStreamingRead(ctx context.Context, d *schema.ResourceData, meta interface{}, api auditEventStreamingAPI, fields []auditEventStreamingField) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] read audit event streaming destination %s of the gitlab instance", d.Id())
+	return auditEventStreamingRead(ctx, client, d, api, fields, "", d.Id())
+}
+
+func resourceGitlabInstanceAuditEventStreamingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, api auditEventStreamingAPI, fields []auditEventStreamingField) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] update audit event streaming destination %s of the gitlab instance", d.Id())
+	if err := auditEventStreamingUpdate(ctx, client, d, api, fields, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabInstanceAuditEventStreamingRead(ctx, d, meta, api, fields)
+}
+
+func resourceGitlabInstanceAuditEventStreamingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, api auditEventStreamingAPI) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] delete audit event streaming destination %s of the gitlab instance", d.Id())
+	if _, err := auditEventStreamingMutate(ctx, client, api.destroyMutation, map[string]interface{}{"id": api.gid(d.Id())}, ""); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
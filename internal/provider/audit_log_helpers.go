@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// auditLogEntry is a line of the audit log, describing a single request to GitLab.
+type auditLogEntry struct {
+	Time          string `json:"time"`
+	Method        string `json:"method"`
+	Path          string `json:"path"`
+	Status        int    `json:"status,omitempty"`
+	DurationMS    int64  `json:"duration_ms"`
+	CorrelationID string `json:"correlation_id,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+// auditLogTransport appends an entry in JSON format to the audit log for every request to GitLab,
+// including every retry of a request. The query of the request URL is omitted, because it may contain secrets.
+type auditLogTransport struct {
+	mu   sync.Mutex
+	log  io.Writer
+	base http.RoundTripper
+}
+
+// newAuditLogTransport opens the audit log file at the given path, creating it if necessary.
+// NOTE: the file is kept open for the lifetime of the provider.
+func newAuditLogTransport(path string, base http.RoundTripper) (*auditLogTransport, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &auditLogTransport{log: file, base: base}, nil
+}
+
+func (t *auditLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+
+	entry := auditLogEntry{
+		Time:       start.UTC().Format(time.RFC3339Nano),
+		Method:     req.Method,
+		Path:       req.URL.EscapedPath(),
+		DurationMS: time.Since(start).Milliseconds(),
+	}
+	if resp != nil {
+		entry.Status = resp.StatusCode
+		// GitLab returns the correlation ID of a request in the X-Request-Id header.
+		entry.CorrelationID = resp.Header.Get("X-Request-Id")
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	// NOTE: the request has already been processed by GitLab, thus it doesn't fail if the audit log can't be written.
+	if logErr := t.write(entry); logErr != nil {
+		log.Printf("[WARN] failed to write audit log entry for %s %s: %v", entry.Method, entry.Path, logErr)
+	}
+	return resp, err
+}
+
+func (t *auditLogTransport) write(entry auditLogEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, err = t.log.Write(line)
+	return err
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestConfig_AuditLogFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "correlation-id")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	auditLogFile := filepath.Join(t.TempDir(), "audit.log")
+	config := Config{
+		Token:        "token",
+		BaseURL:      server.URL + "/api/v4/",
+		AuditLogFile: auditLogFile,
+	}
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	for _, project := range []string{"foo/bar", "foo/baz"} {
+		if _, _, err := client.Projects.GetProject(project, &gitlab.GetProjectOptions{}); err == nil {
+			t.Fatal("expected the request to fail")
+		}
+	}
+
+	file, err := os.Open(auditLogFile)
+	if err != nil {
+		t.Fatalf("failed to open audit log file: %v", err)
+	}
+	defer file.Close()
+
+	var entries []auditLogEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry auditLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("failed to decode audit log entry %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("failed to read audit log file: %v", err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("got %d audit log entries, expected 2", len(entries))
+	}
+	entry := entries[1]
+	if entry.Method != http.MethodGet || entry.Path != "/api/v4/projects/foo%2Fbaz" || entry.Status != http.StatusNotFound || entry.CorrelationID != "correlation-id" {
+		t.Fatalf("got unexpected audit log entry %+v", entry)
+	}
+	if entry.Time == "" {
+		t.Fatal("expected the time of the request to be logged")
+	}
+}
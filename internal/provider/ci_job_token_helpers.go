@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+// jobTokenSupportedDataSources are the data sources which only use API endpoints a CI/CD job token can access.
+// See https://docs.gitlab.com/ee/ci/jobs/ci_job_token.html
+var jobTokenSupportedDataSources = []string{
+	"gitlab_project_environments",
+	"gitlab_release",
+	"gitlab_releases",
+}
+
+// gitlabJobTokenClients holds the *gitlab.Client of every provider configuration which authenticates
+// with a CI/CD job token, because the provider meta is the go-gitlab client itself.
+var gitlabJobTokenClients sync.Map
+
+func registerGitlabJobTokenClient(client *gitlab.Client) {
+	gitlabJobTokenClients.Store(client, true)
+}
+
+// usesGitlabJobToken returns whether the given provider meta authenticates with a CI/CD job token.
+func usesGitlabJobToken(meta interface{}) bool {
+	client, ok := meta.(*gitlab.Client)
+	if !ok {
+		return false
+	}
+	_, ok = gitlabJobTokenClients.Load(client)
+	return ok
+}
+
+// guardJobTokenUnsupported makes the given data source or resource fail with a descriptive error,
+// instead of an authorization error of the GitLab API, when it's used with a provider configuration
+// which authenticates with a CI/CD job token.
+// Data sources in jobTokenSupportedDataSources are returned as they are.
+func guardJobTokenUnsupported(resourceType, name string, r *schema.Resource) *schema.Resource {
+	if resourceType == "data source" {
+		for _, supported := range jobTokenSupportedDataSources {
+			if name == supported {
+				return r
+			}
+		}
+	}
+
+	err := jobTokenUnsupportedError(resourceType, name)
+	guard := func(fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if fn == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if usesGitlabJobToken(meta) {
+				return diag.FromErr(err)
+			}
+			return fn(ctx, d, meta)
+		}
+	}
+
+	r.CreateContext = guard(r.CreateContext)
+	r.ReadContext = guard(r.ReadContext)
+	r.UpdateContext = guard(r.UpdateContext)
+	r.DeleteContext = guard(r.DeleteContext)
+
+	// NOTE: resources are checked at plan time already, not only once they are applied.
+	if resourceType == "resource" {
+		customizeDiff := r.CustomizeDiff
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			if usesGitlabJobToken(meta) {
+				return err
+			}
+			if customizeDiff == nil {
+				return nil
+			}
+			return customizeDiff(ctx, diff, meta)
+		}
+	}
+
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		importState := r.Importer.StateContext
+		r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if usesGitlabJobToken(meta) {
+				return nil, err
+			}
+			return importState(ctx, d, meta)
+		}
+	}
+
+	return r
+}
+
+func jobTokenUnsupportedError(resourceType, name string) error {
+	return fmt.Errorf("the %s %s requires GitLab API endpoints which a CI/CD job token can't access: configure `token` or `oauth_client_id` in the provider instead of `ci_job_token`", resourceType, name)
+}
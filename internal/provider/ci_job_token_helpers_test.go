@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestGuardJobTokenUnsupported(t *testing.T) {
+	p := New("dev")()
+
+	for _, name := range jobTokenSupportedDataSources {
+		if _, ok := p.DataSourcesMap[name]; !ok {
+			t.Fatalf("supported data source %s is not registered", name)
+		}
+	}
+
+	jobTokenClient, err := gitlab.NewJobClient("job-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	registerGitlabJobTokenClient(jobTokenClient)
+
+	if diags := p.DataSourcesMap["gitlab_project"].ReadContext(context.Background(), nil, jobTokenClient); !diags.HasError() {
+		t.Fatal("expected the unsupported data source gitlab_project to fail with a job token")
+	}
+	if err := p.ResourcesMap["gitlab_project"].CustomizeDiff(context.Background(), nil, jobTokenClient); err == nil {
+		t.Fatal("expected the plan of the unsupported resource gitlab_project to fail with a job token")
+	}
+	if diags := p.ResourcesMap["gitlab_project"].DeleteContext(context.Background(), nil, jobTokenClient); !diags.HasError() {
+		t.Fatal("expected the delete of the unsupported resource gitlab_project to fail with a job token")
+	}
+	if _, err := p.ResourcesMap["gitlab_project"].Importer.StateContext(context.Background(), nil, jobTokenClient); err == nil {
+		t.Fatal("expected the import of the unsupported resource gitlab_project to fail with a job token")
+	}
+
+	client, err := gitlab.NewClient("token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usesGitlabJobToken(client) {
+		t.Fatal("expected a client with a personal access token to not use a job token")
+	}
+	if !usesGitlabJobToken(jobTokenClient) {
+		t.Fatal("expected the registered client to use a job token")
+	}
+}
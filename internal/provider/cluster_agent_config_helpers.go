@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	gitlab "github.com/xanzy/go-gitlab"
+	"gopkg.in/yaml.v3"
+)
+
+// gitlabClusterAgentAccessEntry represents a project or group entry
+// of the `ci_access` and `user_access` sections of an agent configuration file.
+type gitlabClusterAgentAccessEntry struct {
+	ID               string `yaml:"id"`
+	DefaultNamespace string `yaml:"default_namespace,omitempty"`
+}
+
+// gitlabClusterAgentConfigPath returns the path of the configuration file of an agent.
+func gitlabClusterAgentConfigPath(agentName string) string {
+	return fmt.Sprintf(".gitlab/agents/%s/config.yaml", agentName)
+}
+
+// gitlabClusterAgentConfigDefaultBranch returns the default branch of the agent configuration project.
+func gitlabClusterAgentConfigDefaultBranch(ctx context.Context, client *gitlab.Client, project string) (string, error) {
+	p, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	return p.DefaultBranch, nil
+}
+
+// getGitlabClusterAgentConfig fetches and parses the configuration file of an agent.
+// It returns a nil document if the file doesn't exist.
+func getGitlabClusterAgentConfig(ctx context.Context, client *gitlab.Client, project, branch, agentName string) (*yaml.Node, error) {
+	options := &gitlab.GetFileOptions{Ref: gitlab.String(branch)}
+	file, _, err := client.RepositoryFiles.GetFile(project, gitlabClusterAgentConfigPath(agentName), options, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	content, err := base64.StdEncoding.DecodeString(file.Content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode configuration file of agent %s: %w", agentName, err)
+	}
+
+	doc := new(yaml.Node)
+	if err := yaml.Unmarshal(content, doc); err != nil {
+		return nil, fmt.Errorf("failed to parse configuration file of agent %s: %w", agentName, err)
+	}
+	return doc, nil
+}
+
+// getGitlabClusterAgentConfigSection decodes the given top-level section of the configuration file of an agent.
+// It returns false if the configuration file or the section doesn't exist.
+func getGitlabClusterAgentConfigSection(ctx context.Context, client *gitlab.Client, project, branch, agentName, section string, value interface{}) (bool, error) {
+	doc, err := getGitlabClusterAgentConfig(ctx, client, project, branch, agentName)
+	if err != nil || doc == nil {
+		return false, err
+	}
+
+	mapping := gitlabClusterAgentConfigMapping(doc)
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == section {
+			return true, mapping.Content[i+1].Decode(value)
+		}
+	}
+	return false, nil
+}
+
+// setGitlabClusterAgentConfigSection sets or, if the value is nil, removes the given top-level section
+// of the configuration file of an agent and commits the file. All other sections are kept as-is.
+func setGitlabClusterAgentConfigSection(ctx context.Context, client *gitlab.Client, project, branch, agentName, section string, value interface{}, commitMessage string) error {
+	// NOTE: we share the lock with the `gitlab_repository_file` resource, see its documentation.
+	if err := resourceGitlabRepositoryFileApiLock.lock(ctx); err != nil {
+		return err
+	}
+	defer resourceGitlabRepositoryFileApiLock.unlock()
+
+	doc, err := getGitlabClusterAgentConfig(ctx, client, project, branch, agentName)
+	if err != nil {
+		return err
+	}
+	exists := doc != nil
+	if !exists {
+		if value == nil {
+			return nil
+		}
+		doc = &yaml.Node{Kind: yaml.DocumentNode}
+	}
+
+	mapping := gitlabClusterAgentConfigMapping(doc)
+	index := -1
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == section {
+			index = i
+			break
+		}
+	}
+
+	switch {
+	case value == nil && index == -1:
+		return nil
+	case value == nil:
+		mapping.Content = append(mapping.Content[:index], mapping.Content[index+2:]...)
+	default:
+		valueNode := new(yaml.Node)
+		if err := valueNode.Encode(value); err != nil {
+			return err
+		}
+		if index == -1 {
+			mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: section}, valueNode)
+		} else {
+			mapping.Content[index+1] = valueNode
+		}
+	}
+
+	var content bytes.Buffer
+	encoder := yaml.NewEncoder(&content)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+	encodedContent := base64.StdEncoding.EncodeToString(content.Bytes())
+	filePath := gitlabClusterAgentConfigPath(agentName)
+
+	log.Printf("[DEBUG] commit %s section of configuration file of agent %s in gitlab project %s", section, agentName, project)
+
+	if exists {
+		_, _, err = client.RepositoryFiles.UpdateFile(project, filePath, &gitlab.UpdateFileOptions{
+			Branch:        gitlab.String(branch),
+			Encoding:      gitlab.String("base64"),
+			Content:       gitlab.String(encodedContent),
+			CommitMessage: gitlab.String(commitMessage),
+		}, gitlab.WithContext(ctx))
+	} else {
+		_, _, err = client.RepositoryFiles.CreateFile(project, filePath, &gitlab.CreateFileOptions{
+			Branch:        gitlab.String(branch),
+			Encoding:      gitlab.String("base64"),
+			Content:       gitlab.String(encodedContent),
+			CommitMessage: gitlab.String(commitMessage),
+		}, gitlab.WithContext(ctx))
+	}
+	return err
+}
+
+// gitlabClusterAgentConfigMapping returns the top-level mapping of the given document,
+// creating it if the document is empty.
+func gitlabClusterAgentConfigMapping(doc *yaml.Node) *yaml.Node {
+	doc.Kind = yaml.DocumentNode
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		doc.Content = []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}
+	}
+	return doc.Content[0]
+}
@@ -1,14 +1,28 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"math"
 	"net/http"
+	"net/url"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/xanzy/go-gitlab"
+	"golang.org/x/net/http/httpproxy"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"golang.org/x/time/rate"
 )
 
 // Config is per-provider, specifies where to connect to gitlab
@@ -16,10 +30,44 @@ type Config struct {
 	Token         string
 	BaseURL       string
 	Insecure      bool
+	ProxyURL      string
+	NoProxy       string
 	CACertFile    string
+	CACertPEM     string
 	ClientCert    string
 	ClientKey     string
 	EarlyAuthFail bool
+
+	// OAuth application used to obtain and refresh access tokens instead of using Token.
+	OAuthClientID     string
+	OAuthClientSecret string
+	OAuthRefreshToken string
+	OAuthScopes       []string
+
+	// CI/CD job token, only used if neither Token nor OAuthClientID are set.
+	JobToken string
+
+	// ID or username of the user to impersonate with every request.
+	Sudo string
+
+	// Additional headers to send with every request.
+	Headers map[string]string
+
+	// Path of the file to write the audit log of all requests to, disabled if empty.
+	AuditLogFile string
+
+	// Timeout of a single request, disabled if zero.
+	RequestTimeout time.Duration
+
+	// Retry policy of the requests. The go-gitlab retry policy and wait times are used by default.
+	Retries                     int
+	RetryOnlyIdempotentRequests bool
+	MinRetryWaitSeconds         int
+	MaxRetryWaitSeconds         int
+
+	// Client-side rate limit of the requests, disabled if RequestsPerSecond is zero.
+	RequestsPerSecond float64
+	RequestsBurst     int
 }
 
 // Client returns a *gitlab.Client to interact with the configured gitlab instance
@@ -27,15 +75,21 @@ func (c *Config) Client(ctx context.Context) (*gitlab.Client, error) {
 	// Configure TLS/SSL
 	tlsConfig := &tls.Config{}
 
-	// If a CACertFile has been specified, use that for cert validation
-	if c.CACertFile != "" {
-		caCert, err := ioutil.ReadFile(c.CACertFile)
-		if err != nil {
-			return nil, err
+	// If a CACertFile or CACertPEM has been specified, use that for cert validation
+	if c.CACertFile != "" || c.CACertPEM != "" {
+		caCert := []byte(c.CACertPEM)
+		if c.CACertFile != "" {
+			var err error
+			caCert, err = ioutil.ReadFile(c.CACertFile)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		caCertPool := x509.NewCertPool()
-		caCertPool.AppendCertsFromPEM(caCert)
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("the ca cert doesn't contain any valid PEM encoded certificate")
+		}
 		tlsConfig.RootCAs = caCertPool
 	}
 
@@ -46,7 +100,7 @@ func (c *Config) Client(ctx context.Context) (*gitlab.Client, error) {
 
 	// add client cert and key to connection
 	if c.ClientCert != "" && c.ClientKey != "" {
-		clientPair, err := tls.LoadX509KeyPair(c.ClientCert, c.ClientKey)
+		clientPair, err := loadClientCertificate(c.ClientCert, c.ClientKey)
 		if err != nil {
 			return nil, err
 		}
@@ -56,27 +110,95 @@ func (c *Config) Client(ctx context.Context) (*gitlab.Client, error) {
 	t := http.DefaultTransport.(*http.Transport).Clone()
 	t.TLSClientConfig = tlsConfig
 	t.MaxIdleConnsPerHost = 100
+	if c.ProxyURL != "" || c.NoProxy != "" {
+		t.Proxy = proxyFunc(c.ProxyURL, c.NoProxy)
+	}
+
+	var transport http.RoundTripper = t
+	if len(c.Headers) > 0 {
+		transport = &headersTransport{headers: c.Headers, base: t}
+	}
+	if c.AuditLogFile != "" {
+		auditLog, err := newAuditLogTransport(c.AuditLogFile, transport)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open audit log file: %w", err)
+		}
+		transport = auditLog
+	}
+
+	httpClient := &http.Client{
+		Transport: logging.NewTransport("GitLab", transport),
+		Timeout:   c.RequestTimeout,
+	}
 
 	opts := []gitlab.ClientOptionFunc{
-		gitlab.WithHTTPClient(
-			&http.Client{
-				Transport: logging.NewTransport("GitLab", t),
-			},
-		),
+		gitlab.WithHTTPClient(httpClient),
+		gitlab.WithCustomRetryMax(c.Retries),
+	}
+
+	if c.RetryOnlyIdempotentRequests {
+		opts = append(opts, gitlab.WithCustomRetry(retryHTTPCheck))
+	}
+
+	// NOTE: the go-gitlab backoff ignores the wait times for failed requests, thus we use our own if they are configured.
+	if c.MinRetryWaitSeconds > 0 || c.MaxRetryWaitSeconds > 0 {
+		opts = append(opts,
+			gitlab.WithCustomBackoff(retryHTTPBackoff),
+			gitlab.WithCustomRetryWaitMinMax(time.Duration(c.MinRetryWaitSeconds)*time.Second, time.Duration(c.MaxRetryWaitSeconds)*time.Second),
+		)
 	}
 
 	if c.BaseURL != "" {
 		opts = append(opts, gitlab.WithBaseURL(c.BaseURL))
 	}
 
+	if c.Sudo != "" {
+		opts = append(opts, gitlab.WithRequestOptions(gitlab.WithSudo(c.Sudo)))
+	}
+
+	// NOTE: without a custom limiter, go-gitlab adapts to the rate limit reported by GitLab.
+	if c.RequestsPerSecond > 0 {
+		burst := c.RequestsBurst
+		if burst == 0 {
+			burst = int(math.Ceil(c.RequestsPerSecond))
+		}
+		opts = append(opts, gitlab.WithCustomLimiter(rate.NewLimiter(rate.Limit(c.RequestsPerSecond), burst)))
+	}
+
 	// The OAuth method is also compatible with project/group/personal access and job tokens because they are all usable as Bearer tokens.
 	// Although the job token API access is very limited.
 	// see https://docs.gitlab.com/ee/api#authentication
+	if c.usesJobToken() {
+		client, err := gitlab.NewJobClient(c.JobToken, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		// The job token can't access the current user, thus we get the job of the token instead.
+		if c.EarlyAuthFail {
+			_, _, err = client.Jobs.GetJobTokensJob(&gitlab.GetJobTokensJobOptions{}, gitlab.WithContext(ctx))
+		}
+		return client, err
+	}
+
 	client, err := gitlab.NewOAuthClient(c.Token, opts...)
 	if err != nil {
 		return nil, err
 	}
 
+	// When an OAuth application is configured, the access token is obtained (and refreshed) by the transport,
+	// which overrides the Bearer authorization header set by go-gitlab.
+	if c.OAuthClientID != "" {
+		tokenSource, err := c.oauthTokenSource(client.BaseURL().String(), &http.Client{Transport: httpClient.Transport, Timeout: c.RequestTimeout})
+		if err != nil {
+			return nil, err
+		}
+		httpClient.Transport = &oauth2.Transport{
+			Source: tokenSource,
+			Base:   httpClient.Transport,
+		}
+	}
+
 	// Test the credentials by checking we can get information about the authenticated user.
 	if c.EarlyAuthFail {
 		_, _, err = client.Users.CurrentUser(gitlab.WithContext(ctx))
@@ -84,3 +206,170 @@ func (c *Config) Client(ctx context.Context) (*gitlab.Client, error) {
 
 	return client, err
 }
+
+// headersTransport sets additional headers on every request, including the ones requesting OAuth tokens.
+type headersTransport struct {
+	headers map[string]string
+	base    http.RoundTripper
+}
+
+func (t *headersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// NOTE: a round tripper mustn't modify the given request.
+	req = req.Clone(req.Context())
+	for name, value := range t.headers {
+		req.Header.Set(name, value)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// retryHTTPCheck decides whether a request is retried. Contrary to the go-gitlab default, which retries all failed
+// requests, only rate limited requests and failed idempotent requests are retried, because a failed non-idempotent
+// request may have been processed by GitLab nonetheless.
+func retryHTTPCheck(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true, nil
+	}
+	if resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented {
+		switch resp.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return true, nil
+		}
+		if isIdempotentRequest(ctx) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type idempotentRequestKey struct{}
+
+// withIdempotentRequest marks the requests sent with the returned context as idempotent, even though their method isn't,
+// e.g. GraphQL queries, which are sent as POST requests.
+func withIdempotentRequest(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentRequestKey{}, true)
+}
+
+func isIdempotentRequest(ctx context.Context) bool {
+	idempotent, _ := ctx.Value(idempotentRequestKey{}).(bool)
+	return idempotent
+}
+
+// retryHTTPBackoff returns the time to wait before retrying a request. Rate limited requests are retried after the time
+// requested by GitLab in the Retry-After or RateLimit-Reset headers, all others with an exponential backoff.
+func retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests && resp.Header.Get("Retry-After") == "" {
+		if reset, err := strconv.ParseInt(resp.Header.Get("RateLimit-Reset"), 10, 64); err == nil && reset > 0 {
+			if wait := time.Until(time.Unix(reset, 0)); wait > min {
+				return wait
+			}
+		}
+	}
+
+	// NOTE: the default backoff honors the Retry-After header and otherwise computes an exponential backoff.
+	return retryablehttp.DefaultBackoff(min, max, attemptNum, resp)
+}
+
+// proxyFunc returns the proxy function of the HTTP transport for the given proxy URL and no proxy list.
+// If any of them is empty, the respective environment variables are used instead.
+func proxyFunc(proxyURL, noProxy string) func(*http.Request) (*url.URL, error) {
+	config := httpproxy.FromEnvironment()
+	if proxyURL != "" {
+		config.HTTPProxy = proxyURL
+		config.HTTPSProxy = proxyURL
+	}
+	if noProxy != "" {
+		config.NoProxy = noProxy
+	}
+
+	proxy := config.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}
+}
+
+// loadClientCertificate loads the client certificate and key for mutual TLS.
+// Each of them may either be a file path or the PEM encoded data itself.
+func loadClientCertificate(cert, key string) (tls.Certificate, error) {
+	certPEM, err := readPEMOrFile(cert)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to read client certificate: %v", err)
+	}
+	keyPEM, err := readPEMOrFile(key)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to read client key: %v", err)
+	}
+	return tls.X509KeyPair(certPEM, keyPEM)
+}
+
+// readPEMOrFile returns the given value if it is PEM encoded data, otherwise the content of the file at the given path.
+func readPEMOrFile(value string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return ioutil.ReadFile(value)
+}
+
+// usesJobToken returns whether the client authenticates with the CI/CD job token.
+func (c *Config) usesJobToken() bool {
+	return c.Token == "" && c.OAuthClientID == "" && c.JobToken != ""
+}
+
+// oauthTokenSource returns the source of the OAuth access tokens for the configured OAuth application.
+// The tokens are requested from the GitLab instance of the given API base URL, using the given HTTP client.
+func (c *Config) oauthTokenSource(baseURL string, httpClient *http.Client) (oauth2.TokenSource, error) {
+	tokenURL := strings.TrimSuffix(baseURL, "api/v4/") + "oauth/token"
+
+	// NOTE: the token source outlives the context the provider is configured with,
+	//       thus it mustn't be used to refresh the tokens.
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
+
+	if c.OAuthRefreshToken != "" {
+		config := &oauth2.Config{
+			ClientID:     c.OAuthClientID,
+			ClientSecret: c.OAuthClientSecret,
+			Endpoint: oauth2.Endpoint{
+				TokenURL:  tokenURL,
+				AuthStyle: oauth2.AuthStyleInParams,
+			},
+		}
+		// NOTE: the initial token has no access token, so that the refresh token is exchanged for one on the first request.
+		//       Afterwards, the access token is refreshed according to the expiry returned by GitLab.
+		return config.TokenSource(ctx, &oauth2.Token{RefreshToken: c.OAuthRefreshToken}), nil
+	}
+
+	if c.OAuthClientSecret == "" {
+		return nil, errors.New("the client credentials flow requires `oauth_client_secret` to be configured, or configure `oauth_refresh_token` instead")
+	}
+	config := &clientcredentials.Config{
+		ClientID:     c.OAuthClientID,
+		ClientSecret: c.OAuthClientSecret,
+		TokenURL:     tokenURL,
+		Scopes:       c.OAuthScopes,
+		AuthStyle:    oauth2.AuthStyleInParams,
+	}
+	return config.TokenSource(ctx), nil
+}
+
+// tokenFromCommand executes the given command and returns its standard output as token.
+func tokenFromCommand(ctx context.Context, command []string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("failed to execute token command %q: %v: %s", command[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("the token command %q didn't print a token", command[0])
+	}
+	return token, nil
+}
@@ -0,0 +1,513 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestConfig_OAuthTokenSource(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Config        Config
+		WantGrantType string
+	}{
+		{
+			Name: "refresh token",
+			Config: Config{
+				OAuthClientID:     "client-id",
+				OAuthRefreshToken: "refresh-token",
+			},
+			WantGrantType: "refresh_token",
+		},
+		{
+			Name: "client credentials",
+			Config: Config{
+				OAuthClientID:     "client-id",
+				OAuthClientSecret: "client-secret",
+			},
+			WantGrantType: "client_credentials",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tokenRequests := 0
+			mux := http.NewServeMux()
+			mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+				tokenRequests++
+				if got := r.FormValue("grant_type"); got != tc.WantGrantType {
+					t.Errorf("got grant_type %q, expected %q", got, tc.WantGrantType)
+				}
+				if got := r.FormValue("client_id"); got != tc.Config.OAuthClientID {
+					t.Errorf("got client_id %q, expected %q", got, tc.Config.OAuthClientID)
+				}
+				if got := r.FormValue("refresh_token"); got != tc.Config.OAuthRefreshToken {
+					t.Errorf("got refresh_token %q, expected %q", got, tc.Config.OAuthRefreshToken)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"access_token": "access-token", "token_type": "Bearer", "expires_in": 7200}`)
+			})
+			mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != "Bearer access-token" {
+					t.Errorf("got Authorization header %q, expected %q", got, "Bearer access-token")
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"id": 1}`)
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			config := tc.Config
+			config.BaseURL = server.URL + "/api/v4/"
+			config.EarlyAuthFail = true
+
+			client, err := config.Client(context.Background())
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+			// The access token is requested once and reused until it expires.
+			if _, _, err := client.Users.CurrentUser(); err != nil {
+				t.Fatalf("failed to get current user: %v", err)
+			}
+			if tokenRequests != 1 {
+				t.Fatalf("got %d token requests, expected 1", tokenRequests)
+			}
+		})
+	}
+}
+
+func TestConfig_OAuthClientCredentialsRequireSecret(t *testing.T) {
+	config := Config{
+		BaseURL:       "https://gitlab.example.com/api/v4/",
+		OAuthClientID: "client-id",
+	}
+	if _, err := config.Client(context.Background()); err == nil {
+		t.Fatal("expected an error for the client credentials flow without a client secret")
+	}
+}
+
+func TestConfig_JobToken(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/job", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("JOB-TOKEN"); got != "job-token" {
+			t.Errorf("got JOB-TOKEN header %q, expected %q", got, "job-token")
+		}
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("got unexpected Authorization header %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := Config{
+		BaseURL:       server.URL + "/api/v4/",
+		JobToken:      "job-token",
+		EarlyAuthFail: true,
+	}
+	if !config.usesJobToken() {
+		t.Fatal("expected the job token to be used")
+	}
+	if _, err := config.Client(context.Background()); err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	config.Token = "token"
+	if config.usesJobToken() {
+		t.Fatal("expected the token to take precedence over the job token")
+	}
+}
+
+func TestTokenFromCommand(t *testing.T) {
+	token, err := tokenFromCommand(context.Background(), []string{"echo", " secret-token "})
+	if err != nil {
+		t.Fatalf("failed to execute token command: %v", err)
+	}
+	if token != "secret-token" {
+		t.Fatalf("got token %q, expected %q", token, "secret-token")
+	}
+
+	if _, err := tokenFromCommand(context.Background(), []string{"echo"}); err == nil {
+		t.Fatal("expected an error for a command without output")
+	}
+	if _, err := tokenFromCommand(context.Background(), []string{"false"}); err == nil {
+		t.Fatal("expected an error for a failing command")
+	}
+}
+
+func TestLoadClientCertificate(t *testing.T) {
+	certPEM, keyPEM := testGenerateCertificate(t)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+	if err := os.WriteFile(certFile, certPEM, 0600); err != nil {
+		t.Fatalf("failed to write certificate: %v", err)
+	}
+	if err := os.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+
+	cases := []struct {
+		Name string
+		Cert string
+		Key  string
+	}{
+		{
+			Name: "files",
+			Cert: certFile,
+			Key:  keyFile,
+		},
+		{
+			Name: "PEM content",
+			Cert: string(certPEM),
+			Key:  string(keyPEM),
+		},
+		{
+			Name: "PEM certificate and key file",
+			Cert: string(certPEM),
+			Key:  keyFile,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			cert, err := loadClientCertificate(tc.Cert, tc.Key)
+			if err != nil {
+				t.Fatalf("failed to load client certificate: %v", err)
+			}
+			if len(cert.Certificate) != 1 {
+				t.Fatalf("got %d certificates, expected 1", len(cert.Certificate))
+			}
+		})
+	}
+
+	if _, err := loadClientCertificate(filepath.Join(dir, "does-not-exist.crt"), keyFile); err == nil {
+		t.Fatal("expected an error for a missing certificate file")
+	}
+}
+
+// testGenerateCertificate generates a self-signed certificate and its key, both PEM encoded.
+func testGenerateCertificate(t *testing.T) (certPEM []byte, keyPEM []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-gitlab"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM
+}
+
+func TestConfig_CACertPEM(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:         "token",
+		BaseURL:       server.URL + "/api/v4/",
+		CACertPEM:     string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+		EarlyAuthFail: true,
+	}
+	if _, err := config.Client(context.Background()); err != nil {
+		t.Fatalf("failed to create client trusting the ca cert: %v", err)
+	}
+
+	config.CACertPEM = "invalid"
+	if _, err := config.Client(context.Background()); err == nil {
+		t.Fatal("expected an error for an invalid ca cert")
+	}
+}
+
+func TestProxyFunc(t *testing.T) {
+	proxy := proxyFunc("http://proxy.example.com:3128", "internal.example.com,10.0.0.0/8")
+
+	cases := []struct {
+		URL       string
+		WantProxy string
+	}{
+		{
+			URL:       "https://gitlab.example.com/api/v4/user",
+			WantProxy: "http://proxy.example.com:3128",
+		},
+		{
+			URL:       "http://gitlab.example.com/api/v4/user",
+			WantProxy: "http://proxy.example.com:3128",
+		},
+		{
+			URL: "https://gitlab.internal.example.com/api/v4/user",
+		},
+		{
+			URL: "https://10.1.2.3/api/v4/user",
+		},
+	}
+
+	for _, tc := range cases {
+		req, err := http.NewRequest(http.MethodGet, tc.URL, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		proxyURL, err := proxy(req)
+		if err != nil {
+			t.Fatalf("failed to get proxy for %s: %v", tc.URL, err)
+		}
+
+		got := ""
+		if proxyURL != nil {
+			got = proxyURL.String()
+		}
+		if got != tc.WantProxy {
+			t.Fatalf("got proxy %q for %s, expected %q", got, tc.URL, tc.WantProxy)
+		}
+	}
+}
+
+func TestConfig_Retries(t *testing.T) {
+	cases := []struct {
+		Name                        string
+		Method                      string
+		StatusCode                  int
+		RetryOnlyIdempotentRequests bool
+		WantRequests                int
+	}{
+		{
+			Name:         "rate limited GET",
+			Method:       http.MethodGet,
+			StatusCode:   http.StatusTooManyRequests,
+			WantRequests: 3,
+		},
+		{
+			Name:         "failed GET",
+			Method:       http.MethodGet,
+			StatusCode:   http.StatusBadGateway,
+			WantRequests: 3,
+		},
+		{
+			Name:         "failed POST",
+			Method:       http.MethodPost,
+			StatusCode:   http.StatusBadGateway,
+			WantRequests: 3,
+		},
+		{
+			Name:         "not found GET",
+			Method:       http.MethodGet,
+			StatusCode:   http.StatusNotFound,
+			WantRequests: 1,
+		},
+		{
+			Name:                        "rate limited POST with only idempotent requests",
+			Method:                      http.MethodPost,
+			StatusCode:                  http.StatusTooManyRequests,
+			RetryOnlyIdempotentRequests: true,
+			WantRequests:                3,
+		},
+		{
+			Name:                        "failed GET with only idempotent requests",
+			Method:                      http.MethodGet,
+			StatusCode:                  http.StatusBadGateway,
+			RetryOnlyIdempotentRequests: true,
+			WantRequests:                3,
+		},
+		{
+			Name:                        "failed POST with only idempotent requests",
+			Method:                      http.MethodPost,
+			StatusCode:                  http.StatusBadGateway,
+			RetryOnlyIdempotentRequests: true,
+			WantRequests:                1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tc.StatusCode)
+			}))
+			defer server.Close()
+
+			config := Config{
+				Token:                       "token",
+				BaseURL:                     server.URL + "/api/v4/",
+				Retries:                     2,
+				RetryOnlyIdempotentRequests: tc.RetryOnlyIdempotentRequests,
+			}
+			client, err := config.Client(context.Background())
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			req, err := client.NewRequest(tc.Method, "user", nil, nil)
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+			if _, err := client.Do(req, nil); err == nil {
+				t.Fatal("expected the request to fail")
+			}
+			if requests != tc.WantRequests {
+				t.Fatalf("got %d requests, expected %d", requests, tc.WantRequests)
+			}
+		})
+	}
+}
+
+func TestRetryHTTPBackoff(t *testing.T) {
+	min, max := time.Second, 30*time.Second
+
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "42")
+	if got := retryHTTPBackoff(min, max, 0, resp); got != 42*time.Second {
+		t.Fatalf("got wait %s for Retry-After, expected %s", got, 42*time.Second)
+	}
+
+	resp.Header.Del("Retry-After")
+	resp.Header.Set("RateLimit-Reset", fmt.Sprintf("%d", time.Now().Add(time.Minute).Unix()))
+	if got := retryHTTPBackoff(min, max, 0, resp); got < 50*time.Second || got > time.Minute {
+		t.Fatalf("got wait %s for RateLimit-Reset, expected about a minute", got)
+	}
+
+	resp = &http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}}
+	if got := retryHTTPBackoff(min, max, 2, resp); got != 4*time.Second {
+		t.Fatalf("got wait %s for the third retry, expected %s", got, 4*time.Second)
+	}
+	if got := retryHTTPBackoff(min, max, 10, resp); got != max {
+		t.Fatalf("got wait %s for the eleventh retry, expected %s", got, max)
+	}
+}
+
+func TestConfig_RequestsPerSecond(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:             "token",
+		BaseURL:           server.URL + "/api/v4/",
+		RequestsPerSecond: 20,
+		RequestsBurst:     1,
+	}
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, _, err := client.Users.CurrentUser(); err != nil {
+			t.Fatalf("failed to get current user: %v", err)
+		}
+	}
+	// The first request is sent immediately, the other four are throttled to 20 requests per second.
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Fatalf("sent 5 requests in %s, expected them to be throttled to about 200ms", elapsed)
+	}
+}
+
+func TestConfig_RequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:          "token",
+		BaseURL:        server.URL + "/api/v4/",
+		RequestTimeout: 50 * time.Millisecond,
+	}
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	if _, _, err := client.Users.CurrentUser(); err == nil {
+		t.Fatal("expected the request to time out")
+	}
+}
+
+func TestConfig_Sudo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Sudo"); got != "onboarded-user" {
+			t.Errorf("got Sudo header %q, expected %q", got, "onboarded-user")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:         "token",
+		BaseURL:       server.URL + "/api/v4/",
+		Sudo:          "onboarded-user",
+		EarlyAuthFail: true,
+	}
+	if _, err := config.Client(context.Background()); err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+}
+
+func TestConfig_Headers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Tenant"); got != "tenant-a" {
+			t.Errorf("got X-Tenant header %q, expected %q", got, "tenant-a")
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("got Authorization header %q, expected %q", got, "Bearer token")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 1}`)
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:         "token",
+		BaseURL:       server.URL + "/api/v4/",
+		Headers:       map[string]string{"X-Tenant": "tenant-a"},
+		EarlyAuthFail: true,
+	}
+	if _, err := config.Client(context.Background()); err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+}
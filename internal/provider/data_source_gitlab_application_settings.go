@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_application_settings", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_application_settings`" + ` data source allows to retrieve the GitLab application settings.
+
+-> This data source requires administration privileges, but a token with the ` + "`read_api`" + ` scope is sufficient.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/settings.html#get-current-application-settings)`,
+
+		ReadContext: dataSourceGitlabApplicationSettingsRead,
+		Schema:      datasourceSchemaFromResourceSchema(gitlabApplicationSettingsGetSchema(), nil, nil),
+	}
+})
+
+func dataSourceGitlabApplicationSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] read gitlab application settings")
+
+	settings, err := getApplicationSettings(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(applicationSettingsID)
+	if err := setApplicationSettingsToState(d, settings); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabApplicationSettings_basic(t *testing.T) {
+	testAccCheck(t)
+
+	settings, err := getApplicationSettings(context.Background(), testGitlabClient)
+	if err != nil {
+		t.Fatalf("failed to get application settings: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "gitlab_application_settings" "this" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_application_settings.this", "id", "gitlab"),
+					resource.TestCheckResourceAttr("data.gitlab_application_settings.this", "default_branch_name", fmt.Sprintf("%v", settings["default_branch_name"])),
+					resource.TestCheckResourceAttr("data.gitlab_application_settings.this", "max_artifacts_size", fmt.Sprintf("%v", settings["max_artifacts_size"])),
+					resource.TestCheckResourceAttr("data.gitlab_application_settings.this", "signup_enabled", fmt.Sprintf("%t", settings["signup_enabled"])),
+					resource.TestCheckResourceAttrSet("data.gitlab_application_settings.this", "restricted_visibility_levels.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_cluster_agents", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_cluster_agents`" + ` data source allows to retrieve the GitLab Agents for Kubernetes registered for a project.
+
+-> Use the ` + "`agent_id`" + ` of an agent registered by other tooling to manage its tokens with the ` + "`gitlab_cluster_agent_token`" + ` resource.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/cluster_agents.html#list-the-agents-for-a-project)`,
+
+		ReadContext: dataSourceGitlabClusterAgentsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project owned by the authenticated user.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"cluster_agents": {
+				Description: "List of the registered agents.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agent_id": {
+							Description: "The ID of the agent.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the agent.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The time the agent has been registered, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_by_user_id": {
+							Description: "The ID of the user who registered the agent.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabClusterAgentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlab.ListAgentsOptions{
+		PerPage: 20,
+		Page:    1,
+	}
+
+	log.Printf("[DEBUG] list cluster agents of project %s", project)
+
+	var agents []*gitlab.Agent
+	for options.Page != 0 {
+		paginatedAgents, resp, err := client.ClusterAgents.ListAgents(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		agents = append(agents, paginatedAgents...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(project)
+	if err := d.Set("cluster_agents", flattenGitlabClusterAgents(agents)); err != nil {
+		return diag.Errorf("failed to set cluster agents to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabClusterAgents(agents []*gitlab.Agent) (values []map[string]interface{}) {
+	for _, agent := range agents {
+		v := map[string]interface{}{
+			"agent_id":           agent.ID,
+			"name":               agent.Name,
+			"created_by_user_id": agent.CreatedByUserID,
+		}
+		if agent.CreatedAt != nil {
+			v["created_at"] = agent.CreatedAt.Format(time.RFC3339)
+		}
+		values = append(values, v)
+	}
+	return values
+}
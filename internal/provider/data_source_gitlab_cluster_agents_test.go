@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabClusterAgents_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	currentUser := testAccCurrentUser(t)
+
+	var testAgents []*gitlab.Agent
+	for _, name := range []string{"agent-1", "agent-2"} {
+		agent, _, err := testGitlabClient.ClusterAgents.RegisterAgent(testProject.ID, &gitlab.RegisterAgentOptions{
+			Name: gitlab.String(name),
+		})
+		if err != nil {
+			t.Fatalf("could not register test cluster agent: %v", err)
+		}
+		testAgents = append(testAgents, agent)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_cluster_agents" "this" {
+						project = "%s"
+					}
+				`, testProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_cluster_agents.this", "cluster_agents.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_cluster_agents.this", "cluster_agents.*", map[string]string{
+						"agent_id":           fmt.Sprintf("%d", testAgents[0].ID),
+						"name":               "agent-1",
+						"created_by_user_id": fmt.Sprintf("%d", currentUser.ID),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_cluster_agents.this", "cluster_agents.*", map[string]string{
+						"agent_id": fmt.Sprintf("%d", testAgents[1].ID),
+						"name":     "agent-2",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_current_user", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_current_user`" + ` data source allows details of the user the provider is authenticated with to be retrieved.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/users.html#list-current-user) and [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#querycurrentuser)`,
+
+		ReadContext: dataSourceGitlabCurrentUserRead,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The ID of the user.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"global_id": {
+				Description: "The global ID of the user, as used by the GraphQL API.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"username": {
+				Description: "The username of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"public_email": {
+				Description: "The public email address of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"is_admin": {
+				Description: "Whether the user is an administrator of the instance.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"bot": {
+				Description: "Whether the user is a bot, e.g. a project or group access token user.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"namespace_id": {
+				Description: "The ID of the personal namespace of the user.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"group_count": {
+				Description: "The number of groups the user is a member of.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func dataSourceGitlabCurrentUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] read current user")
+	user, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// NOTE: the group count is only available in the GraphQL API.
+	var data struct {
+		CurrentUser *struct {
+			ID         string `json:"id"`
+			GroupCount int    `json:"groupCount"`
+		} `json:"currentUser"`
+	}
+	query := `query {
+		currentUser { id groupCount }
+	}`
+	if err := graphQLClientFor(client).query(ctx, query, nil, &data); err != nil {
+		return diag.FromErr(err)
+	}
+	if data.CurrentUser == nil {
+		return diag.Errorf("current user not found in GraphQL API")
+	}
+
+	d.SetId(fmt.Sprintf("%d", user.ID))
+	stateMap := map[string]interface{}{
+		"user_id":      user.ID,
+		"global_id":    data.CurrentUser.ID,
+		"username":     user.Username,
+		"name":         user.Name,
+		"public_email": user.PublicEmail,
+		"is_admin":     user.IsAdmin,
+		"bot":          user.Bot,
+		"namespace_id": user.NamespaceID,
+		"group_count":  data.CurrentUser.GroupCount,
+	}
+	if err := setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabCurrentUser_basic(t *testing.T) {
+	testAccCheck(t)
+
+	currentUser := testAccCurrentUser(t)
+	testAccCreateGroups(t, 2)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "gitlab_current_user" "this" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_current_user.this", "user_id", fmt.Sprintf("%d", currentUser.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_current_user.this", "global_id", fmt.Sprintf("gid://gitlab/User/%d", currentUser.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_current_user.this", "username", currentUser.Username),
+					resource.TestCheckResourceAttr("data.gitlab_current_user.this", "name", currentUser.Name),
+					resource.TestCheckResourceAttr("data.gitlab_current_user.this", "is_admin", fmt.Sprintf("%t", currentUser.IsAdmin)),
+					resource.TestCheckResourceAttr("data.gitlab_current_user.this", "bot", "false"),
+					resource.TestCheckResourceAttr("data.gitlab_current_user.this", "namespace_id", fmt.Sprintf("%d", currentUser.NamespaceID)),
+					resource.TestCheckResourceAttrSet("data.gitlab_current_user.this", "group_count"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_access_tokens", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_access_tokens`" + ` data source allows to retrieve the access tokens of a group.
+
+-> The token values themselves are never returned by the GitLab API.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_access_tokens.html#list-group-access-tokens)`,
+
+		ReadContext: dataSourceGitlabGroupAccessTokensRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"access_tokens": {
+				Description: "The list of access tokens of the group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: gitlabAccessTokensDataSourceTokenSchema("group"),
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabGroupAccessTokensRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	options := &gitlab.ListGroupAccessTokensOptions{
+		PerPage: 20,
+		Page:    1,
+	}
+
+	log.Printf("[DEBUG] list access tokens of group %s", group)
+
+	var accessTokens []*gitlab.GroupAccessToken
+	for options.Page != 0 {
+		paginatedAccessTokens, resp, err := client.GroupAccessTokens.ListGroupAccessTokens(group, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		accessTokens = append(accessTokens, paginatedAccessTokens...)
+		options.Page = resp.NextPage
+	}
+
+	var values []map[string]interface{}
+	for _, t := range accessTokens {
+		values = append(values, gitlabAccessTokensDataSourceTokenToStateMap(
+			t.ID, t.UserID, t.Name, t.Scopes, t.AccessLevel, t.CreatedAt, t.ExpiresAt, t.LastUsedAt, t.Active, t.Revoked,
+		))
+	}
+
+	d.SetId(group)
+	if err := d.Set("access_tokens", values); err != nil {
+		return diag.Errorf("failed to set access tokens to state: %v", err)
+	}
+	return nil
+}
+
+// gitlabAccessTokensDataSourceTokenSchema returns the schema of an access token
+// shared by the group and project access tokens data sources.
+func gitlabAccessTokensDataSourceTokenSchema(kind string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "The ID of the access token.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"user_id": {
+			Description: "The ID of the bot user associated with the access token.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The name of the access token.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"scopes": {
+			Description: "The scopes of the access token.",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"access_level": {
+			Description: fmt.Sprintf("The access level of the access token in the %s.", kind),
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created_at": {
+			Description: "The time the access token has been created, in RFC3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"expires_at": {
+			Description: "The date the access token expires, in `YYYY-MM-DD` format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_used_at": {
+			Description: "The time the access token has last been used, in RFC3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"active": {
+			Description: "Whether the access token is active, i.e. neither revoked nor expired.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"revoked": {
+			Description: "Whether the access token has been revoked.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	}
+}
+
+func gitlabAccessTokensDataSourceTokenToStateMap(id, userID int, name string, scopes []string, accessLevel gitlab.AccessLevelValue, createdAt *time.Time, expiresAt *gitlab.ISOTime, lastUsedAt *time.Time, active, revoked bool) map[string]interface{} {
+	stateMap := map[string]interface{}{
+		"id":           id,
+		"user_id":      userID,
+		"name":         name,
+		"scopes":       scopes,
+		"access_level": accessLevelValueToName[accessLevel],
+		"active":       active,
+		"revoked":      revoked,
+	}
+	if createdAt != nil {
+		stateMap["created_at"] = createdAt.Format(time.RFC3339)
+	}
+	if expiresAt != nil {
+		stateMap["expires_at"] = expiresAt.String()
+	}
+	if lastUsedAt != nil {
+		stateMap["last_used_at"] = lastUsedAt.Format(time.RFC3339)
+	}
+	return stateMap
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabGroupAccessTokens_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	expiresAt := gitlab.ISOTime(time.Now().AddDate(0, 1, 0))
+	accessToken, _, err := testGitlabClient.GroupAccessTokens.CreateGroupAccessToken(testGroup.ID, &gitlab.CreateGroupAccessTokenOptions{
+		Name:        gitlab.String("acctest-token"),
+		Scopes:      &[]string{"read_api", "read_repository"},
+		AccessLevel: gitlab.AccessLevel(gitlab.DeveloperPermissions),
+		ExpiresAt:   &expiresAt,
+	})
+	if err != nil {
+		t.Fatalf("could not create test group access token: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_access_tokens" "this" {
+						group = "%s"
+					}
+				`, testGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_access_tokens.this", "access_tokens.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group_access_tokens.this", "access_tokens.0.id", fmt.Sprintf("%d", accessToken.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_group_access_tokens.this", "access_tokens.0.user_id", fmt.Sprintf("%d", accessToken.UserID)),
+					resource.TestCheckResourceAttr("data.gitlab_group_access_tokens.this", "access_tokens.0.name", "acctest-token"),
+					resource.TestCheckResourceAttr("data.gitlab_group_access_tokens.this", "access_tokens.0.scopes.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.gitlab_group_access_tokens.this", "access_tokens.0.scopes.*", "read_api"),
+					resource.TestCheckResourceAttr("data.gitlab_group_access_tokens.this", "access_tokens.0.access_level", "developer"),
+					resource.TestCheckResourceAttr("data.gitlab_group_access_tokens.this", "access_tokens.0.expires_at", expiresAt.String()),
+					resource.TestCheckResourceAttr("data.gitlab_group_access_tokens.this", "access_tokens.0.active", "true"),
+					resource.TestCheckResourceAttr("data.gitlab_group_access_tokens.this", "access_tokens.0.revoked", "false"),
+				),
+			},
+		},
+	})
+}
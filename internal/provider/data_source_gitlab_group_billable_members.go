@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var validBillableMembersSortValues = []string{
+	"access_level_asc", "access_level_desc", "last_joined", "name_asc", "name_desc",
+	"oldest_joined", "oldest_sign_in", "recent_sign_in", "last_activity_on_asc", "last_activity_on_desc",
+}
+
+var _ = registerDataSource("gitlab_group_billable_members", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_billable_members`" + ` data source allows to retrieve the billable members of a top-level group, together with their memberships in the group hierarchy.
+
+-> The memberships are retrieved with an additional API request per billable member.
+
+~> This data source requires GitLab Premium or higher and the Owner role in the group.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/members.html#list-all-billable-members-of-a-group)`,
+
+		ReadContext: dataSourceGitlabGroupBillableMembersRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the top-level group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"search": {
+				Description: "Filter the billable members by name, username or public email.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"sort": {
+				Description:      fmt.Sprintf("Sort the billable members. Valid values are: %s.", renderValueListForDocs(validBillableMembersSortValues)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validBillableMembersSortValues, false)),
+			},
+			"billable_members": {
+				Description: "The list of billable members of the group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the user.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"username": {
+							Description: "The username of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"state": {
+							Description: "The state of the user, e.g. `active` or `blocked`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"web_url": {
+							Description: "The URL of the user's profile.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "The public email address of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"membership_type": {
+							Description: "How the user is a member of the group hierarchy, one of `group_member`, `project_member`, `group_invite` or `project_invite`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"removable": {
+							Description: "Whether the user can be removed from the group.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"is_last_owner": {
+							Description: "Whether the user is the last owner of the group.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The time the user has been created, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_activity_on": {
+							Description: "The date of the last activity of the user, in `YYYY-MM-DD` format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_login_at": {
+							Description: "The time of the last login of the user, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"memberships": {
+							Description: "The direct memberships of the user in the group hierarchy. Empty for members invited via a group share.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Description: "The ID of the membership.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"source_id": {
+										Description: "The ID of the group or project of the membership.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"source_full_name": {
+										Description: "The full name of the group or project of the membership.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"source_members_url": {
+										Description: "The URL of the members page of the group or project of the membership.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"access_level": {
+										Description: "The access level of the membership.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"created_at": {
+										Description: "The time the membership has been created, in RFC3339 format.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"expires_at": {
+										Description: "The time the membership expires, in RFC3339 format.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabGroupBillableMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	options := &gitlab.ListBillableGroupMembersOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+	}
+	if v, ok := d.GetOk("search"); ok {
+		options.Search = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("sort"); ok {
+		options.Sort = gitlab.String(v.(string))
+	}
+
+	optionsHash, err := hashstructure.Hash(options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list billable members of group %s", group)
+
+	var members []*gitlab.BillableGroupMember
+	for options.Page != 0 {
+		paginatedMembers, resp, err := client.Groups.ListBillableGroupMembers(group, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		members = append(members, paginatedMembers...)
+		options.Page = resp.NextPage
+	}
+
+	var values []map[string]interface{}
+	for _, member := range members {
+		var memberships []*gitlab.BillableUserMembership
+		// NOTE: only direct members of the group hierarchy have memberships, invited members have access via a group share.
+		if member.MembershipType == "group_member" || member.MembershipType == "project_member" {
+			log.Printf("[DEBUG] list memberships of billable member %d of group %s", member.ID, group)
+			memberships, err = dataSourceGitlabGroupBillableMembersListMemberships(ctx, client, group, member.ID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		values = append(values, gitlabBillableGroupMemberToStateMap(member, memberships))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", group, optionsHash))
+	if err := d.Set("billable_members", values); err != nil {
+		return diag.Errorf("failed to set billable members to state: %v", err)
+	}
+	return nil
+}
+
+func dataSourceGitlabGroupBillableMembersListMemberships(ctx context.Context, client *gitlab.Client, group string, user int) ([]*gitlab.BillableUserMembership, error) {
+	options := &gitlab.ListMembershipsForBillableGroupMemberOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	var memberships []*gitlab.BillableUserMembership
+	for options.Page != 0 {
+		paginatedMemberships, resp, err := client.Groups.ListMembershipsForBillableGroupMember(group, user, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		memberships = append(memberships, paginatedMemberships...)
+		options.Page = resp.NextPage
+	}
+	return memberships, nil
+}
+
+func gitlabBillableGroupMemberToStateMap(member *gitlab.BillableGroupMember, memberships []*gitlab.BillableUserMembership) map[string]interface{} {
+	stateMap := map[string]interface{}{
+		"id":              member.ID,
+		"username":        member.Username,
+		"name":            member.Name,
+		"state":           member.State,
+		"web_url":         member.WebURL,
+		"email":           member.Email,
+		"membership_type": member.MembershipType,
+		"removable":       member.Removable,
+		"is_last_owner":   member.IsLastOwner,
+	}
+	if member.CreatedAt != nil {
+		stateMap["created_at"] = member.CreatedAt.Format(time.RFC3339)
+	}
+	if member.LastActivityOn != nil {
+		stateMap["last_activity_on"] = member.LastActivityOn.String()
+	}
+	if member.LastLoginAt != nil {
+		stateMap["last_login_at"] = member.LastLoginAt.Format(time.RFC3339)
+	}
+
+	var membershipValues []map[string]interface{}
+	for _, membership := range memberships {
+		v := map[string]interface{}{
+			"id":                 membership.ID,
+			"source_id":          membership.SourceID,
+			"source_full_name":   membership.SourceFullName,
+			"source_members_url": membership.SourceMembersURL,
+		}
+		if membership.AccessLevel != nil {
+			v["access_level"] = accessLevelValueToName[membership.AccessLevel.IntegerValue]
+		}
+		if membership.CreatedAt != nil {
+			v["created_at"] = membership.CreatedAt.Format(time.RFC3339)
+		}
+		if membership.ExpiresAt != nil {
+			v["expires_at"] = membership.ExpiresAt.Format(time.RFC3339)
+		}
+		membershipValues = append(membershipValues, v)
+	}
+	stateMap["memberships"] = membershipValues
+
+	return stateMap
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabGroupBillableMembers_basic(t *testing.T) {
+	testAccCheck(t)
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	testUsers := testAccCreateUsers(t, 2)
+	testAccAddGroupMembers(t, testGroup.ID, testUsers)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_billable_members" "this" {
+						group = "%s"
+					}
+				`, testGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_group_billable_members.this", "billable_members.*", map[string]string{
+						"id":                             fmt.Sprintf("%d", testUsers[0].ID),
+						"username":                       testUsers[0].Username,
+						"membership_type":                "group_member",
+						"memberships.#":                  "1",
+						"memberships.0.source_id":        fmt.Sprintf("%d", testGroup.ID),
+						"memberships.0.source_full_name": testGroup.Name,
+						"memberships.0.access_level":     "developer",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_group_billable_members.this", "billable_members.*", map[string]string{
+						"id":       fmt.Sprintf("%d", testUsers[1].ID),
+						"username": testUsers[1].Username,
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_billable_members" "this" {
+						group  = "%s"
+						search = "%s"
+					}
+				`, testGroup.FullPath, testUsers[0].Username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_billable_members.this", "billable_members.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group_billable_members.this", "billable_members.0.username", testUsers[0].Username),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_compliance_frameworks", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_compliance_frameworks`" + ` data source allows to retrieve the compliance frameworks of a top-level group.
+
+-> Compliance frameworks are identified by their GraphQL global ID, e.g. ` + "`gid://gitlab/ComplianceManagement::Framework/1`" + `.
+
+~> This data source requires GitLab Premium or higher.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#namespacecomplianceframeworks)`,
+
+		ReadContext: dataSourceGitlabGroupComplianceFrameworksRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the top-level group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"compliance_frameworks": {
+				Description: "The list of compliance frameworks of the group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The GraphQL global ID of the compliance framework.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the compliance framework.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the compliance framework.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"color": {
+							Description: "The color of the compliance framework label, in hex format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"default": {
+							Description: "Whether the compliance framework is the default framework of the group.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"pipeline_configuration_full_path": {
+							Description: "The full path of the compliance pipeline configuration, e.g. `.compliance-gitlab-ci.yml@compliance/hipaa`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+type gitlabComplianceFramework struct {
+	ID                            string `json:"id"`
+	Name                          string `json:"name"`
+	Description                   string `json:"description"`
+	Color                         string `json:"color"`
+	Default                       bool   `json:"default"`
+	PipelineConfigurationFullPath string `json:"pipelineConfigurationFullPath"`
+}
+
+func dataSourceGitlabGroupComplianceFrameworksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	groupID := d.Get("group").(string)
+
+	group, _, err := client.Groups.GetGroup(groupID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list compliance frameworks of group %s", group.FullPath)
+
+	query := `query($fullPath: ID!, $after: String) {
+		group(fullPath: $fullPath) {
+			complianceFrameworks(after: $after) {
+				nodes { id name description color default pipelineConfigurationFullPath }
+				pageInfo { hasNextPage endCursor }
+			}
+		}
+	}`
+	variables := map[string]interface{}{"fullPath": group.FullPath}
+
+	var frameworks []gitlabComplianceFramework
+	for {
+		var data struct {
+			Group *struct {
+				ComplianceFrameworks struct {
+					Nodes    []gitlabComplianceFramework `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"complianceFrameworks"`
+			} `json:"group"`
+		}
+		if err := graphQLClientFor(client).query(ctx, query, variables, &data); err != nil {
+			return diag.FromErr(err)
+		}
+		if data.Group == nil {
+			return diag.Errorf("gitlab group %s not found in GraphQL API", group.FullPath)
+		}
+
+		frameworks = append(frameworks, data.Group.ComplianceFrameworks.Nodes...)
+		if !data.Group.ComplianceFrameworks.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = data.Group.ComplianceFrameworks.PageInfo.EndCursor
+	}
+
+	d.SetId(fmt.Sprintf("%d", group.ID))
+	if err := d.Set("compliance_frameworks", flattenGitlabComplianceFrameworks(frameworks)); err != nil {
+		return diag.Errorf("failed to set compliance frameworks to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabComplianceFrameworks(frameworks []gitlabComplianceFramework) (values []map[string]interface{}) {
+	for _, framework := range frameworks {
+		values = append(values, map[string]interface{}{
+			"id":                               framework.ID,
+			"name":                             framework.Name,
+			"description":                      framework.Description,
+			"color":                            framework.Color,
+			"default":                          framework.Default,
+			"pipeline_configuration_full_path": framework.PipelineConfigurationFullPath,
+		})
+	}
+	return values
+}
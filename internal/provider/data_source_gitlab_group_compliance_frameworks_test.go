@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabGroupComplianceFrameworks_basic(t *testing.T) {
+	testAccCheck(t)
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+
+	for _, name := range []string{"SOX", "HIPAA"} {
+		mutation := `mutation($namespacePath: ID!, $name: String!) {
+			createComplianceFramework(input: { namespacePath: $namespacePath, params: { name: $name, description: $name, color: "#87BEEF" } }) { errors }
+		}`
+		if err := graphQLClientFor(testGitlabClient).mutate(context.Background(), mutation, map[string]interface{}{
+			"namespacePath": testGroup.FullPath,
+			"name":          name,
+		}, nil); err != nil {
+			t.Fatalf("could not create test compliance framework: %v", err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_compliance_frameworks" "this" {
+						group = "%s"
+					}
+				`, testGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_compliance_frameworks.this", "compliance_frameworks.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_group_compliance_frameworks.this", "compliance_frameworks.*", map[string]string{
+						"name":        "SOX",
+						"description": "SOX",
+						"color":       "#87BEEF",
+						"default":     "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_group_compliance_frameworks.this", "compliance_frameworks.*", map[string]string{
+						"name":        "HIPAA",
+						"description": "HIPAA",
+					}),
+					resource.TestMatchResourceAttr("data.gitlab_group_compliance_frameworks.this", "compliance_frameworks.0.id", regexp.MustCompile(`^gid://gitlab/ComplianceManagement::Framework/\d+$`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_descendant_groups", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_descendant_groups`" + ` data source allows to retrieve all groups in the hierarchy below a group, i.e. its subgroups and their subgroups recursively.
+
+-> Only the descendant groups visible to the authenticated user are returned.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/groups.html#list-a-groups-descendant-groups)`,
+
+		ReadContext: dataSourceGitlabGroupDescendantGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"skip_groups": {
+				Description: "The IDs of the groups to skip. Their descendants are still returned.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"min_access_level": {
+				Description:      fmt.Sprintf("Limit to groups where the authenticated user has at least this access level. Valid values are: %s.", renderValueListForDocs(validGroupAccessLevelNames)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validGroupAccessLevelNames, false)),
+			},
+			"search": {
+				Description: "Return only the groups matching the search criteria.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"owned": {
+				Description: "Limit to groups explicitly owned by the authenticated user.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"all_available": {
+				Description: "Show all the groups the authenticated user has access to.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"order_by": {
+				Description:      "Order the groups by `name`, `path` or `id`. Defaults to `name`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"name", "path", "id"}, false)),
+			},
+			"sort": {
+				Description:      "Order the groups in `asc` or `desc` order. Defaults to `asc`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"asc", "desc"}, false)),
+			},
+			"groups": {
+				Description: "The list of descendant groups.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Description: "The ID of the group.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"path": {
+							Description: "The path of the group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"full_name": {
+							Description: "The full name of the group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"full_path": {
+							Description: "The full path of the group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"web_url": {
+							Description: "Web URL of the group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"visibility_level": {
+							Description: "Visibility level of the group. Possible values are `private`, `internal`, `public`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"parent_id": {
+							Description: "The ID of the parent group.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabGroupDescendantGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	options := &gitlab.ListDescendantGroupsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 20,
+			Page:    1,
+		},
+	}
+
+	var optionsHash strings.Builder
+	optionsHash.WriteString(group)
+
+	if v, ok := d.GetOk("skip_groups"); ok {
+		var skipGroups []int
+		for _, id := range v.(*schema.Set).List() {
+			skipGroups = append(skipGroups, id.(int))
+			optionsHash.WriteString(strconv.Itoa(id.(int)))
+		}
+		options.SkipGroups = &skipGroups
+	}
+	if v, ok := d.GetOk("min_access_level"); ok {
+		options.MinAccessLevel = gitlab.AccessLevel(accessLevelNameToValue[v.(string)])
+		optionsHash.WriteString(v.(string))
+	}
+	if v, ok := d.GetOk("search"); ok {
+		options.Search = gitlab.String(v.(string))
+		optionsHash.WriteString(v.(string))
+	}
+	if v, ok := d.GetOk("owned"); ok {
+		options.Owned = gitlab.Bool(v.(bool))
+		optionsHash.WriteString(strconv.FormatBool(v.(bool)))
+	}
+	if v, ok := d.GetOk("all_available"); ok {
+		options.AllAvailable = gitlab.Bool(v.(bool))
+		optionsHash.WriteString(strconv.FormatBool(v.(bool)))
+	}
+	if v, ok := d.GetOk("order_by"); ok {
+		options.OrderBy = gitlab.String(v.(string))
+		optionsHash.WriteString(v.(string))
+	}
+	if v, ok := d.GetOk("sort"); ok {
+		options.Sort = gitlab.String(v.(string))
+		optionsHash.WriteString(v.(string))
+	}
+
+	log.Printf("[DEBUG] list descendant groups of group %s", group)
+
+	var groups []*gitlab.Group
+	for options.Page != 0 {
+		paginatedGroups, resp, err := client.Groups.ListDescendantGroups(group, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		groups = append(groups, paginatedGroups...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(optionsHash.String())))
+	if err := d.Set("groups", flattenGitlabDescendantGroups(groups)); err != nil {
+		return diag.Errorf("failed to set groups to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabDescendantGroups(groups []*gitlab.Group) (values []map[string]interface{}) {
+	for _, group := range groups {
+		values = append(values, map[string]interface{}{
+			"group_id":         group.ID,
+			"name":             group.Name,
+			"path":             group.Path,
+			"full_name":        group.FullName,
+			"full_path":        group.FullPath,
+			"description":      group.Description,
+			"web_url":          group.WebURL,
+			"visibility_level": string(group.Visibility),
+			"parent_id":        group.ParentID,
+		})
+	}
+	return values
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabGroupDescendantGroups_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	testSubGroup := testAccCreateDescendantGroup(t, testGroup.ID)
+	testSubSubGroup := testAccCreateDescendantGroup(t, testSubGroup.ID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_descendant_groups" "this" {
+						group    = %d
+						order_by = "id"
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_descendant_groups.this", "groups.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlab_group_descendant_groups.this", "groups.0.full_path", testSubGroup.FullPath),
+					resource.TestCheckResourceAttr("data.gitlab_group_descendant_groups.this", "groups.1.full_path", testSubSubGroup.FullPath),
+					resource.TestCheckResourceAttr("data.gitlab_group_descendant_groups.this", "groups.1.parent_id", strconv.Itoa(testSubGroup.ID)),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_descendant_groups" "this" {
+						group            = "%s"
+						skip_groups      = [%d]
+						min_access_level = "owner"
+					}
+				`, testGroup.FullPath, testSubGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_descendant_groups.this", "groups.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group_descendant_groups.this", "groups.0.group_id", strconv.Itoa(testSubSubGroup.ID)),
+				),
+			},
+		},
+	})
+}
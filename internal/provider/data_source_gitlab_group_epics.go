@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_epics", func() *schema.Resource {
+	validEpicStateValues := []string{"all", "opened", "closed"}
+	validEpicOrderByValues := []string{"created_at", "updated_at", "title"}
+	validEpicSortValues := []string{"asc", "desc"}
+
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_epics`" + ` data source allows to retrieve details about epics in a group.
+
+-> Epics can't be filtered by milestone or assignee, because they have neither. Use the ` + "`gitlab_project_issues`" + ` data source to filter issues by those.
+
+~> This data source requires GitLab Premium or higher.
+
+**Upstream API**: [GitLab API docs](https://docs.gitlab.com/ee/api/epics.html#list-epics-for-a-group)`,
+
+		ReadContext: dataSourceGitlabGroupEpicsRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"author_id": {
+				Description: "Return epics created by the given user id.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"labels": {
+				Description: "Return epics with labels. Epics must have all labels to be returned. None lists all epics with no labels. Any lists all epics with at least one label.",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			"state": {
+				Description:      fmt.Sprintf("Return all epics or just those that are opened or closed. Valid values are %s. Defaults to all.", renderValueListForDocs(validEpicStateValues)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validEpicStateValues, false)),
+			},
+			"search": {
+				Description: "Search group epics against their title and description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"order_by": {
+				Description:      fmt.Sprintf("Return epics ordered by. Valid values are %s. Default is created_at", renderValueListForDocs(validEpicOrderByValues)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validEpicOrderByValues, false)),
+			},
+			"sort": {
+				Description:      "Return epics sorted in asc or desc order. Default is desc",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validEpicSortValues, false)),
+			},
+			"created_after": {
+				Description: "Return epics created on or after the given time. Expected in ISO 8601 format (2019-03-15T08:00:00Z)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				// NOTE: since RFC3339 is pretty much a subset of ISO8601 and actually expected by GitLab,
+				//       we use it here to avoid having to parse the string ourselves.
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"created_before": {
+				Description: "Return epics created on or before the given time. Expected in ISO 8601 format (2019-03-15T08:00:00Z)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				// NOTE: since RFC3339 is pretty much a subset of ISO8601 and actually expected by GitLab,
+				//       we use it here to avoid having to parse the string ourselves.
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"updated_after": {
+				Description: "Return epics updated on or after the given time. Expected in ISO 8601 format (2019-03-15T08:00:00Z)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				// NOTE: since RFC3339 is pretty much a subset of ISO8601 and actually expected by GitLab,
+				//       we use it here to avoid having to parse the string ourselves.
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"updated_before": {
+				Description: "Return epics updated on or before the given time. Expected in ISO 8601 format (2019-03-15T08:00:00Z)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				// NOTE: since RFC3339 is pretty much a subset of ISO8601 and actually expected by GitLab,
+				//       we use it here to avoid having to parse the string ourselves.
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"include_ancestor_groups": {
+				Description: "Include epics from the ancestor groups.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"include_descendant_groups": {
+				Description: "Include epics from the descendant groups. Defaults to true.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"my_reaction_emoji": {
+				Description: "Return epics reacted by the authenticated user by the given emoji. None returns epics not given a reaction. Any returns epics given at least one reaction.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"epics": {
+				Description: "The list of epics returned by the search.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"epic_id": {
+							Description: "The instance-wide ID of the epic.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"iid": {
+							Description: "The ID of the epic in its group.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"group_id": {
+							Description: "The ID of the group the epic belongs to.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"parent_id": {
+							Description: "The ID of the parent epic.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"title": {
+							Description: "The title of the epic.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the epic.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"state": {
+							Description: "The state of the epic, either `opened` or `closed`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"confidential": {
+							Description: "Whether the epic is confidential.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"web_url": {
+							Description: "The web URL of the epic.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"author_id": {
+							Description: "The ID of the author of the epic.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"author_username": {
+							Description: "The username of the author of the epic.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"labels": {
+							Description: "The labels of the epic.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"start_date": {
+							Description: "The start date of the epic, in `YYYY-MM-DD` format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"due_date": {
+							Description: "The due date of the epic, in `YYYY-MM-DD` format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The time the epic has been created, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"updated_at": {
+							Description: "The time the epic has last been updated, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"closed_at": {
+							Description: "The time the epic has been closed, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"upvotes": {
+							Description: "The number of upvotes of the epic.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"downvotes": {
+							Description: "The number of downvotes of the epic.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabGroupEpicsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	group := d.Get("group").(string)
+	options := gitlab.ListGroupEpicsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 20,
+			Page:    1,
+		},
+		IncludeDescendantGroups: gitlab.Bool(d.Get("include_descendant_groups").(bool)),
+	}
+
+	if v, ok := d.GetOk("author_id"); ok {
+		options.AuthorID = gitlab.Int(v.(int))
+	}
+
+	if v, ok := d.GetOk("labels"); ok {
+		gitlabLabels := gitlab.LabelOptions(*stringSetToStringSlice(v.(*schema.Set)))
+		options.Labels = &gitlabLabels
+	}
+
+	if v, ok := d.GetOk("state"); ok {
+		options.State = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("search"); ok {
+		options.Search = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("order_by"); ok {
+		options.OrderBy = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sort"); ok {
+		options.Sort = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("created_after"); ok {
+		parsedCreatedAfter, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.Errorf("failed to parse created_after: %s. It must be in valid RFC3339 format.", err)
+		}
+		options.CreatedAfter = gitlab.Time(parsedCreatedAfter)
+	}
+
+	if v, ok := d.GetOk("created_before"); ok {
+		parsedCreatedBefore, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.Errorf("failed to parse created_before: %s. It must be in valid RFC3339 format.", err)
+		}
+		options.CreatedBefore = gitlab.Time(parsedCreatedBefore)
+	}
+
+	if v, ok := d.GetOk("updated_after"); ok {
+		parsedUpdatedAfter, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.Errorf("failed to parse updated_after: %s. It must be in valid RFC3339 format.", err)
+		}
+		options.UpdatedAfter = gitlab.Time(parsedUpdatedAfter)
+	}
+
+	if v, ok := d.GetOk("updated_before"); ok {
+		parsedUpdatedBefore, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.Errorf("failed to parse updated_before: %s. It must be in valid RFC3339 format.", err)
+		}
+		options.UpdatedBefore = gitlab.Time(parsedUpdatedBefore)
+	}
+
+	if v, ok := d.GetOk("include_ancestor_groups"); ok {
+		options.IncludeAncestorGroups = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("my_reaction_emoji"); ok {
+		options.MyReactionEmoji = gitlab.String(v.(string))
+	}
+
+	optionsHash, err := hashstructure.Hash(&options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list epics of group %s", group)
+
+	var epics []*gitlab.Epic
+	for options.Page != 0 {
+		paginatedEpics, resp, err := client.Epics.ListGroupEpics(group, &options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		epics = append(epics, paginatedEpics...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%s-%d", group, optionsHash))
+	if err = d.Set("epics", flattenGitlabGroupEpics(epics)); err != nil {
+		return diag.Errorf("failed to set epics to state: %v", err)
+	}
+
+	return nil
+}
+
+func flattenGitlabGroupEpics(epics []*gitlab.Epic) (values []map[string]interface{}) {
+	for _, epic := range epics {
+		v := map[string]interface{}{
+			"epic_id":      epic.ID,
+			"iid":          epic.IID,
+			"group_id":     epic.GroupID,
+			"parent_id":    epic.ParentID,
+			"title":        epic.Title,
+			"description":  epic.Description,
+			"state":        epic.State,
+			"confidential": epic.Confidential,
+			"web_url":      epic.WebURL,
+			"labels":       epic.Labels,
+			"upvotes":      epic.Upvotes,
+			"downvotes":    epic.Downvotes,
+		}
+		if epic.Author != nil {
+			v["author_id"] = epic.Author.ID
+			v["author_username"] = epic.Author.Username
+		}
+		if epic.StartDate != nil {
+			v["start_date"] = epic.StartDate.String()
+		}
+		if epic.DueDate != nil {
+			v["due_date"] = epic.DueDate.String()
+		}
+		if epic.CreatedAt != nil {
+			v["created_at"] = epic.CreatedAt.Format(time.RFC3339)
+		}
+		if epic.UpdatedAt != nil {
+			v["updated_at"] = epic.UpdatedAt.Format(time.RFC3339)
+		}
+		if epic.ClosedAt != nil {
+			v["closed_at"] = epic.ClosedAt.Format(time.RFC3339)
+		}
+		values = append(values, v)
+	}
+	return values
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabGroupEpics_basic(t *testing.T) {
+	testAccCheck(t)
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+
+	var testEpics []*gitlab.Epic
+	for i, labels := range []gitlab.LabelOptions{{"roadmap", "q1"}, {"roadmap"}, {"q1"}} {
+		epic, _, err := testGitlabClient.Epics.CreateEpic(testGroup.ID, &gitlab.CreateEpicOptions{
+			Title:       gitlab.String(fmt.Sprintf("Epic %d", i)),
+			Description: gitlab.String(fmt.Sprintf("Description %d", i)),
+			Labels:      &labels,
+		})
+		if err != nil {
+			t.Fatalf("could not create test epic: %v", err)
+		}
+		testEpics = append(testEpics, epic)
+	}
+
+	if _, _, err := testGitlabClient.Epics.UpdateEpic(testGroup.ID, testEpics[1].IID, &gitlab.UpdateEpicOptions{
+		StateEvent: gitlab.String("close"),
+	}); err != nil {
+		t.Fatalf("could not close test epic: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_epics" "all" {
+						group = %[1]d
+					}
+
+					data "gitlab_group_epics" "roadmap" {
+						group  = %[1]d
+						labels = ["roadmap"]
+						state  = "opened"
+					}
+
+					data "gitlab_group_epics" "search" {
+						group  = %[1]d
+						search = "Description 2"
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_epics.all", "epics.#", "3"),
+					resource.TestCheckResourceAttr("data.gitlab_group_epics.roadmap", "epics.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group_epics.roadmap", "epics.0.epic_id", fmt.Sprintf("%d", testEpics[0].ID)),
+					resource.TestCheckResourceAttr("data.gitlab_group_epics.roadmap", "epics.0.title", "Epic 0"),
+					resource.TestCheckResourceAttr("data.gitlab_group_epics.roadmap", "epics.0.state", "opened"),
+					resource.TestCheckResourceAttr("data.gitlab_group_epics.roadmap", "epics.0.labels.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlab_group_epics.roadmap", "epics.0.group_id", fmt.Sprintf("%d", testGroup.ID)),
+					resource.TestCheckResourceAttrSet("data.gitlab_group_epics.roadmap", "epics.0.web_url"),
+					resource.TestCheckResourceAttr("data.gitlab_group_epics.search", "epics.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group_epics.search", "epics.0.iid", fmt.Sprintf("%d", testEpics[2].IID)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabGroupHook_basic(t *testing.T) {
+	testAccCheck(t)
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	testHook := testAccCreateGroupHooks(t, testGroup.ID, 1)[0]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_hook" "this" {
+						group   = "%s"
+						hook_id = %d
+					}
+				`, testGroup.FullPath, testHook.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_hook.this", "hook_id", strconv.Itoa(testHook.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_group_hook.this", "url", testHook.URL),
+					resource.TestCheckResourceAttr("data.gitlab_group_hook.this", "push_events", "true"),
+					resource.TestCheckResourceAttr("data.gitlab_group_hook.this", "issues_events", "true"),
+					resource.TestCheckResourceAttr("data.gitlab_group_hook.this", "merge_requests_events", "false"),
+					resource.TestCheckResourceAttr("data.gitlab_group_hook.this", "enable_ssl_verification", "true"),
+					resource.TestCheckResourceAttrSet("data.gitlab_group_hook.this", "alert_status"),
+				),
+			},
+		},
+	})
+}
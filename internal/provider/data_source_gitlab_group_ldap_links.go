@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_ldap_links", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_ldap_links`" + ` data source allows to retrieve the LDAP links of a group.
+
+-> This data source is only available for administrators and requires LDAP to be enabled on the GitLab instance.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/groups.html#list-ldap-group-links)`,
+
+		ReadContext: dataSourceGitlabGroupLdapLinksRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"ldap_links": {
+				Description: "The list of LDAP links of the group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cn": {
+							Description: "The CN of the linked LDAP group. Empty if the link uses a `filter`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"filter": {
+							Description: "The LDAP filter of the link. Empty if the link uses a `cn`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"group_access": {
+							Description: "The minimum access level granted to the members of the LDAP group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ldap_provider": {
+							Description: "The name of the LDAP provider as stored in the GitLab database.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabGroupLdapLinksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	log.Printf("[DEBUG] list LDAP links of group %s", group)
+	ldapLinks, _, err := client.Groups.ListGroupLDAPLinks(group, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(group)
+	if err := d.Set("ldap_links", flattenGitlabGroupLdapLinks(ldapLinks)); err != nil {
+		return diag.Errorf("failed to set LDAP links to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabGroupLdapLinks(ldapLinks []*gitlab.LDAPGroupLink) (values []map[string]interface{}) {
+	for _, ldapLink := range ldapLinks {
+		values = append(values, map[string]interface{}{
+			"cn":            ldapLink.CN,
+			"filter":        ldapLink.Filter,
+			"group_access":  accessLevelValueToName[ldapLink.GroupAccess],
+			"ldap_provider": ldapLink.Provider,
+		})
+	}
+	return values
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabGroupLdapLinks_basic(t *testing.T) {
+	testAccCheck(t)
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	if _, _, err := testGitlabClient.Groups.AddGroupLDAPLink(testGroup.ID, &gitlab.AddGroupLDAPLinkOptions{
+		CN:          gitlab.String("default"),
+		GroupAccess: gitlab.AccessLevel(gitlab.DeveloperPermissions),
+		Provider:    gitlab.String("default"),
+	}); err != nil {
+		t.Fatalf("failed to create LDAP link: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_ldap_links" "this" {
+						group = %d
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_ldap_links.this", "ldap_links.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group_ldap_links.this", "ldap_links.0.cn", "default"),
+					resource.TestCheckResourceAttr("data.gitlab_group_ldap_links.this", "ldap_links.0.group_access", "developer"),
+					resource.TestCheckResourceAttr("data.gitlab_group_ldap_links.this", "ldap_links.0.ldap_provider", "default"),
+				),
+			},
+		},
+	})
+}
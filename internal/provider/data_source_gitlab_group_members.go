@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_members", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_members`" + ` data source allows to retrieve the members and pending invitations of a group, optionally including the members inherited from ancestor groups.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/members.html#list-all-members-of-a-group-or-project-including-inherited-and-invited-members)`,
+
+		ReadContext: dataSourceGitlabGroupMembersRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"inherited": {
+				Description: "Include the members inherited from ancestor groups and the members of groups invited to this group or its ancestors.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"query": {
+				Description: "Filter the members and invitations by name, username or email.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"members": {
+				Description: "The list of group members.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: gitlabMembersDataSourceMemberSchema("group"),
+				},
+			},
+			"invitations": {
+				Description: "The list of pending invitations of the group. Invitations of ancestor groups are never included.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: gitlabMembersDataSourceInvitationSchema(),
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabGroupMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+	inherited := d.Get("inherited").(bool)
+
+	var query *string
+	if v, ok := d.GetOk("query"); ok {
+		query = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] list members of group %s", group)
+	directMembers, err := dataSourceGitlabGroupMembersList(ctx, group, query, client.Groups.ListGroupMembers)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	members := directMembers
+	if inherited {
+		log.Printf("[DEBUG] list inherited members of group %s", group)
+		members, err = dataSourceGitlabGroupMembersList(ctx, group, query, client.Groups.ListAllGroupMembers)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	directMemberIDs := make(map[int]bool, len(directMembers))
+	for _, m := range directMembers {
+		directMemberIDs[m.ID] = true
+	}
+
+	var memberValues []map[string]interface{}
+	for _, m := range members {
+		memberValues = append(memberValues, gitlabMembersDataSourceMemberToStateMap(
+			m.ID, m.Username, m.Name, m.State, m.WebURL, m.AccessLevel, m.CreatedAt, m.ExpiresAt, directMemberIDs[m.ID],
+		))
+	}
+
+	log.Printf("[DEBUG] list pending invitations of group %s", group)
+	options := &gitlab.ListPendingInvitationsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		Query: query,
+	}
+
+	var invitations []*gitlab.PendingInvite
+	for options.Page != 0 {
+		paginatedInvitations, resp, err := client.Invites.ListPendingGroupInvitations(group, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		invitations = append(invitations, paginatedInvitations...)
+		options.Page = resp.NextPage
+	}
+
+	var optionsHash strings.Builder
+	optionsHash.WriteString(group)
+	optionsHash.WriteString(",")
+	optionsHash.WriteString(strconv.FormatBool(inherited))
+	optionsHash.WriteString(",")
+	if query != nil {
+		optionsHash.WriteString(*query)
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(optionsHash.String())))
+	if err := d.Set("members", memberValues); err != nil {
+		return diag.Errorf("failed to set members to state: %v", err)
+	}
+	if err := d.Set("invitations", flattenGitlabPendingInvitations(invitations)); err != nil {
+		return diag.Errorf("failed to set invitations to state: %v", err)
+	}
+	return nil
+}
+
+func dataSourceGitlabGroupMembersList(ctx context.Context, group string, query *string, list func(interface{}, *gitlab.ListGroupMembersOptions, ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMember, *gitlab.Response, error)) ([]*gitlab.GroupMember, error) {
+	options := &gitlab.ListGroupMembersOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		Query: query,
+	}
+
+	var members []*gitlab.GroupMember
+	for options.Page != 0 {
+		paginatedMembers, resp, err := list(group, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, paginatedMembers...)
+		options.Page = resp.NextPage
+	}
+	return members, nil
+}
+
+// gitlabMembersDataSourceMemberSchema returns the schema of a member
+// shared by the group and project members data sources.
+func gitlabMembersDataSourceMemberSchema(kind string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "The ID of the user.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"username": {
+			Description: "The username of the user.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The name of the user.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"state": {
+			Description: "The state of the user, e.g. `active` or `blocked`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"web_url": {
+			Description: "The URL of the user's profile.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"access_level": {
+			Description: fmt.Sprintf("The access level of the member in the %s.", kind),
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"membership_type": {
+			Description: fmt.Sprintf("Whether the user is a `direct` member of the %s or an `inherited` one.", kind),
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created_at": {
+			Description: "The time the membership has been created, in RFC3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"expires_at": {
+			Description: "The date the membership expires, in `YYYY-MM-DD` format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+// gitlabMembersDataSourceInvitationSchema returns the schema of a pending invitation.
+func gitlabMembersDataSourceInvitationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "The ID of the invitation.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"invite_email": {
+			Description: "The email address the invitation has been sent to.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"user_name": {
+			Description: "The name of the invited user, if the email address belongs to an existing user.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"access_level": {
+			Description: "The access level the invited user will be granted.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created_by_name": {
+			Description: "The name of the user who created the invitation.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created_at": {
+			Description: "The time the invitation has been created, in RFC3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"expires_at": {
+			Description: "The time the membership granted by the invitation expires, in RFC3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func gitlabMembersDataSourceMemberToStateMap(id int, username, name, state, webURL string, accessLevel gitlab.AccessLevelValue, createdAt *time.Time, expiresAt *gitlab.ISOTime, direct bool) map[string]interface{} {
+	stateMap := map[string]interface{}{
+		"id":              id,
+		"username":        username,
+		"name":            name,
+		"state":           state,
+		"web_url":         webURL,
+		"access_level":    accessLevelValueToName[accessLevel],
+		"membership_type": "inherited",
+	}
+	if direct {
+		stateMap["membership_type"] = "direct"
+	}
+	if createdAt != nil {
+		stateMap["created_at"] = createdAt.Format(time.RFC3339)
+	}
+	if expiresAt != nil {
+		stateMap["expires_at"] = expiresAt.String()
+	}
+	return stateMap
+}
+
+func flattenGitlabPendingInvitations(invitations []*gitlab.PendingInvite) (values []map[string]interface{}) {
+	for _, invitation := range invitations {
+		v := map[string]interface{}{
+			"id":              invitation.ID,
+			"invite_email":    invitation.InviteEmail,
+			"user_name":       invitation.UserName,
+			"access_level":    accessLevelValueToName[invitation.AccessLevel],
+			"created_by_name": invitation.CreatedByName,
+		}
+		if invitation.CreatedAt != nil {
+			v["created_at"] = invitation.CreatedAt.Format(time.RFC3339)
+		}
+		if invitation.ExpiresAt != nil {
+			v["expires_at"] = invitation.ExpiresAt.Format(time.RFC3339)
+		}
+		values = append(values, v)
+	}
+	return values
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabGroupMembers_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	testSubGroup := testAccCreateDescendantGroup(t, testGroup.ID)
+	testUsers := testAccCreateUsers(t, 2)
+	testAccAddGroupMembers(t, testGroup.ID, testUsers[:1])
+	testAccAddGroupMembers(t, testSubGroup.ID, testUsers[1:])
+
+	inviteEmail := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix("acctest-invite"))
+	if _, _, err := testGitlabClient.Invites.GroupInvites(testSubGroup.ID, &gitlab.InvitesOptions{
+		Email:       gitlab.String(inviteEmail),
+		AccessLevel: gitlab.AccessLevel(gitlab.ReporterPermissions),
+	}); err != nil {
+		t.Fatalf("could not invite to test group: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_members" "this" {
+						group = %d
+					}
+				`, testSubGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_group_members.this", "members.*", map[string]string{
+						"username":        testUsers[1].Username,
+						"access_level":    "developer",
+						"membership_type": "direct",
+						"state":           "active",
+					}),
+					resource.TestCheckResourceAttr("data.gitlab_group_members.this", "invitations.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group_members.this", "invitations.0.invite_email", inviteEmail),
+					resource.TestCheckResourceAttr("data.gitlab_group_members.this", "invitations.0.access_level", "reporter"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_members" "this" {
+						group     = "%s"
+						inherited = true
+					}
+				`, testSubGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_group_members.this", "members.*", map[string]string{
+						"username":        testUsers[0].Username,
+						"membership_type": "inherited",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_group_members.this", "members.*", map[string]string{
+						"username":        testUsers[1].Username,
+						"membership_type": "direct",
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_members" "this" {
+						group     = %d
+						inherited = true
+						query     = "%s"
+					}
+				`, testSubGroup.ID, testUsers[0].Username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_members.this", "members.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group_members.this", "members.0.id", fmt.Sprintf("%d", testUsers[0].ID)),
+					resource.TestCheckResourceAttr("data.gitlab_group_members.this", "invitations.#", "0"),
+				),
+			},
+		},
+	})
+}
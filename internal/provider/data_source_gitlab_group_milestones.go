@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_milestones", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_milestones`" + ` data source allows to retrieve the milestones of a group.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_milestones.html#list-group-milestones)`,
+
+		ReadContext: dataSourceGitlabGroupMilestonesRead,
+		Schema: constructSchema(
+			gitlabMilestonesDataSourceFilterSchema(),
+			map[string]*schema.Schema{
+				"group": {
+					Description: "The ID or full path of the group.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"include_ancestors": {
+					Description: "Include the milestones of the ancestor groups.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+				"include_descendants": {
+					Description: "Include the milestones of the descendant groups and their projects.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+				"milestones": {
+					Description: "The list of milestones of the group.",
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: gitlabMilestonesDataSourceMilestoneSchema(),
+					},
+				},
+			},
+		),
+	}
+})
+
+func dataSourceGitlabGroupMilestonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	options := gitlab.ListGroupMilestonesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		IncludeAncestors:   gitlab.Bool(d.Get("include_ancestors").(bool)),
+		IncludeDescendents: gitlab.Bool(d.Get("include_descendants").(bool)),
+	}
+	if v, ok := d.GetOk("iids"); ok {
+		options.IIDs = intSetToIntSlice(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("title"); ok {
+		options.Title = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("state"); ok {
+		options.State = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("search"); ok {
+		options.Search = gitlab.String(v.(string))
+	}
+
+	optionsHash, err := hashstructure.Hash(&options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list milestones of group %s", group)
+
+	var values []map[string]interface{}
+	for options.Page != 0 {
+		paginatedMilestones, resp, err := client.GroupMilestones.ListGroupMilestones(group, &options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, m := range paginatedMilestones {
+			values = append(values, gitlabMilestonesDataSourceMilestoneToStateMap(
+				m.ID, m.IID, 0, m.GroupID, m.Title, m.Description, m.State, "", m.StartDate, m.DueDate, m.CreatedAt, m.UpdatedAt, m.Expired,
+			))
+		}
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", group, optionsHash))
+	if err := d.Set("milestones", values); err != nil {
+		return diag.Errorf("failed to set milestones to state: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabGroupMilestones_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	testMilestones := testAccCreateGroupMilestones(t, testGroup.ID, 2)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_milestones" "all" {
+						group = %[1]d
+					}
+
+					data "gitlab_group_milestones" "by_title" {
+						group = %[1]d
+						title = "%[2]s"
+					}
+				`, testGroup.ID, testMilestones[1].Title),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_milestones.all", "milestones.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlab_group_milestones.by_title", "milestones.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group_milestones.by_title", "milestones.0.milestone_id", fmt.Sprintf("%d", testMilestones[1].ID)),
+					resource.TestCheckResourceAttr("data.gitlab_group_milestones.by_title", "milestones.0.iid", fmt.Sprintf("%d", testMilestones[1].IID)),
+					resource.TestCheckResourceAttr("data.gitlab_group_milestones.by_title", "milestones.0.group_id", fmt.Sprintf("%d", testGroup.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_group_milestones.by_title", "milestones.0.state", "active"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_projects", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_projects`" + ` data source allows to retrieve a minimal set of attributes of the projects in a group.
+
+-> The projects are queried with the ` + "`simple`" + ` option of the API, which is considerably faster for large groups. Use the ` + "`gitlab_projects`" + ` data source to retrieve all project attributes.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/groups.html#list-a-groups-projects)`,
+
+		ReadContext: dataSourceGitlabGroupProjectsRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"with_shared": {
+				Description: "Include projects shared to this group.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"include_subgroups": {
+				Description: "Include projects in subgroups of this group.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"archived": {
+				Description: "Limit by archived status. If not set, archived and not archived projects are returned.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"search": {
+				Description: "Return the projects matching the search criteria.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"order_by": {
+				Description:      "Return projects ordered by `id`, `name`, `path`, `created_at`, `updated_at`, `similarity` or `last_activity_at`. Defaults to `created_at`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"id", "name", "path", "created_at", "updated_at", "similarity", "last_activity_at"}, false)),
+			},
+			"sort": {
+				Description:      "Return projects sorted in `asc` or `desc` order. Defaults to `desc`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"asc", "desc"}, false)),
+			},
+			"projects": {
+				Description: "The list of projects.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the project.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"path": {
+							Description: "The path of the project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name_with_namespace": {
+							Description: "The name of the project, including its namespace.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"path_with_namespace": {
+							Description: "The path of the project, including its namespace.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"namespace_id": {
+							Description: "The ID of the namespace the project belongs to. Differs from the group for shared projects and projects in subgroups.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"default_branch": {
+							Description: "The default branch of the project.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"topics": {
+							Description: "The topics of the project.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"web_url": {
+							Description: "The URL to view the project in a browser.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"http_url_to_repo": {
+							Description: "The URL to clone the repository via HTTP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ssh_url_to_repo": {
+							Description: "The URL to clone the repository via SSH.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_activity_at": {
+							Description: "The time of the last activity in the project, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabGroupProjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	options := &gitlab.ListGroupProjectsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		Simple:           gitlab.Bool(true),
+		WithShared:       gitlab.Bool(d.Get("with_shared").(bool)),
+		IncludeSubGroups: gitlab.Bool(d.Get("include_subgroups").(bool)),
+	}
+
+	var optionsHash strings.Builder
+	optionsHash.WriteString(group)
+	optionsHash.WriteString(strconv.FormatBool(*options.WithShared))
+	optionsHash.WriteString(strconv.FormatBool(*options.IncludeSubGroups))
+
+	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
+	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
+	if v, ok := d.GetOkExists("archived"); ok {
+		options.Archived = gitlab.Bool(v.(bool))
+		optionsHash.WriteString(strconv.FormatBool(v.(bool)))
+	}
+	if v, ok := d.GetOk("search"); ok {
+		options.Search = gitlab.String(v.(string))
+		optionsHash.WriteString(v.(string))
+	}
+	if v, ok := d.GetOk("order_by"); ok {
+		options.OrderBy = gitlab.String(v.(string))
+		optionsHash.WriteString(v.(string))
+	}
+	if v, ok := d.GetOk("sort"); ok {
+		options.Sort = gitlab.String(v.(string))
+		optionsHash.WriteString(v.(string))
+	}
+
+	log.Printf("[DEBUG] list projects of group %s", group)
+
+	var projects []*gitlab.Project
+	for options.Page != 0 {
+		paginatedProjects, resp, err := client.Groups.ListGroupProjects(group, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		projects = append(projects, paginatedProjects...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(optionsHash.String())))
+	if err := d.Set("projects", flattenGitlabGroupProjects(projects)); err != nil {
+		return diag.Errorf("failed to set projects to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabGroupProjects(projects []*gitlab.Project) (values []map[string]interface{}) {
+	for _, project := range projects {
+		v := map[string]interface{}{
+			"id":                  project.ID,
+			"name":                project.Name,
+			"path":                project.Path,
+			"name_with_namespace": project.NameWithNamespace,
+			"path_with_namespace": project.PathWithNamespace,
+			"description":         project.Description,
+			"default_branch":      project.DefaultBranch,
+			"topics":              project.Topics,
+			"web_url":             project.WebURL,
+			"http_url_to_repo":    project.HTTPURLToRepo,
+			"ssh_url_to_repo":     project.SSHURLToRepo,
+		}
+		if project.Namespace != nil {
+			v["namespace_id"] = project.Namespace.ID
+		}
+		if project.LastActivityAt != nil {
+			v["last_activity_at"] = project.LastActivityAt.Format(time.RFC3339)
+		}
+		values = append(values, v)
+	}
+	return values
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabGroupProjects_basic(t *testing.T) {
+	testAccCheck(t)
+
+	groups := testAccCreateGroups(t, 2)
+	testGroup, otherGroup := groups[0], groups[1]
+	testSubGroup := testAccCreateDescendantGroup(t, testGroup.ID)
+	testProject := testAccCreateProjectWithNamespace(t, testGroup.ID)
+	testSubGroupProject := testAccCreateProjectWithNamespace(t, testSubGroup.ID)
+	sharedProject := testAccCreateProjectWithNamespace(t, otherGroup.ID)
+
+	if _, err := testGitlabClient.Projects.ShareProjectWithGroup(sharedProject.ID, &gitlab.ShareWithGroupOptions{
+		GroupID:     gitlab.Int(testGroup.ID),
+		GroupAccess: gitlab.AccessLevel(gitlab.DeveloperPermissions),
+	}); err != nil {
+		t.Fatalf("could not share test project with group: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_projects" "this" {
+						group    = "%s"
+						order_by = "id"
+						sort     = "asc"
+					}
+				`, testGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_projects.this", "projects.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlab_group_projects.this", "projects.0.id", strconv.Itoa(testProject.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_group_projects.this", "projects.0.path_with_namespace", testProject.PathWithNamespace),
+					resource.TestCheckResourceAttr("data.gitlab_group_projects.this", "projects.0.default_branch", testProject.DefaultBranch),
+					resource.TestCheckResourceAttr("data.gitlab_group_projects.this", "projects.1.id", strconv.Itoa(sharedProject.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_group_projects.this", "projects.1.namespace_id", strconv.Itoa(otherGroup.ID)),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_projects" "this" {
+						group             = %d
+						with_shared       = false
+						include_subgroups = true
+						order_by          = "id"
+						sort              = "asc"
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_projects.this", "projects.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlab_group_projects.this", "projects.0.id", strconv.Itoa(testProject.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_group_projects.this", "projects.1.id", strconv.Itoa(testSubGroupProject.ID)),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_projects" "this" {
+						group    = %d
+						archived = false
+						search   = "%s"
+					}
+				`, testGroup.ID, sharedProject.Name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_projects.this", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group_projects.this", "projects.0.id", strconv.Itoa(sharedProject.ID)),
+				),
+			},
+		},
+	})
+}
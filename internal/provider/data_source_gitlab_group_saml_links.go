@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_saml_links", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_saml_links`" + ` data source allows to retrieve the SAML links of a group.
+
+-> This data source requires GitLab Premium or higher and SAML to be configured for the group or the GitLab instance.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/groups.html#list-saml-group-links)`,
+
+		ReadContext: dataSourceGitlabGroupSamlLinksRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"saml_links": {
+				Description: "The list of SAML links of the group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"saml_group_name": {
+							Description: "The name of the linked SAML group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"access_level": {
+							Description: "The access level granted to the members of the SAML group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"member_role_id": {
+							Description: "The ID of the custom member role granted to the members of the SAML group, if any.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabGroupSamlLinksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	log.Printf("[DEBUG] list SAML links of group %s", group)
+	samlLinks, _, err := client.Groups.ListGroupSAMLLinks(group, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(group)
+	if err := d.Set("saml_links", flattenGitlabGroupSamlLinks(samlLinks)); err != nil {
+		return diag.Errorf("failed to set SAML links to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabGroupSamlLinks(samlLinks []*gitlab.SAMLGroupLink) (values []map[string]interface{}) {
+	for _, samlLink := range samlLinks {
+		values = append(values, map[string]interface{}{
+			"saml_group_name": samlLink.Name,
+			"access_level":    accessLevelValueToName[samlLink.AccessLevel],
+			"member_role_id":  samlLink.MemberRoleID,
+		})
+	}
+	return values
+}
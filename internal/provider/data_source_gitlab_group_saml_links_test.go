@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabGroupSamlLinks_basic(t *testing.T) {
+	testAccCheck(t)
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_saml_links" "this" {
+						group = %d
+					}
+				`, testGroup.ID),
+				Check: resource.TestCheckResourceAttr("data.gitlab_group_saml_links.this", "saml_links.#", "0"),
+			},
+		},
+	})
+}
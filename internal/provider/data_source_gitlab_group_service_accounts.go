@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_group_service_accounts", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_service_accounts`" + ` data source allows to retrieve the service accounts of a top-level group, optionally including their personal access tokens.
+
+-> This data source requires GitLab Premium or higher and the Owner role in the group. Retrieving the tokens of the service accounts additionally requires administration privileges.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_service_accounts.html#list-service-account-users)`,
+
+		ReadContext: dataSourceGitlabGroupServiceAccountsRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the top-level group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"order_by": {
+				Description:      "Order the service accounts by `id` or `username`. Defaults to `id`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"id", "username"}, false)),
+			},
+			"sort": {
+				Description:      "Sort the service accounts in `asc` or `desc` order. Defaults to `desc`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"asc", "desc"}, false)),
+			},
+			"include_tokens": {
+				Description: "Retrieve the personal access tokens of each service account. Requires administration privileges.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"service_accounts": {
+				Description: "The list of service accounts of the group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The user ID of the service account.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the service account.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"username": {
+							Description: "The username of the service account.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tokens": {
+							Description: "The personal access tokens of the service account. Only set if `include_tokens` is `true`.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Description: "The ID of the personal access token.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"name": {
+										Description: "The name of the personal access token.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"scopes": {
+										Description: "The scopes of the personal access token.",
+										Type:        schema.TypeSet,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									"created_at": {
+										Description: "The time the personal access token has been created, in RFC3339 format.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"expires_at": {
+										Description: "The date the personal access token expires, in `YYYY-MM-DD` format.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"last_used_at": {
+										Description: "The time the personal access token has last been used, in RFC3339 format.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"active": {
+										Description: "Whether the personal access token is active, i.e. neither revoked nor expired.",
+										Type:        schema.TypeBool,
+										Computed:    true,
+									},
+									"revoked": {
+										Description: "Whether the personal access token has been revoked.",
+										Type:        schema.TypeBool,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabGroupServiceAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+	includeTokens := d.Get("include_tokens").(bool)
+
+	options := &gitlab.ListServiceAccountsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+	}
+	if v, ok := d.GetOk("order_by"); ok {
+		options.OrderBy = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("sort"); ok {
+		options.Sort = gitlab.String(v.(string))
+	}
+
+	optionsHash, err := hashstructure.Hash(options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list service accounts of group %s", group)
+
+	var serviceAccounts []*gitlab.GroupServiceAccount
+	for options.Page != 0 {
+		paginatedServiceAccounts, resp, err := client.Groups.ListServiceAccounts(group, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		serviceAccounts = append(serviceAccounts, paginatedServiceAccounts...)
+		options.Page = resp.NextPage
+	}
+
+	var values []map[string]interface{}
+	for _, serviceAccount := range serviceAccounts {
+		v := map[string]interface{}{
+			"id":       serviceAccount.ID,
+			"name":     serviceAccount.Name,
+			"username": serviceAccount.UserName,
+		}
+		if includeTokens {
+			tokens, err := dataSourceGitlabGroupServiceAccountsListTokens(ctx, client, serviceAccount.ID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			v["tokens"] = flattenGitlabServiceAccountTokens(tokens)
+		}
+		values = append(values, v)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%t:%d", group, includeTokens, optionsHash))
+	if err := d.Set("service_accounts", values); err != nil {
+		return diag.Errorf("failed to set service accounts to state: %v", err)
+	}
+	return nil
+}
+
+func dataSourceGitlabGroupServiceAccountsListTokens(ctx context.Context, client *gitlab.Client, userID int) ([]*gitlab.PersonalAccessToken, error) {
+	options := &gitlab.ListPersonalAccessTokensOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		UserID: gitlab.Int(userID),
+	}
+
+	log.Printf("[DEBUG] list personal access tokens of service account %d", userID)
+
+	var tokens []*gitlab.PersonalAccessToken
+	for options.Page != 0 {
+		paginatedTokens, resp, err := client.PersonalAccessTokens.ListPersonalAccessTokens(options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, paginatedTokens...)
+		options.Page = resp.NextPage
+	}
+	return tokens, nil
+}
+
+func flattenGitlabServiceAccountTokens(tokens []*gitlab.PersonalAccessToken) (values []map[string]interface{}) {
+	for _, token := range tokens {
+		v := map[string]interface{}{
+			"id":      token.ID,
+			"name":    token.Name,
+			"scopes":  token.Scopes,
+			"active":  token.Active,
+			"revoked": token.Revoked,
+		}
+		if token.CreatedAt != nil {
+			v["created_at"] = token.CreatedAt.Format(time.RFC3339)
+		}
+		if token.ExpiresAt != nil {
+			v["expires_at"] = token.ExpiresAt.String()
+		}
+		if token.LastUsedAt != nil {
+			v["last_used_at"] = token.LastUsedAt.Format(time.RFC3339)
+		}
+		values = append(values, v)
+	}
+	return values
+}
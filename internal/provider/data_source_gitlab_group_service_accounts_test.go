@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabGroupServiceAccounts_basic(t *testing.T) {
+	testAccCheck(t)
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	testServiceAccount, _, err := testGitlabClient.Groups.CreateServiceAccount(testGroup.ID, &gitlab.CreateServiceAccountOptions{})
+	if err != nil {
+		t.Fatalf("failed to create service account: %v", err)
+	}
+	expiresAt := gitlab.ISOTime(time.Now().AddDate(0, 1, 0))
+	testToken, _, err := testGitlabClient.Groups.CreateServiceAccountPersonalAccessToken(testGroup.ID, testServiceAccount.ID, &gitlab.CreateServiceAccountPersonalAccessTokenOptions{
+		Name:      gitlab.String("rotate-me"),
+		Scopes:    &[]string{"read_api"},
+		ExpiresAt: &expiresAt,
+	})
+	if err != nil {
+		t.Fatalf("failed to create service account token: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_group_service_accounts" "this" {
+						group = %[1]d
+					}
+
+					data "gitlab_group_service_accounts" "with_tokens" {
+						group          = %[1]d
+						include_tokens = true
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_group_service_accounts.this", "service_accounts.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group_service_accounts.this", "service_accounts.0.id", fmt.Sprintf("%d", testServiceAccount.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_group_service_accounts.this", "service_accounts.0.username", testServiceAccount.UserName),
+					resource.TestCheckResourceAttr("data.gitlab_group_service_accounts.this", "service_accounts.0.tokens.#", "0"),
+					resource.TestCheckResourceAttr("data.gitlab_group_service_accounts.with_tokens", "service_accounts.0.tokens.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group_service_accounts.with_tokens", "service_accounts.0.tokens.0.id", fmt.Sprintf("%d", testToken.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_group_service_accounts.with_tokens", "service_accounts.0.tokens.0.expires_at", expiresAt.String()),
+					resource.TestCheckResourceAttr("data.gitlab_group_service_accounts.with_tokens", "service_accounts.0.tokens.0.active", "true"),
+				),
+			},
+		},
+	})
+}
@@ -13,6 +13,8 @@ var _ = registerDataSource("gitlab_group_variables", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`gitlab_group_variables`" + ` data source allows to retrieve all group-level CI/CD variables.
 
+-> The ` + "`value`" + ` of masked or hidden variables is not exposed by this data source. Use the ` + "`gitlab_group_variable`" + ` data source to retrieve the value of a masked variable.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_level_variables.html)`,
 
 		ReadContext: dataSourceGitlabGroupVariablesRead,
@@ -71,7 +73,8 @@ func dataSourceGitlabGroupVariablesRead(ctx context.Context, d *schema.ResourceD
 
 func flattenGitlabGroupVariables(group string, variables []*gitlab.GroupVariable) (values []map[string]interface{}) {
 	for _, variable := range variables {
-		values = append(values, gitlabGroupVariableToStateMap(group, variable))
+		stateMap := gitlabGroupVariableToStateMap(group, variable)
+		values = append(values, redactListedVariableValue(stateMap, variable.Masked, variable.Hidden))
 	}
 	return values
 }
@@ -12,6 +12,8 @@ var _ = registerDataSource("gitlab_instance_variables", func() *schema.Resource
 	return &schema.Resource{
 		Description: `The ` + "`gitlab_instance_variables`" + ` data source allows to retrieve all instance-level CI/CD variables.
 
+-> The ` + "`value`" + ` of masked variables is not exposed by this data source. Use the ` + "`gitlab_instance_variable`" + ` data source to retrieve the value of a masked variable.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/instance_level_ci_variables.html)`,
 
 		ReadContext: dataSourceGitlabInstanceVariablesRead,
@@ -56,7 +58,8 @@ func dataSourceGitlabInstanceVariablesRead(ctx context.Context, d *schema.Resour
 
 func flattenGitlabInstanceVariables(variables []*gitlab.InstanceVariable) (values []map[string]interface{}) {
 	for _, variable := range variables {
-		values = append(values, gitlabInstanceVariableToStateMap(variable))
+		stateMap := gitlabInstanceVariableToStateMap(variable)
+		values = append(values, redactListedVariableValue(stateMap, variable.Masked, false))
 	}
 	return values
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/xanzy/go-gitlab"
 )
@@ -33,3 +34,38 @@ func TestAccDataSourceGitlabInstanceVariables_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceGitlabInstanceVariables_maskedValuesNotExposed(t *testing.T) {
+	testAccCheck(t)
+
+	variable, _, err := testGitlabClient.InstanceVariables.CreateVariable(&gitlab.CreateInstanceVariableOptions{
+		Key:    gitlab.String(fmt.Sprintf("masked_key_%d", acctest.RandInt())),
+		Value:  gitlab.String("masked-secret-value"),
+		Masked: gitlab.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := testGitlabClient.InstanceVariables.RemoveVariable(variable.Key, nil); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "gitlab_instance_variables" "this" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_instance_variables.this", "variables.*", map[string]string{
+						"key":    variable.Key,
+						"masked": "true",
+						"value":  "",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_metadata", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_metadata`" + ` data source retrieves the metadata of the GitLab instance.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/metadata.html)`,
+
+		ReadContext: dataSourceGitlabMetadataRead,
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Description: "The version of the GitLab instance.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"revision": {
+				Description: "The revision of the GitLab instance.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"enterprise": {
+				Description: "Whether the GitLab instance is an Enterprise Edition.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"kas": {
+				Description: "Metadata about the GitLab agent server for Kubernetes (KAS).",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Description: "Whether KAS is enabled.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"external_url": {
+							Description: "The URL used by the agents to communicate with KAS. Empty if KAS is disabled.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"version": {
+							Description: "The version of KAS. Empty if KAS is disabled or the version couldn't be retrieved.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabMetadataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] read GitLab instance metadata")
+	metadata, _, err := client.Metadata.GetMetadata(gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("metadata")
+	stateMap := map[string]interface{}{
+		"version":    metadata.Version,
+		"revision":   metadata.Revision,
+		"enterprise": metadata.Enterprise,
+		"kas": []map[string]interface{}{
+			{
+				"enabled":      metadata.KAS.Enabled,
+				"external_url": metadata.KAS.ExternalURL,
+				"version":      metadata.KAS.Version,
+			},
+		},
+	}
+	if err := setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
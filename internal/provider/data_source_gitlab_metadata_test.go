@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabMetadata_basic(t *testing.T) {
+	testAccCheck(t)
+
+	metadata, _, err := testGitlabClient.Metadata.GetMetadata()
+	if err != nil {
+		t.Fatalf("could not get GitLab metadata: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "gitlab_metadata" "this" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_metadata.this", "version", metadata.Version),
+					resource.TestCheckResourceAttr("data.gitlab_metadata.this", "revision", metadata.Revision),
+					resource.TestCheckResourceAttr("data.gitlab_metadata.this", "enterprise", fmt.Sprintf("%t", metadata.Enterprise)),
+					resource.TestCheckResourceAttr("data.gitlab_metadata.this", "kas.0.enabled", fmt.Sprintf("%t", metadata.KAS.Enabled)),
+					resource.TestCheckResourceAttr("data.gitlab_metadata.this", "kas.0.external_url", metadata.KAS.ExternalURL),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_namespace", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_namespace`" + ` data source allows details of a namespace to be retrieved by its full path, regardless of whether it belongs to a user or a group.
+
+-> The ` + "`members_count_with_descendants`" + `, ` + "`billable_members_count`" + ` and ` + "`plan`" + ` attributes are only returned to owners of the group and to administrators.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/namespaces.html#get-namespace-by-id)`,
+
+		ReadContext: dataSourceGitlabNamespaceRead,
+		Schema: map[string]*schema.Schema{
+			"full_path": {
+				Description: "The full path of the namespace.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"namespace_id": {
+				Description: "The ID of the namespace.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The name of the namespace.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"path": {
+				Description: "The path of the namespace.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"kind": {
+				Description: "The kind of the namespace, either `user` or `group`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"parent_id": {
+				Description: "The ID of the parent namespace, if the namespace is a subgroup.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"avatar_url": {
+				Description: "The URL of the avatar image of the namespace.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"web_url": {
+				Description: "The URL of the namespace.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"members_count_with_descendants": {
+				Description: "The number of members of the namespace and its descendants.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"billable_members_count": {
+				Description: "The number of billable members of the namespace.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"plan": {
+				Description: "The subscription plan of the namespace, e.g. `free` or `ultimate`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func dataSourceGitlabNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	fullPath := d.Get("full_path").(string)
+
+	log.Printf("[DEBUG] read namespace %s", fullPath)
+	namespace, _, err := client.Namespaces.GetNamespace(fullPath, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", namespace.ID))
+	stateMap := map[string]interface{}{
+		"full_path":                      namespace.FullPath,
+		"namespace_id":                   namespace.ID,
+		"name":                           namespace.Name,
+		"path":                           namespace.Path,
+		"kind":                           namespace.Kind,
+		"parent_id":                      namespace.ParentID,
+		"web_url":                        namespace.WebURL,
+		"members_count_with_descendants": namespace.MembersCountWithDescendants,
+		"billable_members_count":         namespace.BillableMembersCount,
+		"plan":                           namespace.Plan,
+	}
+	if namespace.AvatarURL != nil {
+		stateMap["avatar_url"] = *namespace.AvatarURL
+	}
+	if err := setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
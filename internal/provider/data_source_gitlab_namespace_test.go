@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabNamespace_basic(t *testing.T) {
+	testAccCheck(t)
+
+	currentUser := testAccCurrentUser(t)
+	testGroup := testAccCreateGroups(t, 1)[0]
+	testSubGroup := testAccCreateDescendantGroup(t, testGroup.ID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_namespace" "user" {
+						full_path = "%s"
+					}
+
+					data "gitlab_namespace" "group" {
+						full_path = "%s"
+					}
+
+					data "gitlab_namespace" "subgroup" {
+						full_path = "%s"
+					}
+				`, currentUser.Username, testGroup.FullPath, testSubGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_namespace.user", "namespace_id", fmt.Sprintf("%d", currentUser.NamespaceID)),
+					resource.TestCheckResourceAttr("data.gitlab_namespace.user", "kind", "user"),
+					resource.TestCheckResourceAttr("data.gitlab_namespace.user", "path", currentUser.Username),
+					resource.TestCheckResourceAttr("data.gitlab_namespace.group", "namespace_id", fmt.Sprintf("%d", testGroup.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_namespace.group", "kind", "group"),
+					resource.TestCheckResourceAttr("data.gitlab_namespace.group", "name", testGroup.Name),
+					resource.TestCheckResourceAttr("data.gitlab_namespace.group", "parent_id", "0"),
+					resource.TestCheckResourceAttr("data.gitlab_namespace.group", "web_url", testGroup.WebURL),
+					resource.TestCheckResourceAttr("data.gitlab_namespace.group", "members_count_with_descendants", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_namespace.subgroup", "namespace_id", fmt.Sprintf("%d", testSubGroup.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_namespace.subgroup", "kind", "group"),
+					resource.TestCheckResourceAttr("data.gitlab_namespace.subgroup", "full_path", testSubGroup.FullPath),
+					resource.TestCheckResourceAttr("data.gitlab_namespace.subgroup", "parent_id", fmt.Sprintf("%d", testGroup.ID)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_access_tokens", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_access_tokens`" + ` data source allows to retrieve the access tokens of a project.
+
+-> The token values themselves are never returned by the GitLab API.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/project_access_tokens.html#list-project-access-tokens)`,
+
+		ReadContext: dataSourceGitlabProjectAccessTokensRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"access_tokens": {
+				Description: "The list of access tokens of the project.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: gitlabAccessTokensDataSourceTokenSchema("project"),
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectAccessTokensRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlab.ListProjectAccessTokensOptions{
+		PerPage: 20,
+		Page:    1,
+	}
+
+	log.Printf("[DEBUG] list access tokens of project %s", project)
+
+	var accessTokens []*gitlab.ProjectAccessToken
+	for options.Page != 0 {
+		paginatedAccessTokens, resp, err := client.ProjectAccessTokens.ListProjectAccessTokens(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		accessTokens = append(accessTokens, paginatedAccessTokens...)
+		options.Page = resp.NextPage
+	}
+
+	var values []map[string]interface{}
+	for _, t := range accessTokens {
+		values = append(values, gitlabAccessTokensDataSourceTokenToStateMap(
+			t.ID, t.UserID, t.Name, t.Scopes, t.AccessLevel, t.CreatedAt, t.ExpiresAt, t.LastUsedAt, t.Active, t.Revoked,
+		))
+	}
+
+	d.SetId(project)
+	if err := d.Set("access_tokens", values); err != nil {
+		return diag.Errorf("failed to set access tokens to state: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_approval_rules", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_approval_rules`" + ` data source allows to retrieve the project-level approval rules of a project.
+
+-> This data source requires a GitLab Enterprise instance.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/merge_request_approvals.html#get-project-level-rules)`,
+
+		ReadContext: dataSourceGitlabProjectApprovalRulesRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"approval_rules": {
+				Description: "The list of approval rules of the project.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the approval rule.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the approval rule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"rule_type": {
+							Description: "The type of the approval rule, e.g. `regular`, `any_approver` or `report_approver`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"report_type": {
+							Description: "The report type of the approval rule, if it is a `report_approver` rule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"approvals_required": {
+							Description: "The number of approvals required by the approval rule.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"user_ids": {
+							Description: "The IDs of the users explicitly added as approvers.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+						},
+						"group_ids": {
+							Description: "The IDs of the groups whose members can approve.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+						},
+						"contains_hidden_groups": {
+							Description: "Whether the approval rule contains groups which are not visible to the current user.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"eligible_approvers": {
+							Description: "The users that are eligible to approve, i.e. the approvers added explicitly and the members of the approver groups.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Description: "The ID of the user.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"username": {
+										Description: "The username of the user.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"name": {
+										Description: "The name of the user.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"applies_to_all_protected_branches": {
+							Description: "Whether the approval rule applies to all protected branches.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"protected_branch_ids": {
+							Description: "The IDs of the protected branches the approval rule is scoped to. Empty if the rule applies to all branches.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+						},
+						"protected_branch_names": {
+							Description: "The names of the protected branches the approval rule is scoped to. Empty if the rule applies to all branches.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectApprovalRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlab.GetProjectApprovalRulesListsOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	log.Printf("[DEBUG] list approval rules of project %s", project)
+
+	var rules []*gitlab.ProjectApprovalRule
+	for options.Page != 0 {
+		paginatedRules, resp, err := client.Projects.GetProjectApprovalRules(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		rules = append(rules, paginatedRules...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(project)
+	if err := d.Set("approval_rules", flattenGitlabProjectApprovalRules(rules)); err != nil {
+		return diag.Errorf("failed to set approval rules to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabProjectApprovalRules(rules []*gitlab.ProjectApprovalRule) (values []map[string]interface{}) {
+	for _, rule := range rules {
+		var userIDs []int
+		for _, user := range rule.Users {
+			userIDs = append(userIDs, user.ID)
+		}
+		var groupIDs []int
+		for _, group := range rule.Groups {
+			groupIDs = append(groupIDs, group.ID)
+		}
+		var eligibleApprovers []map[string]interface{}
+		for _, approver := range rule.EligibleApprovers {
+			eligibleApprovers = append(eligibleApprovers, map[string]interface{}{
+				"id":       approver.ID,
+				"username": approver.Username,
+				"name":     approver.Name,
+			})
+		}
+		var protectedBranchIDs []int
+		var protectedBranchNames []string
+		for _, branch := range rule.ProtectedBranches {
+			protectedBranchIDs = append(protectedBranchIDs, branch.ID)
+			protectedBranchNames = append(protectedBranchNames, branch.Name)
+		}
+
+		values = append(values, map[string]interface{}{
+			"id":                                rule.ID,
+			"name":                              rule.Name,
+			"rule_type":                         rule.RuleType,
+			"report_type":                       rule.ReportType,
+			"approvals_required":                rule.ApprovalsRequired,
+			"user_ids":                          userIDs,
+			"group_ids":                         groupIDs,
+			"contains_hidden_groups":            rule.ContainsHiddenGroups,
+			"eligible_approvers":                eligibleApprovers,
+			"applies_to_all_protected_branches": rule.AppliesToAllProtectedBranches,
+			"protected_branch_ids":              protectedBranchIDs,
+			"protected_branch_names":            protectedBranchNames,
+		})
+	}
+	return values
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabProjectApprovalRules_basic(t *testing.T) {
+	testAccCheck(t)
+	testAccCheckEE(t)
+
+	testProject := testAccCreateProject(t)
+	testUsers := testAccCreateUsers(t, 1)
+	testBranches := testAccCreateProtectedBranches(t, testProject, 1)
+	testAccAddProjectMembers(t, testProject.ID, testUsers)
+
+	testRule, _, err := testGitlabClient.Projects.CreateProjectApprovalRule(testProject.ID, &gitlab.CreateProjectLevelRuleOptions{
+		Name:               gitlab.String("security"),
+		ApprovalsRequired:  gitlab.Int(2),
+		UserIDs:            &[]int{testUsers[0].ID},
+		ProtectedBranchIDs: &[]int{testBranches[0].ID},
+	})
+	if err != nil {
+		t.Fatalf("failed to create approval rule: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_approval_rules" "this" {
+						project = %d
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_project_approval_rules.this", "approval_rules.*", map[string]string{
+						"id":                       fmt.Sprintf("%d", testRule.ID),
+						"name":                     "security",
+						"rule_type":                "regular",
+						"approvals_required":       "2",
+						"user_ids.#":               "1",
+						"eligible_approvers.#":     "1",
+						"eligible_approvers.0.id":  fmt.Sprintf("%d", testUsers[0].ID),
+						"protected_branch_ids.#":   "1",
+						"protected_branch_names.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
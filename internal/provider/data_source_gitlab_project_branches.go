@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_branches", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_branches`" + ` data source allows details of the repository branches of a project to be retrieved by some search criteria.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/branches.html#list-repository-branches)`,
+
+		ReadContext: dataSourceGitlabProjectBranchesRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The full path or id of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"search": {
+				Description: "Return list of branches containing the search string. You can use `^term` and `term$` to find branches that begin and end with `term` respectively.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"regex": {
+				Description:      "Return list of branches with names matching a [re2](https://github.com/google/re2/wiki/Syntax) regular expression. Cannot be used with `search`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+				ConflictsWith:    []string{"search"},
+			},
+			"branches": {
+				Description: "The list of branches of the project.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the branch.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"web_url": {
+							Description: "The url of the branch (https.)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"default": {
+							Description: "Bool, true if branch is the default branch for the project.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"can_push": {
+							Description: "Bool, true if you can push to the branch.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"protected": {
+							Description: "Bool, true if branch has branch protection.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"merged": {
+							Description: "Bool, true if the branch has been merged into it's parent.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"developer_can_merge": {
+							Description: "Bool, true if developer level access allows to merge branch.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"developer_can_push": {
+							Description: "Bool, true if developer level access allows git push.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"commit": {
+							Description: "The commit associated with the branch ref.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Set:         schema.HashResource(commitSchema),
+							Elem:        commitSchema,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectBranchesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	project := d.Get("project").(string)
+	options := gitlab.ListBranchesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 20,
+			Page:    1,
+		},
+	}
+
+	if v, ok := d.GetOk("search"); ok {
+		options.Search = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("regex"); ok {
+		options.Regex = gitlab.String(v.(string))
+	}
+
+	optionsHash, err := hashstructure.Hash(&options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list gitlab branches of project: %s", project)
+
+	var branches []*gitlab.Branch
+	for options.Page != 0 {
+		paginatedBranches, resp, err := client.Branches.ListBranches(project, &options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		branches = append(branches, paginatedBranches...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", project, optionsHash))
+	if err = d.Set("branches", flattenDataBranches(branches)); err != nil {
+		return diag.Errorf("Failed to set branches to state: %v", err)
+	}
+	return nil
+}
+
+func flattenDataBranches(branches []*gitlab.Branch) (values []map[string]interface{}) {
+	for _, branch := range branches {
+		values = append(values, map[string]interface{}{
+			"name":                branch.Name,
+			"web_url":             branch.WebURL,
+			"default":             branch.Default,
+			"can_push":            branch.CanPush,
+			"protected":           branch.Protected,
+			"merged":              branch.Merged,
+			"developer_can_merge": branch.DevelopersCanMerge,
+			"developer_can_push":  branch.DevelopersCanPush,
+			"commit":              flattenCommit(branch.Commit),
+		})
+	}
+	return values
+}
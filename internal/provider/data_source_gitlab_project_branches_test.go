@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataGitlabProjectBranches_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testBranches := testAccCreateBranches(t, testProject, 2)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_branches" "this" {
+						project = "%s"
+					}
+				`, testProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_branches.this", "branches.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_project_branches.this", "branches.*", map[string]string{
+						"name":      testProject.DefaultBranch,
+						"default":   "true",
+						"protected": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_project_branches.this", "branches.*", map[string]string{
+						"name":     testBranches[0].Name,
+						"default":  "false",
+						"commit.#": "1",
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_branches" "this" {
+						project = %d
+						search  = "%s"
+					}
+				`, testProject.ID, testBranches[1].Name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_branches.this", "branches.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_branches.this", "branches.0.name", testBranches[1].Name),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_branches" "this" {
+						project = %d
+						regex   = "^acctest-"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_branches.this", "branches.#", "2"),
+				),
+			},
+		},
+	})
+}
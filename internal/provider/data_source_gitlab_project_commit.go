@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_commit", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_commit`" + ` data source allows to retrieve details about a commit in the repository of a project, identified by its SHA or the name of a branch or tag.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/commits.html#get-a-single-commit)`,
+
+		ReadContext: dataSourceGitlabProjectCommitRead,
+		Schema: constructSchema(
+			datasourceSchemaFromResourceSchema(gitlabProjectCommitGetSchema(), []string{"project"}, nil),
+			map[string]*schema.Schema{
+				"ref": {
+					Description: "The SHA of the commit or the name of a branch or tag to retrieve the latest commit of.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+			},
+		),
+	}
+})
+
+func gitlabProjectCommitGetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project": {
+			Description: "The ID or full path of the project.",
+			Type:        schema.TypeString,
+		},
+		"sha": {
+			Description: "The SHA of the commit.",
+			Type:        schema.TypeString,
+		},
+		"short_id": {
+			Description: "The short SHA of the commit.",
+			Type:        schema.TypeString,
+		},
+		"title": {
+			Description: "The title of the commit.",
+			Type:        schema.TypeString,
+		},
+		"message": {
+			Description: "The message of the commit.",
+			Type:        schema.TypeString,
+		},
+		"author_name": {
+			Description: "The name of the author of the commit.",
+			Type:        schema.TypeString,
+		},
+		"author_email": {
+			Description: "The email of the author of the commit.",
+			Type:        schema.TypeString,
+		},
+		"authored_date": {
+			Description: "The time the commit has been authored, in RFC3339 format.",
+			Type:        schema.TypeString,
+		},
+		"committer_name": {
+			Description: "The name of the committer of the commit.",
+			Type:        schema.TypeString,
+		},
+		"committer_email": {
+			Description: "The email of the committer of the commit.",
+			Type:        schema.TypeString,
+		},
+		"committed_date": {
+			Description: "The time the commit has been committed, in RFC3339 format.",
+			Type:        schema.TypeString,
+		},
+		"parent_ids": {
+			Description: "The SHAs of the parent commits.",
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"web_url": {
+			Description: "The URL of the commit.",
+			Type:        schema.TypeString,
+		},
+		"stats": {
+			Description: "The number of changed lines of the commit.",
+			Type:        schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"additions": {
+						Description: "The number of added lines.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"deletions": {
+						Description: "The number of deleted lines.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"total": {
+						Description: "The total number of changed lines.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGitlabProjectCommitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	ref := d.Get("ref").(string)
+
+	log.Printf("[DEBUG] read commit %s of project %s", ref, project)
+	commit, _, err := client.Commits.GetCommit(project, ref, &gitlab.GetCommitOptions{Stats: gitlab.Bool(true)}, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildTwoPartID(&project, &commit.ID))
+	if err := setStateMapInResourceData(gitlabProjectCommitToStateMap(project, commit), d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func gitlabProjectCommitToStateMap(project string, commit *gitlab.Commit) map[string]interface{} {
+	stateMap := map[string]interface{}{
+		"project":         project,
+		"sha":             commit.ID,
+		"short_id":        commit.ShortID,
+		"title":           commit.Title,
+		"message":         commit.Message,
+		"author_name":     commit.AuthorName,
+		"author_email":    commit.AuthorEmail,
+		"committer_name":  commit.CommitterName,
+		"committer_email": commit.CommitterEmail,
+		"parent_ids":      commit.ParentIDs,
+		"web_url":         commit.WebURL,
+	}
+	if commit.AuthoredDate != nil {
+		stateMap["authored_date"] = commit.AuthoredDate.Format(time.RFC3339)
+	}
+	if commit.CommittedDate != nil {
+		stateMap["committed_date"] = commit.CommittedDate.Format(time.RFC3339)
+	}
+	if commit.Stats != nil {
+		stateMap["stats"] = []map[string]interface{}{
+			{
+				"additions": commit.Stats.Additions,
+				"deletions": commit.Stats.Deletions,
+				"total":     commit.Stats.Total,
+			},
+		}
+	}
+	return stateMap
+}
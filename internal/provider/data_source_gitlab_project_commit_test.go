@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabProjectCommit_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	fileInfo, _, err := testGitlabClient.RepositoryFiles.CreateFile(testProject.ID, "main.go", &gitlab.CreateFileOptions{
+		Branch:        gitlab.String(testProject.DefaultBranch),
+		Content:       gitlab.String("package main\n\nfunc main() {}\n"),
+		CommitMessage: gitlab.String("Add main.go"),
+	})
+	if err != nil {
+		t.Fatalf("could not create test file: %v", err)
+	}
+	testCommit, _, err := testGitlabClient.Commits.GetCommit(testProject.ID, fileInfo.Branch, nil)
+	if err != nil {
+		t.Fatalf("could not get test commit: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_commit" "branch" {
+						project = "%[1]s"
+						ref     = "%[2]s"
+					}
+
+					data "gitlab_project_commit" "sha" {
+						project = "%[1]s"
+						ref     = "%[3]s"
+					}
+				`, testProject.PathWithNamespace, testProject.DefaultBranch, testCommit.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_commit.branch", "sha", testCommit.ID),
+					resource.TestCheckResourceAttr("data.gitlab_project_commit.branch", "short_id", testCommit.ShortID),
+					resource.TestCheckResourceAttr("data.gitlab_project_commit.branch", "title", "Add main.go"),
+					resource.TestCheckResourceAttr("data.gitlab_project_commit.branch", "parent_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_commit.branch", "stats.0.additions", "3"),
+					resource.TestCheckResourceAttr("data.gitlab_project_commit.branch", "stats.0.deletions", "0"),
+					resource.TestCheckResourceAttr("data.gitlab_project_commit.branch", "web_url", testCommit.WebURL),
+					resource.TestCheckResourceAttrPair("data.gitlab_project_commit.sha", "sha", "data.gitlab_project_commit.branch", "sha"),
+					resource.TestCheckResourceAttrPair("data.gitlab_project_commit.sha", "authored_date", "data.gitlab_project_commit.branch", "authored_date"),
+				),
+			},
+		},
+	})
+}
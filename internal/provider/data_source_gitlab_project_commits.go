@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_commits", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_commits`" + ` data source allows to retrieve the commits in the repository of a project, by some search criteria.
+
+-> The commits are returned in reverse chronological order, thus the first commit is the latest one matching the criteria.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/commits.html#list-repository-commits)`,
+
+		ReadContext: dataSourceGitlabProjectCommitsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"ref_name": {
+				Description: "The name of a branch, tag or revision range to list the commits of. Defaults to the default branch.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"since": {
+				Description: "Only return the commits after or on this date. Expected in ISO 8601 format (2019-03-15T08:00:00Z).",
+				Type:        schema.TypeString,
+				Optional:    true,
+				// NOTE: since RFC3339 is pretty much a subset of ISO8601 and actually expected by GitLab,
+				//       we use it here to avoid having to parse the string ourselves.
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"until": {
+				Description: "Only return the commits before or on this date. Expected in ISO 8601 format (2019-03-15T08:00:00Z).",
+				Type:        schema.TypeString,
+				Optional:    true,
+				// NOTE: since RFC3339 is pretty much a subset of ISO8601 and actually expected by GitLab,
+				//       we use it here to avoid having to parse the string ourselves.
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"path": {
+				Description: "Only return the commits changing this file path.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"author": {
+				Description: "Only return the commits of this author.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"all": {
+				Description:   "Return the commits of all branches. Cannot be used with `ref_name`.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"ref_name"},
+			},
+			"first_parent": {
+				Description: "Only follow the first parent commit upon seeing a merge commit.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"with_stats": {
+				Description: "Include the `stats` of each commit.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"commits": {
+				Description: "The list of commits.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: datasourceSchemaFromResourceSchema(gitlabProjectCommitGetSchema(), nil, nil),
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectCommitsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlab.ListCommitsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+	}
+
+	if v, ok := d.GetOk("ref_name"); ok {
+		options.RefName = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("since"); ok {
+		parsedSince, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.Errorf("failed to parse since: %s. It must be in valid RFC3339 format.", err)
+		}
+		options.Since = gitlab.Time(parsedSince)
+	}
+
+	if v, ok := d.GetOk("until"); ok {
+		parsedUntil, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.Errorf("failed to parse until: %s. It must be in valid RFC3339 format.", err)
+		}
+		options.Until = gitlab.Time(parsedUntil)
+	}
+
+	if v, ok := d.GetOk("path"); ok {
+		options.Path = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("author"); ok {
+		options.Author = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("all"); ok {
+		options.All = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("first_parent"); ok {
+		options.FirstParent = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("with_stats"); ok {
+		options.WithStats = gitlab.Bool(v.(bool))
+	}
+
+	optionsHash, err := hashstructure.Hash(options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list commits of project %s", project)
+
+	var commits []*gitlab.Commit
+	for options.Page != 0 {
+		paginatedCommits, resp, err := client.Commits.ListCommits(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		commits = append(commits, paginatedCommits...)
+		options.Page = resp.NextPage
+	}
+
+	var values []map[string]interface{}
+	for _, commit := range commits {
+		values = append(values, gitlabProjectCommitToStateMap(project, commit))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", project, optionsHash))
+	if err := d.Set("commits", values); err != nil {
+		return diag.Errorf("failed to set commits to state: %v", err)
+	}
+	return nil
+}
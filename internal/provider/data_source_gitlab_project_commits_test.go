@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabProjectCommits_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	for _, path := range []string{"docs/index.md", "main.go"} {
+		if _, _, err := testGitlabClient.RepositoryFiles.CreateFile(testProject.ID, path, &gitlab.CreateFileOptions{
+			Branch:        gitlab.String(testProject.DefaultBranch),
+			Content:       gitlab.String("content\n"),
+			CommitMessage: gitlab.String(fmt.Sprintf("Add %s", path)),
+		}); err != nil {
+			t.Fatalf("could not create test file: %v", err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_commits" "this" {
+						project = "%s"
+					}
+				`, testProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					// The README of the project has been committed initially.
+					resource.TestCheckResourceAttr("data.gitlab_project_commits.this", "commits.#", "3"),
+					resource.TestCheckResourceAttr("data.gitlab_project_commits.this", "commits.0.title", "Add main.go"),
+					resource.TestCheckResourceAttr("data.gitlab_project_commits.this", "commits.1.title", "Add docs/index.md"),
+					resource.TestCheckResourceAttr("data.gitlab_project_commits.this", "commits.0.stats.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_commits" "this" {
+						project    = "%s"
+						ref_name   = "%s"
+						path       = "docs"
+						with_stats = true
+					}
+				`, testProject.PathWithNamespace, testProject.DefaultBranch),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_commits.this", "commits.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_commits.this", "commits.0.title", "Add docs/index.md"),
+					resource.TestCheckResourceAttr("data.gitlab_project_commits.this", "commits.0.stats.0.additions", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_commits" "this" {
+						project = "%s"
+						since   = "2100-01-01T00:00:00Z"
+					}
+				`, testProject.PathWithNamespace),
+				Check: resource.TestCheckResourceAttr("data.gitlab_project_commits.this", "commits.#", "0"),
+			},
+		},
+	})
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_deploy_keys", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_deploy_keys`" + ` data source allows to retrieve the deploy keys enabled for a project.
+
+-> Use the ` + "`gitlab_instance_deploy_keys`" + ` data source to retrieve all deploy keys of the instance together with the projects they have write access to.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/deploy_keys.html#list-project-deploy-keys)`,
+
+		ReadContext: dataSourceGitlabProjectDeployKeysRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"deploy_keys": {
+				Description: "The list of deploy keys of the project.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the deploy key.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"title": {
+							Description: "The title of the deploy key.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"key": {
+							Description: "The public SSH key of the deploy key.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"can_push": {
+							Description: "Whether the deploy key has write access to the project.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The creation date of the deploy key. In RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"expires_at": {
+							Description: "The expiration date of the deploy key. In RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectDeployKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlab.ListProjectDeployKeysOptions{
+		PerPage: 20,
+		Page:    1,
+	}
+
+	log.Printf("[DEBUG] list deploy keys of project %s", project)
+
+	var deployKeys []*gitlab.ProjectDeployKey
+	for options.Page != 0 {
+		paginatedDeployKeys, resp, err := client.DeployKeys.ListProjectDeployKeys(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		deployKeys = append(deployKeys, paginatedDeployKeys...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(project)
+	if err := d.Set("deploy_keys", flattenGitlabProjectDeployKeys(deployKeys)); err != nil {
+		return diag.Errorf("failed to set deploy keys to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabProjectDeployKeys(deployKeys []*gitlab.ProjectDeployKey) (values []map[string]interface{}) {
+	for _, deployKey := range deployKeys {
+		v := map[string]interface{}{
+			"id":       deployKey.ID,
+			"title":    deployKey.Title,
+			"key":      deployKey.Key,
+			"can_push": deployKey.CanPush,
+		}
+		if deployKey.CreatedAt != nil {
+			v["created_at"] = deployKey.CreatedAt.Format(time.RFC3339)
+		}
+		if deployKey.ExpiresAt != nil {
+			v["expires_at"] = deployKey.ExpiresAt.Format(time.RFC3339)
+		}
+		values = append(values, v)
+	}
+	return values
+}
@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_environments", func() *schema.Resource {
+	validEnvironmentStateValues := []string{"available", "stopping", "stopped"}
+
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_environments`" + ` data source allows to retrieve the environments of a project, including their last deployment.
+
+-> The last deployment is only returned by the single environment endpoint, thus an additional request is made for each environment matching the filters.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/environments.html#list-environments)`,
+
+		ReadContext: dataSourceGitlabProjectEnvironmentsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description:   "Return the environment with this name. Mutually exclusive with `search`.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"search"},
+			},
+			"search": {
+				Description:   "Return the environments with a name matching the search criteria. Mutually exclusive with `name`.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+			},
+			"states": {
+				Description:      fmt.Sprintf("Return only the environments in the given state. Valid values are %s.", renderValueListForDocs(validEnvironmentStateValues)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validEnvironmentStateValues, false)),
+			},
+			"environments": {
+				Description: "The list of environments.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"environment_id": {
+							Description: "The ID of the environment.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"slug": {
+							Description: "The name of the environment, truncated to 24 characters and made DNS-compatible.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"state": {
+							Description: "The state of the environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tier": {
+							Description: "The tier of the environment, e.g. `production` or `staging`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"external_url": {
+							Description: "The external URL of the environment.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The time the environment has been created, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"updated_at": {
+							Description: "The time the environment has last been updated, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_deployment": {
+							Description: "The last deployment to the environment. Empty if the environment has never been deployed to.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Description: "The ID of the deployment.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"iid": {
+										Description: "The ID of the deployment in the project.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"ref": {
+										Description: "The ref which has been deployed.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"sha": {
+										Description: "The SHA of the commit which has been deployed.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"status": {
+										Description: "The status of the deployment.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"user_username": {
+										Description: "The username of the user who triggered the deployment.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"job_name": {
+										Description: "The name of the job which ran the deployment.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"pipeline_id": {
+										Description: "The ID of the pipeline which ran the deployment.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"created_at": {
+										Description: "The time the deployment has been created, in RFC3339 format.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"updated_at": {
+										Description: "The time the deployment has last been updated, in RFC3339 format.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectEnvironmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	project := d.Get("project").(string)
+	options := gitlab.ListEnvironmentsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 20,
+			Page:    1,
+		},
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		options.Name = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("search"); ok {
+		options.Search = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("states"); ok {
+		options.States = gitlab.String(v.(string))
+	}
+
+	optionsHash, err := hashstructure.Hash(&options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list environments of project %s", project)
+
+	var environments []*gitlab.Environment
+	for options.Page != 0 {
+		paginatedEnvironments, resp, err := client.Environments.ListEnvironments(project, &options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, e := range paginatedEnvironments {
+			environment, _, err := client.Environments.GetEnvironment(project, e.ID, gitlab.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			environments = append(environments, environment)
+		}
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%s-%d", project, optionsHash))
+	if err = d.Set("environments", flattenGitlabProjectEnvironments(environments)); err != nil {
+		return diag.Errorf("failed to set environments to state: %v", err)
+	}
+
+	return nil
+}
+
+func flattenGitlabProjectEnvironments(environments []*gitlab.Environment) (values []map[string]interface{}) {
+	for _, environment := range environments {
+		v := map[string]interface{}{
+			"environment_id": environment.ID,
+			"name":           environment.Name,
+			"slug":           environment.Slug,
+			"description":    environment.Description,
+			"state":          environment.State,
+			"tier":           environment.Tier,
+			"external_url":   environment.ExternalURL,
+		}
+		if environment.CreatedAt != nil {
+			v["created_at"] = environment.CreatedAt.Format(time.RFC3339)
+		}
+		if environment.UpdatedAt != nil {
+			v["updated_at"] = environment.UpdatedAt.Format(time.RFC3339)
+		}
+
+		var lastDeployment []map[string]interface{}
+		if deployment := environment.LastDeployment; deployment != nil {
+			dv := map[string]interface{}{
+				"id":          deployment.ID,
+				"iid":         deployment.IID,
+				"ref":         deployment.Ref,
+				"sha":         deployment.SHA,
+				"status":      deployment.Status,
+				"job_name":    deployment.Deployable.Name,
+				"pipeline_id": deployment.Deployable.Pipeline.ID,
+			}
+			if deployment.User != nil {
+				dv["user_username"] = deployment.User.Username
+			}
+			if deployment.CreatedAt != nil {
+				dv["created_at"] = deployment.CreatedAt.Format(time.RFC3339)
+			}
+			if deployment.UpdatedAt != nil {
+				dv["updated_at"] = deployment.UpdatedAt.Format(time.RFC3339)
+			}
+			lastDeployment = append(lastDeployment, dv)
+		}
+		v["last_deployment"] = lastDeployment
+
+		values = append(values, v)
+	}
+	return values
+}
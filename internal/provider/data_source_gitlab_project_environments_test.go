@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabProjectEnvironments_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testAccCreateProjectEnvironment(t, testProject.ID, &gitlab.CreateEnvironmentOptions{
+		Name: gitlab.String("staging"),
+	})
+	testAccCreateProjectEnvironment(t, testProject.ID, &gitlab.CreateEnvironmentOptions{
+		Name:        gitlab.String("production"),
+		ExternalURL: gitlab.String("https://example.com"),
+	})
+
+	branch, _, err := testGitlabClient.Branches.GetBranch(testProject.ID, testProject.DefaultBranch)
+	if err != nil {
+		t.Fatalf("could not get default branch: %v", err)
+	}
+	deployment, _, err := testGitlabClient.Deployments.CreateProjectDeployment(testProject.ID, &gitlab.CreateProjectDeploymentOptions{
+		Environment: gitlab.String("production"),
+		Ref:         gitlab.String(testProject.DefaultBranch),
+		SHA:         gitlab.String(branch.Commit.ID),
+		Tag:         gitlab.Bool(false),
+		Status:      gitlab.DeploymentStatus(gitlab.DeploymentStatusSuccess),
+	})
+	if err != nil {
+		t.Fatalf("could not create test deployment: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_environments" "all" {
+						project = %[1]d
+					}
+
+					data "gitlab_project_environments" "production" {
+						project = %[1]d
+						name    = "production"
+						states  = "available"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_environments.all", "environments.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_project_environments.all", "environments.*", map[string]string{
+						"name":              "staging",
+						"last_deployment.#": "0",
+					}),
+					resource.TestCheckResourceAttr("data.gitlab_project_environments.production", "environments.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_environments.production", "environments.0.external_url", "https://example.com"),
+					resource.TestCheckResourceAttr("data.gitlab_project_environments.production", "environments.0.state", "available"),
+					resource.TestCheckResourceAttr("data.gitlab_project_environments.production", "environments.0.last_deployment.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_environments.production", "environments.0.last_deployment.0.id", fmt.Sprintf("%d", deployment.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_project_environments.production", "environments.0.last_deployment.0.sha", branch.Commit.ID),
+					resource.TestCheckResourceAttr("data.gitlab_project_environments.production", "environments.0.last_deployment.0.status", "success"),
+				),
+			},
+		},
+	})
+}
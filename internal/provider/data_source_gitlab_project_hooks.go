@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_hooks", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_hooks`" + ` data source allows to retrieve details about hooks in a project.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/projects.html#list-project-hooks)`,
+
+		ReadContext: dataSourceGitlabProjectHooksRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"hooks": {
+				Description: "The list of hooks.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: datasourceSchemaFromResourceSchema(gitlabProjectHookGetSchema(), nil, nil),
+				},
+			},
+		},
+	}
+})
+
+func gitlabProjectHookGetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project": {
+			Description: "The ID or full path of the project.",
+			Type:        schema.TypeString,
+		},
+		"hook_id": {
+			Description: "The ID of the hook.",
+			Type:        schema.TypeInt,
+		},
+		"url": {
+			Description: "The URL the hook invokes.",
+			Type:        schema.TypeString,
+		},
+		"push_events": {
+			Description: "Whether the hook is invoked for push events.",
+			Type:        schema.TypeBool,
+		},
+		"push_events_branch_filter": {
+			Description: "The hook is only invoked for push events on matching branches.",
+			Type:        schema.TypeString,
+		},
+		"issues_events": {
+			Description: "Whether the hook is invoked for issues events.",
+			Type:        schema.TypeBool,
+		},
+		"confidential_issues_events": {
+			Description: "Whether the hook is invoked for confidential issues events.",
+			Type:        schema.TypeBool,
+		},
+		"merge_requests_events": {
+			Description: "Whether the hook is invoked for merge requests events.",
+			Type:        schema.TypeBool,
+		},
+		"tag_push_events": {
+			Description: "Whether the hook is invoked for tag push events.",
+			Type:        schema.TypeBool,
+		},
+		"note_events": {
+			Description: "Whether the hook is invoked for notes events.",
+			Type:        schema.TypeBool,
+		},
+		"confidential_note_events": {
+			Description: "Whether the hook is invoked for confidential notes events.",
+			Type:        schema.TypeBool,
+		},
+		"job_events": {
+			Description: "Whether the hook is invoked for job events.",
+			Type:        schema.TypeBool,
+		},
+		"pipeline_events": {
+			Description: "Whether the hook is invoked for pipeline events.",
+			Type:        schema.TypeBool,
+		},
+		"wiki_page_events": {
+			Description: "Whether the hook is invoked for wiki page events.",
+			Type:        schema.TypeBool,
+		},
+		"deployment_events": {
+			Description: "Whether the hook is invoked for deployment events.",
+			Type:        schema.TypeBool,
+		},
+		"releases_events": {
+			Description: "Whether the hook is invoked for releases events.",
+			Type:        schema.TypeBool,
+		},
+		"resource_access_token_events": {
+			Description: "Whether the hook is invoked for resource access token events.",
+			Type:        schema.TypeBool,
+		},
+		"enable_ssl_verification": {
+			Description: "Whether SSL verification is enabled when invoking the hook.",
+			Type:        schema.TypeBool,
+		},
+		"alert_status": {
+			Description: "The status of the hook, e.g. `executable` or `disabled` if it has been automatically disabled after failures.",
+			Type:        schema.TypeString,
+		},
+		"custom_webhook_template": {
+			Description: "The custom template of the hook payload.",
+			Type:        schema.TypeString,
+		},
+		"created_at": {
+			Description: "The time the hook has been created, in RFC3339 format.",
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func dataSourceGitlabProjectHooksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlab.ListProjectHooksOptions{
+		Page:    1,
+		PerPage: 20,
+	}
+
+	var hooks []*gitlab.ProjectHook
+	for options.Page != 0 {
+		paginatedHooks, resp, err := client.Projects.ListProjectHooks(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		hooks = append(hooks, paginatedHooks...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(project)
+	if err := d.Set("hooks", flattenGitlabProjectHooks(project, hooks)); err != nil {
+		return diag.Errorf("failed to set hooks to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabProjectHooks(project string, hooks []*gitlab.ProjectHook) (values []map[string]interface{}) {
+	for _, hook := range hooks {
+		values = append(values, gitlabProjectHookToStateMap(project, hook))
+	}
+	return values
+}
+
+func gitlabProjectHookToStateMap(project string, hook *gitlab.ProjectHook) map[string]interface{} {
+	stateMap := map[string]interface{}{
+		"project":                      project,
+		"hook_id":                      hook.ID,
+		"url":                          hook.URL,
+		"push_events":                  hook.PushEvents,
+		"push_events_branch_filter":    hook.PushEventsBranchFilter,
+		"issues_events":                hook.IssuesEvents,
+		"confidential_issues_events":   hook.ConfidentialIssuesEvents,
+		"merge_requests_events":        hook.MergeRequestsEvents,
+		"tag_push_events":              hook.TagPushEvents,
+		"note_events":                  hook.NoteEvents,
+		"confidential_note_events":     hook.ConfidentialNoteEvents,
+		"job_events":                   hook.JobEvents,
+		"pipeline_events":              hook.PipelineEvents,
+		"wiki_page_events":             hook.WikiPageEvents,
+		"deployment_events":            hook.DeploymentEvents,
+		"releases_events":              hook.ReleasesEvents,
+		"resource_access_token_events": hook.ResourceAccessTokenEvents,
+		"enable_ssl_verification":      hook.EnableSSLVerification,
+		"alert_status":                 hook.AlertStatus,
+		"custom_webhook_template":      hook.CustomWebhookTemplate,
+	}
+	if hook.CreatedAt != nil {
+		stateMap["created_at"] = hook.CreatedAt.Format(time.RFC3339)
+	}
+	return stateMap
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabProjectHooks_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testHooks := testAccCreateProjectHooks(t, testProject.ID, 25)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_hooks" "this" {
+						project = %d
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_hooks.this", "hooks.#", strconv.Itoa(len(testHooks))),
+					resource.TestCheckResourceAttr("data.gitlab_project_hooks.this", "hooks.0.hook_id", strconv.Itoa(testHooks[0].ID)),
+					resource.TestCheckResourceAttr("data.gitlab_project_hooks.this", "hooks.0.url", testHooks[0].URL),
+					resource.TestCheckResourceAttr("data.gitlab_project_hooks.this", "hooks.0.issues_events", "true"),
+					resource.TestCheckResourceAttr("data.gitlab_project_hooks.this", "hooks.1.issues_events", "false"),
+					resource.TestCheckResourceAttr("data.gitlab_project_hooks.this", "hooks.24.hook_id", strconv.Itoa(testHooks[24].ID)),
+					resource.TestCheckResourceAttr("data.gitlab_project_hooks.this", "hooks.24.url", testHooks[24].URL),
+				),
+			},
+		},
+	})
+}
@@ -27,6 +27,8 @@ var validIssueOrderByValues = []string{
 var _ = registerDataSource("gitlab_project_issues", func() *schema.Resource {
 	validIssueScopeValues := []string{"created_by_me", "assigned_to_me", "all"}
 	validIssueSortValues := []string{"asc", "desc"}
+	validIssueStateValues := []string{"all", "opened", "closed"}
+	validIssueInValues := []string{"title", "description", "title,description"}
 
 	return &schema.Resource{
 		Description: `The ` + "`gitlab_project_issues`" + ` data source allows to retrieve details about issues in a project.
@@ -50,7 +52,7 @@ var _ = registerDataSource("gitlab_project_issues", func() *schema.Resource {
 			},
 			"not_assignee_id": {
 				Description: "Return issues that do not match the assignee id.",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 				Optional:    true,
 			},
@@ -62,19 +64,38 @@ var _ = registerDataSource("gitlab_project_issues", func() *schema.Resource {
 					"assignee_id",
 				},
 			},
+			"not_assignee_username": {
+				Description: "Return issues that are not assigned to the given username.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"author_id": {
 				Description: "Return issues created by the given user id. Combine with scope=all or scope=assigned_to_me.",
 				Type:        schema.TypeInt,
 				Optional:    true,
+				ConflictsWith: []string{
+					"author_username",
+				},
 			},
 			"not_author_id": {
 				Description: "Return issues that do not match the author id.",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 				Optional:    true,
 			},
-			// NOTE: not yet supported in go-gitlab.
-			// "author_username": {},
+			"author_username": {
+				Description: "Return issues created by the given username. Mutually exclusive with author_id.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ConflictsWith: []string{
+					"author_id",
+				},
+			},
+			"not_author_username": {
+				Description: "Return issues that do not match the author username.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"confidential": {
 				Description: "Filter confidential or public issues.",
 				Type:        schema.TypeBool,
@@ -105,7 +126,7 @@ var _ = registerDataSource("gitlab_project_issues", func() *schema.Resource {
 			// "epic_id": {}
 			"iids": {
 				Description: "Return only the issues having the given iid",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 				Optional:    true,
 			},
@@ -115,18 +136,28 @@ var _ = registerDataSource("gitlab_project_issues", func() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validIssueTypes, false)),
 			},
+			"iteration_id": {
+				Description: "Return issues assigned to the given iteration ID. (only GitLab Premium or higher)",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
 			// NOTE: not yet supported in go-gitlab.
-			// iteration_id: {},
 			// iteration_title: {},
+			"in": {
+				Description:      fmt.Sprintf("Modify the scope of the search attribute. Valid values are %s. Default is title,description", renderValueListForDocs(validIssueInValues)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validIssueInValues, false)),
+			},
 			"labels": {
 				Description: "Return issues with labels. Issues must have all labels to be returned. None lists all issues with no labels. Any lists all issues with at least one label. No+Label (Deprecated) lists all issues with no labels. Predefined names are case-insensitive.",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Optional:    true,
 			},
 			"not_labels": {
 				Description: "Return issues that do not match the labels.",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Optional:    true,
 			},
@@ -136,7 +167,7 @@ var _ = registerDataSource("gitlab_project_issues", func() *schema.Resource {
 				Optional:    true,
 			},
 			"not_milestone": {
-				Description: "Return issues that do not match the milestone.",
+				Description: "Return issues that do not match the milestone. Only the first milestone is used, because the API only supports a single negated milestone.",
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Optional:    true,
@@ -148,7 +179,7 @@ var _ = registerDataSource("gitlab_project_issues", func() *schema.Resource {
 			},
 			"not_my_reaction_emoji": {
 				Description: "Return issues not reacted by the authenticated user by the given emoji.",
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Optional:    true,
 			},
@@ -169,6 +200,12 @@ var _ = registerDataSource("gitlab_project_issues", func() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"state": {
+				Description:      fmt.Sprintf("Return all issues or just those that are opened or closed. Valid values are %s. Defaults to all.", renderValueListForDocs(validIssueStateValues)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validIssueStateValues, false)),
+			},
 			"sort": {
 				Description:      "Return issues sorted in asc or desc order. Default is desc",
 				Type:             schema.TypeString,
@@ -233,13 +270,13 @@ func dataSourceGitlabProjectIssuesRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if v, ok := d.GetOk("labels"); ok {
-		gitlabLabels := gitlab.Labels(*stringSetToStringSlice(v.(*schema.Set)))
+		gitlabLabels := gitlab.LabelOptions(*stringSetToStringSlice(v.(*schema.Set)))
 		options.Labels = &gitlabLabels
 	}
 
 	if v, ok := d.GetOk("not_labels"); ok {
-		gitlabLabels := gitlab.Labels(*stringSetToStringSlice(v.(*schema.Set)))
-		options.Labels = &gitlabLabels
+		gitlabLabels := gitlab.LabelOptions(*stringSetToStringSlice(v.(*schema.Set)))
+		options.NotLabels = &gitlabLabels
 	}
 
 	if v, ok := d.GetOk("with_labels_details"); ok {
@@ -251,7 +288,10 @@ func dataSourceGitlabProjectIssuesRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if v, ok := d.GetOk("not_milestone"); ok {
-		options.NotMilestone = stringSetToStringSlice(v.(*schema.Set))
+		// NOTE: the API only supports filtering by a single negated milestone.
+		if notMilestones := v.([]interface{}); len(notMilestones) > 0 {
+			options.NotMilestone = gitlab.String(notMilestones[0].(string))
+		}
 	}
 
 	if v, ok := d.GetOk("scope"); ok {
@@ -262,6 +302,14 @@ func dataSourceGitlabProjectIssuesRead(ctx context.Context, d *schema.ResourceDa
 		options.AuthorID = gitlab.Int(v.(int))
 	}
 
+	if v, ok := d.GetOk("author_username"); ok {
+		options.AuthorUsername = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("not_author_username"); ok {
+		options.NotAuthorUsername = gitlab.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("not_author_id"); ok {
 		options.NotAuthorID = intSetToIntSlice(v.(*schema.Set))
 	}
@@ -278,6 +326,10 @@ func dataSourceGitlabProjectIssuesRead(ctx context.Context, d *schema.ResourceDa
 		options.AssigneeUsername = gitlab.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("not_assignee_username"); ok {
+		options.NotAssigneeUsername = gitlab.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("my_reaction_emoji"); ok {
 		options.MyReactionEmoji = gitlab.String(v.(string))
 	}
@@ -346,6 +398,10 @@ func dataSourceGitlabProjectIssuesRead(ctx context.Context, d *schema.ResourceDa
 		options.IssueType = gitlab.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("iteration_id"); ok {
+		options.IterationID = gitlab.Int(v.(int))
+	}
+
 	var issues []*gitlab.Issue
 	for options.Page != 0 {
 		paginatedIssues, resp, err := client.Issues.ListProjectIssues(project, &options, gitlab.WithContext(ctx))
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
 )
 
 func TestAccDataSourceGitlabProjectIssues_basic(t *testing.T) {
@@ -33,6 +34,82 @@ func TestAccDataSourceGitlabProjectIssues_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceGitlabProjectIssues_filters(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testIssues := testAccCreateProjectIssues(t, testProject.ID, 3)
+	testMilestone := testAccCreateProjectMilestones(t, testProject.ID, 1)[0]
+
+	currentUser, _, err := testGitlabClient.Users.CurrentUser()
+	if err != nil {
+		t.Fatalf("could not get current user: %v", err)
+	}
+
+	if _, _, err := testGitlabClient.Issues.UpdateIssue(testProject.ID, testIssues[0].IID, &gitlab.UpdateIssueOptions{
+		Labels:      &gitlab.LabelOptions{"bug", "backend"},
+		MilestoneID: gitlab.Int(testMilestone.ID),
+		AssigneeIDs: &[]int{currentUser.ID},
+	}); err != nil {
+		t.Fatalf("could not update test issue: %v", err)
+	}
+	if _, _, err := testGitlabClient.Issues.UpdateIssue(testProject.ID, testIssues[1].IID, &gitlab.UpdateIssueOptions{
+		Labels:     &gitlab.LabelOptions{"bug"},
+		StateEvent: gitlab.String("close"),
+	}); err != nil {
+		t.Fatalf("could not update test issue: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_issues" "labels" {
+						project = %[1]d
+						labels  = ["bug", "backend"]
+					}
+
+					data "gitlab_project_issues" "opened" {
+						project = %[1]d
+						state   = "opened"
+						labels  = ["bug"]
+					}
+
+					data "gitlab_project_issues" "milestone" {
+						project   = %[1]d
+						milestone = "%[2]s"
+					}
+
+					data "gitlab_project_issues" "assignee" {
+						project           = %[1]d
+						assignee_username = "%[3]s"
+					}
+
+					data "gitlab_project_issues" "search" {
+						project = %[1]d
+						search  = "Description 2"
+						in      = "description"
+					}
+				`, testProject.ID, testMilestone.Title, currentUser.Username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_issues.labels", "issues.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_issues.labels", "issues.0.iid", fmt.Sprintf("%d", testIssues[0].IID)),
+					resource.TestCheckResourceAttr("data.gitlab_project_issues.opened", "issues.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_issues.opened", "issues.0.iid", fmt.Sprintf("%d", testIssues[0].IID)),
+					resource.TestCheckResourceAttr("data.gitlab_project_issues.milestone", "issues.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_issues.milestone", "issues.0.milestone_id", fmt.Sprintf("%d", testMilestone.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_project_issues.assignee", "issues.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_issues.assignee", "issues.0.iid", fmt.Sprintf("%d", testIssues[0].IID)),
+					resource.TestCheckResourceAttr("data.gitlab_project_issues.search", "issues.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_issues.search", "issues.0.iid", fmt.Sprintf("%d", testIssues[2].IID)),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataGitlabProjectIssuesConfig(projectID int) string {
 	return fmt.Sprintf(`
 data "gitlab_project_issues" "this" {
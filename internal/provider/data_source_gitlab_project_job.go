@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_job", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_job`" + ` data source allows to retrieve the latest successful job with a given name for a ref of a project, including the URL to download its artifacts.
+
+-> The jobs of the project are searched from the newest to the oldest, thus the lookup may require several API requests for projects with many jobs.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/jobs.html#list-project-jobs)`,
+
+		ReadContext: dataSourceGitlabProjectJobRead,
+		Schema:      datasourceSchemaFromResourceSchema(gitlabProjectJobGetSchema(), []string{"project", "ref", "name"}, nil),
+	}
+})
+
+func gitlabProjectJobGetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project": {
+			Description: "The ID or full path of the project.",
+			Type:        schema.TypeString,
+		},
+		"ref": {
+			Description: "The branch or tag the job has run for.",
+			Type:        schema.TypeString,
+		},
+		"name": {
+			Description: "The name of the job.",
+			Type:        schema.TypeString,
+		},
+		"job_id": {
+			Description: "The ID of the job.",
+			Type:        schema.TypeInt,
+		},
+		"stage": {
+			Description: "The stage of the job.",
+			Type:        schema.TypeString,
+		},
+		"status": {
+			Description: "The status of the job.",
+			Type:        schema.TypeString,
+		},
+		"tag": {
+			Description: "Whether the job has run for a tag.",
+			Type:        schema.TypeBool,
+		},
+		"pipeline_id": {
+			Description: "The ID of the pipeline of the job.",
+			Type:        schema.TypeInt,
+		},
+		"commit_sha": {
+			Description: "The SHA of the commit the job has run for.",
+			Type:        schema.TypeString,
+		},
+		"user_username": {
+			Description: "The username of the user who triggered the job.",
+			Type:        schema.TypeString,
+		},
+		"allow_failure": {
+			Description: "Whether the job is allowed to fail.",
+			Type:        schema.TypeBool,
+		},
+		"failure_reason": {
+			Description: "The reason the job failed, if it failed.",
+			Type:        schema.TypeString,
+		},
+		"web_url": {
+			Description: "The web URL of the job.",
+			Type:        schema.TypeString,
+		},
+		"created_at": {
+			Description: "The time the job has been created, in RFC3339 format.",
+			Type:        schema.TypeString,
+		},
+		"started_at": {
+			Description: "The time the job has been started, in RFC3339 format.",
+			Type:        schema.TypeString,
+		},
+		"finished_at": {
+			Description: "The time the job has finished, in RFC3339 format.",
+			Type:        schema.TypeString,
+		},
+		"artifacts_expire_at": {
+			Description: "The time the artifacts of the job expire, in RFC3339 format.",
+			Type:        schema.TypeString,
+		},
+		"artifacts": {
+			Description: "The artifacts of the job.",
+			Type:        schema.TypeList,
+			Elem:        gitlabJobArtifactsSchema(),
+		},
+		"artifacts_download_url": {
+			Description: "The API URL to download the artifacts archive of the job. Requires authentication, e.g. with a `PRIVATE-TOKEN` header.",
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func dataSourceGitlabProjectJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	ref := d.Get("ref").(string)
+	name := d.Get("name").(string)
+
+	options := &gitlab.ListJobsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		Scope: &[]gitlab.BuildStateValue{gitlab.Success},
+	}
+
+	log.Printf("[DEBUG] find latest successful job %s for ref %s of project %s", name, ref, project)
+
+	var job *gitlab.Job
+	for options.Page != 0 && job == nil {
+		paginatedJobs, resp, err := client.Jobs.ListProjectJobs(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, j := range paginatedJobs {
+			if j.Ref == ref && j.Name == name {
+				job = j
+				break
+			}
+		}
+		options.Page = resp.NextPage
+	}
+	if job == nil {
+		return diag.Errorf("no successful job %s found for ref %s of project %s", name, ref, project)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", project, job.ID))
+	if err := setStateMapInResourceData(gitlabProjectJobToStateMap(client, project, job), d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func gitlabProjectJobToStateMap(client *gitlab.Client, project string, job *gitlab.Job) map[string]interface{} {
+	stateMap := map[string]interface{}{
+		"project":                project,
+		"ref":                    job.Ref,
+		"name":                   job.Name,
+		"job_id":                 job.ID,
+		"stage":                  job.Stage,
+		"status":                 job.Status,
+		"tag":                    job.Tag,
+		"pipeline_id":            job.Pipeline.ID,
+		"allow_failure":          job.AllowFailure,
+		"failure_reason":         job.FailureReason,
+		"web_url":                job.WebURL,
+		"artifacts":              flattenGitlabJobArtifacts(job),
+		"artifacts_download_url": fmt.Sprintf("%sprojects/%s/jobs/%d/artifacts", client.BaseURL(), gitlab.PathEscape(project), job.ID),
+	}
+	if job.Commit != nil {
+		stateMap["commit_sha"] = job.Commit.ID
+	}
+	if job.User != nil {
+		stateMap["user_username"] = job.User.Username
+	}
+	if job.CreatedAt != nil {
+		stateMap["created_at"] = job.CreatedAt.Format(time.RFC3339)
+	}
+	if job.StartedAt != nil {
+		stateMap["started_at"] = job.StartedAt.Format(time.RFC3339)
+	}
+	if job.FinishedAt != nil {
+		stateMap["finished_at"] = job.FinishedAt.Format(time.RFC3339)
+	}
+	if job.ArtifactsExpireAt != nil {
+		stateMap["artifacts_expire_at"] = job.ArtifactsExpireAt.Format(time.RFC3339)
+	}
+	return stateMap
+}
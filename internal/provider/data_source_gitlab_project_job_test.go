@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabProjectJob_noSuccessfulJob(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testAccCreatePipeline(t, testProject)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_job" "this" {
+						project = %d
+						ref     = "%s"
+						name    = "does-not-exist"
+					}
+				`, testProject.ID, testProject.DefaultBranch),
+				ExpectError: regexp.MustCompile(`no successful job does-not-exist found`),
+			},
+		},
+	})
+}
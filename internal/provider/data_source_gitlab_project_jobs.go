@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var validJobScopeValues = []string{
+	string(gitlab.Created), string(gitlab.Pending), string(gitlab.Running), string(gitlab.Failed),
+	string(gitlab.Success), string(gitlab.Canceled), string(gitlab.Skipped),
+	string(gitlab.WaitingForResource), string(gitlab.Manual),
+}
+
+var _ = registerDataSource("gitlab_project_jobs", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_jobs`" + ` data source allows to retrieve the jobs of a project, optionally filtered by their status.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/jobs.html#list-project-jobs)`,
+
+		ReadContext: dataSourceGitlabProjectJobsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"scope": {
+				Description: fmt.Sprintf("The statuses of the jobs to return. Valid values are: %s. All jobs are returned if not set.", renderValueListForDocs(validJobScopeValues)),
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validJobScopeValues, false)),
+				},
+			},
+			"include_retried": {
+				Description: "Include the retried jobs.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"jobs": {
+				Description: "The list of jobs, ordered from the newest to the oldest.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: datasourceSchemaFromResourceSchema(gitlabProjectJobGetSchema(), nil, nil),
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlab.ListJobsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		IncludeRetried: gitlab.Bool(d.Get("include_retried").(bool)),
+	}
+	if v, ok := d.GetOk("scope"); ok {
+		var scope []gitlab.BuildStateValue
+		for _, s := range v.(*schema.Set).List() {
+			scope = append(scope, gitlab.BuildStateValue(s.(string)))
+		}
+		options.Scope = &scope
+	}
+
+	optionsHash, err := hashstructure.Hash(options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list jobs of project %s", project)
+
+	var values []map[string]interface{}
+	for options.Page != 0 {
+		paginatedJobs, resp, err := client.Jobs.ListProjectJobs(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, job := range paginatedJobs {
+			values = append(values, gitlabProjectJobToStateMap(client, project, job))
+		}
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", project, optionsHash))
+	if err := d.Set("jobs", values); err != nil {
+		return diag.Errorf("failed to set jobs to state: %v", err)
+	}
+	return nil
+}
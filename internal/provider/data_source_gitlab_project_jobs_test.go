@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabProjectJobs_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testPipeline := testAccCreatePipeline(t, testProject)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_jobs" "all" {
+						project = %[1]d
+					}
+
+					data "gitlab_project_jobs" "skipped" {
+						project = %[1]d
+						scope   = ["skipped"]
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_project_jobs.all", "jobs.*", map[string]string{
+						"name":        "build",
+						"stage":       "build",
+						"ref":         testProject.DefaultBranch,
+						"pipeline_id": fmt.Sprintf("%d", testPipeline.ID),
+						"commit_sha":  testPipeline.SHA,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_project_jobs.all", "jobs.*", map[string]string{
+						"name":        "test",
+						"stage":       "test",
+						"pipeline_id": fmt.Sprintf("%d", testPipeline.ID),
+					}),
+					resource.TestCheckResourceAttr("data.gitlab_project_jobs.skipped", "jobs.#", "0"),
+				),
+			},
+		},
+	})
+}
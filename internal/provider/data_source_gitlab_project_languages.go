@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_languages", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_languages`" + ` data source allows to retrieve the programming languages detected in the repository of a project.
+
+-> The languages are detected asynchronously after a push, thus they may not immediately reflect the latest changes to the repository.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/projects.html#languages)`,
+
+		ReadContext: dataSourceGitlabProjectLanguagesRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"languages": {
+				Description: "A map of the detected programming languages to their share of the repository, in percent.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectLanguagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	log.Printf("[DEBUG] read languages of project %s", project)
+	languages, _, err := client.Projects.GetProjectLanguages(project, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := make(map[string]interface{}, len(*languages))
+	for language, share := range *languages {
+		values[language] = float64(share)
+	}
+
+	d.SetId(project)
+	if err := d.Set("languages", values); err != nil {
+		return diag.Errorf("failed to set languages to state: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabProjectLanguages_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	if _, _, err := testGitlabClient.RepositoryFiles.CreateFile(testProject.ID, "main.go", &gitlab.CreateFileOptions{
+		Branch:        gitlab.String(testProject.DefaultBranch),
+		Content:       gitlab.String("package main\n\nfunc main() {}\n"),
+		CommitMessage: gitlab.String("Add main.go"),
+	}); err != nil {
+		t.Fatalf("could not create test file: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_languages" "this" {
+						project = "%s"
+					}
+				`, testProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_languages.this", "id", testProject.PathWithNamespace),
+					resource.TestCheckResourceAttrSet("data.gitlab_project_languages.this", "languages.%"),
+				),
+			},
+		},
+	})
+}
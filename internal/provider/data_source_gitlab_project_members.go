@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_members", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_members`" + ` data source allows to retrieve the members of a project, either only the direct members or all members including the inherited ones.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/members.html#list-all-members-of-a-group-or-project)`,
+
+		ReadContext: dataSourceGitlabProjectMembersRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"inherited": {
+				Description: "Include the members inherited from the ancestor groups of the project and the members of groups the project is shared with.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"query": {
+				Description: "Filter the members by name, username or email.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"access_level": {
+				Description:      fmt.Sprintf("Only return the members with exactly this access level. Valid values are: %s.", renderValueListForDocs(validGroupAccessLevelNames)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validGroupAccessLevelNames, false)),
+				ConflictsWith:    []string{"min_access_level"},
+			},
+			"min_access_level": {
+				Description:      fmt.Sprintf("Only return the members with at least this access level. Valid values are: %s.", renderValueListForDocs(validGroupAccessLevelNames)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validGroupAccessLevelNames, false)),
+				ConflictsWith:    []string{"access_level"},
+			},
+			"members": {
+				Description: "The list of project members.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: gitlabMembersDataSourceMemberSchema("project"),
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	inherited := d.Get("inherited").(bool)
+
+	var query *string
+	if v, ok := d.GetOk("query"); ok {
+		query = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] list members of project %s", project)
+	directMembers, err := dataSourceGitlabProjectMembersList(ctx, project, query, client.ProjectMembers.ListProjectMembers)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	members := directMembers
+	if inherited {
+		log.Printf("[DEBUG] list inherited members of project %s", project)
+		members, err = dataSourceGitlabProjectMembersList(ctx, project, query, client.ProjectMembers.ListAllProjectMembers)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	directMemberIDs := make(map[int]bool, len(directMembers))
+	for _, m := range directMembers {
+		directMemberIDs[m.ID] = true
+	}
+
+	accessLevel, filterAccessLevel := d.GetOk("access_level")
+	minAccessLevel, filterMinAccessLevel := d.GetOk("min_access_level")
+
+	var memberValues []map[string]interface{}
+	for _, m := range members {
+		if filterAccessLevel && m.AccessLevel != accessLevelNameToValue[accessLevel.(string)] {
+			continue
+		}
+		if filterMinAccessLevel && m.AccessLevel < accessLevelNameToValue[minAccessLevel.(string)] {
+			continue
+		}
+		memberValues = append(memberValues, gitlabMembersDataSourceMemberToStateMap(
+			m.ID, m.Username, m.Name, m.State, m.WebURL, m.AccessLevel, m.CreatedAt, m.ExpiresAt, directMemberIDs[m.ID],
+		))
+	}
+
+	var optionsHash strings.Builder
+	optionsHash.WriteString(project)
+	optionsHash.WriteString(",")
+	optionsHash.WriteString(strconv.FormatBool(inherited))
+	optionsHash.WriteString(",")
+	if query != nil {
+		optionsHash.WriteString(*query)
+	}
+	optionsHash.WriteString(",")
+	if filterAccessLevel {
+		optionsHash.WriteString(accessLevel.(string))
+	}
+	optionsHash.WriteString(",")
+	if filterMinAccessLevel {
+		optionsHash.WriteString(minAccessLevel.(string))
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(optionsHash.String())))
+	if err := d.Set("members", memberValues); err != nil {
+		return diag.Errorf("failed to set members to state: %v", err)
+	}
+	return nil
+}
+
+func dataSourceGitlabProjectMembersList(ctx context.Context, project string, query *string, list func(interface{}, *gitlab.ListProjectMembersOptions, ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectMember, *gitlab.Response, error)) ([]*gitlab.ProjectMember, error) {
+	options := &gitlab.ListProjectMembersOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		Query: query,
+	}
+
+	var members []*gitlab.ProjectMember
+	for options.Page != 0 {
+		paginatedMembers, resp, err := list(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, paginatedMembers...)
+		options.Page = resp.NextPage
+	}
+	return members, nil
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabProjectMembers_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	testProject := testAccCreateProjectWithNamespace(t, testGroup.ID)
+	testUsers := testAccCreateUsers(t, 2)
+	testAccAddGroupMembers(t, testGroup.ID, testUsers[:1])
+	testAccAddProjectMembers(t, testProject.ID, testUsers[1:])
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_members" "this" {
+						project = %d
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_members.this", "members.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_members.this", "members.0.username", testUsers[1].Username),
+					resource.TestCheckResourceAttr("data.gitlab_project_members.this", "members.0.access_level", "developer"),
+					resource.TestCheckResourceAttr("data.gitlab_project_members.this", "members.0.membership_type", "direct"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_members" "this" {
+						project   = "%s"
+						inherited = true
+					}
+				`, testProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_project_members.this", "members.*", map[string]string{
+						"username":        testUsers[0].Username,
+						"membership_type": "inherited",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_project_members.this", "members.*", map[string]string{
+						"username":        testUsers[1].Username,
+						"membership_type": "direct",
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_members" "this" {
+						project          = %d
+						inherited        = true
+						min_access_level = "maintainer"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					// only the owner of the parent group, who created it, remains
+					resource.TestCheckResourceAttr("data.gitlab_project_members.this", "members.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_members.this", "members.0.access_level", "owner"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_members" "this" {
+						project      = %d
+						inherited    = true
+						access_level = "developer"
+						query        = "%s"
+					}
+				`, testProject.ID, testUsers[0].Username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_members.this", "members.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_members.this", "members.0.id", fmt.Sprintf("%d", testUsers[0].ID)),
+				),
+			},
+		},
+	})
+}
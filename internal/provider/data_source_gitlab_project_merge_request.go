@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_merge_request", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_merge_request`" + ` data source allows to retrieve details about a merge request in a project, including its merge status, approvals and head pipeline.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/merge_requests.html#get-single-mr)`,
+
+		ReadContext: dataSourceGitlabProjectMergeRequestRead,
+		Schema:      datasourceSchemaFromResourceSchema(gitlabProjectMergeRequestGetSchema(), []string{"project", "iid"}, nil),
+	}
+})
+
+func gitlabProjectMergeRequestGetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project": {
+			Description: "The ID or full path of the project.",
+			Type:        schema.TypeString,
+		},
+		"iid": {
+			Description: "The internal ID of the merge request in the project.",
+			Type:        schema.TypeInt,
+		},
+		"merge_request_id": {
+			Description: "The instance-wide ID of the merge request.",
+			Type:        schema.TypeInt,
+		},
+		"title": {
+			Description: "The title of the merge request.",
+			Type:        schema.TypeString,
+		},
+		"description": {
+			Description: "The description of the merge request.",
+			Type:        schema.TypeString,
+		},
+		"state": {
+			Description: "The state of the merge request, one of `opened`, `closed`, `locked` or `merged`.",
+			Type:        schema.TypeString,
+		},
+		"source_branch": {
+			Description: "The source branch of the merge request.",
+			Type:        schema.TypeString,
+		},
+		"target_branch": {
+			Description: "The target branch of the merge request.",
+			Type:        schema.TypeString,
+		},
+		"source_project_id": {
+			Description: "The ID of the project the source branch belongs to.",
+			Type:        schema.TypeInt,
+		},
+		"target_project_id": {
+			Description: "The ID of the project the target branch belongs to.",
+			Type:        schema.TypeInt,
+		},
+		"author_username": {
+			Description: "The username of the author of the merge request.",
+			Type:        schema.TypeString,
+		},
+		"labels": {
+			Description: "The labels of the merge request.",
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"draft": {
+			Description: "Whether the merge request is a draft.",
+			Type:        schema.TypeBool,
+		},
+		"detailed_merge_status": {
+			Description: "The detailed merge status of the merge request, e.g. `mergeable`, `not_approved` or `ci_still_running`.",
+			Type:        schema.TypeString,
+		},
+		"has_conflicts": {
+			Description: "Whether the merge request has conflicts with its target branch.",
+			Type:        schema.TypeBool,
+		},
+		"sha": {
+			Description: "The SHA of the head commit of the source branch.",
+			Type:        schema.TypeString,
+		},
+		"merge_commit_sha": {
+			Description: "The SHA of the merge commit, if the merge request has been merged.",
+			Type:        schema.TypeString,
+		},
+		"squash_commit_sha": {
+			Description: "The SHA of the squash commit, if the merge request has been merged with squashing.",
+			Type:        schema.TypeString,
+		},
+		"merged_by_username": {
+			Description: "The username of the user who merged the merge request.",
+			Type:        schema.TypeString,
+		},
+		"merged_at": {
+			Description: "The time the merge request has been merged, in RFC3339 format.",
+			Type:        schema.TypeString,
+		},
+		"closed_at": {
+			Description: "The time the merge request has been closed, in RFC3339 format.",
+			Type:        schema.TypeString,
+		},
+		"created_at": {
+			Description: "The time the merge request has been created, in RFC3339 format.",
+			Type:        schema.TypeString,
+		},
+		"updated_at": {
+			Description: "The time the merge request has last been updated, in RFC3339 format.",
+			Type:        schema.TypeString,
+		},
+		"web_url": {
+			Description: "The web URL of the merge request.",
+			Type:        schema.TypeString,
+		},
+		"approved": {
+			Description: "Whether the merge request satisfies all approval rules.",
+			Type:        schema.TypeBool,
+		},
+		"approvals_required": {
+			Description: "The number of approvals required for the merge request.",
+			Type:        schema.TypeInt,
+		},
+		"approvals_left": {
+			Description: "The number of approvals still required for the merge request.",
+			Type:        schema.TypeInt,
+		},
+		"approved_by": {
+			Description: "The usernames of the users who approved the merge request.",
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"head_pipeline": {
+			Description: "The latest pipeline that ran for the head commit of the merge request.",
+			Type:        schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The ID of the pipeline.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"sha": {
+						Description: "The SHA of the commit the pipeline ran for.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"ref": {
+						Description: "The ref the pipeline ran for.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"status": {
+						Description: "The status of the pipeline, e.g. `running`, `success` or `failed`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"web_url": {
+						Description: "The web URL of the pipeline.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGitlabProjectMergeRequestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	iid := d.Get("iid").(int)
+
+	log.Printf("[DEBUG] read merge request %d of project %s", iid, project)
+	stateMap, err := dataSourceGitlabProjectMergeRequestGetStateMap(ctx, client, project, iid)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", project, iid))
+	if err := setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// dataSourceGitlabProjectMergeRequestGetStateMap reads a merge request and its approvals.
+// The merge request is read individually, because only the single merge request
+// endpoint returns the detailed merge status and the head pipeline.
+func dataSourceGitlabProjectMergeRequestGetStateMap(ctx context.Context, client *gitlab.Client, project string, iid int) (map[string]interface{}, error) {
+	mergeRequest, _, err := client.MergeRequests.GetMergeRequest(project, iid, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	approvals, _, err := client.MergeRequests.GetMergeRequestApprovals(project, iid, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return gitlabProjectMergeRequestToStateMap(project, mergeRequest, approvals), nil
+}
+
+func gitlabProjectMergeRequestToStateMap(project string, mergeRequest *gitlab.MergeRequest, approvals *gitlab.MergeRequestApprovals) map[string]interface{} {
+	stateMap := map[string]interface{}{
+		"project":               project,
+		"iid":                   mergeRequest.IID,
+		"merge_request_id":      mergeRequest.ID,
+		"title":                 mergeRequest.Title,
+		"description":           mergeRequest.Description,
+		"state":                 mergeRequest.State,
+		"source_branch":         mergeRequest.SourceBranch,
+		"target_branch":         mergeRequest.TargetBranch,
+		"source_project_id":     mergeRequest.SourceProjectID,
+		"target_project_id":     mergeRequest.TargetProjectID,
+		"labels":                mergeRequest.Labels,
+		"draft":                 mergeRequest.Draft,
+		"detailed_merge_status": mergeRequest.DetailedMergeStatus,
+		"has_conflicts":         mergeRequest.HasConflicts,
+		"sha":                   mergeRequest.SHA,
+		"merge_commit_sha":      mergeRequest.MergeCommitSHA,
+		"squash_commit_sha":     mergeRequest.SquashCommitSHA,
+		"web_url":               mergeRequest.WebURL,
+		"approved":              approvals.Approved,
+		"approvals_required":    approvals.ApprovalsRequired,
+		"approvals_left":        approvals.ApprovalsLeft,
+	}
+	if mergeRequest.Author != nil {
+		stateMap["author_username"] = mergeRequest.Author.Username
+	}
+	if mergeRequest.MergedBy != nil {
+		stateMap["merged_by_username"] = mergeRequest.MergedBy.Username
+	}
+	if mergeRequest.MergedAt != nil {
+		stateMap["merged_at"] = mergeRequest.MergedAt.Format(time.RFC3339)
+	}
+	if mergeRequest.ClosedAt != nil {
+		stateMap["closed_at"] = mergeRequest.ClosedAt.Format(time.RFC3339)
+	}
+	if mergeRequest.CreatedAt != nil {
+		stateMap["created_at"] = mergeRequest.CreatedAt.Format(time.RFC3339)
+	}
+	if mergeRequest.UpdatedAt != nil {
+		stateMap["updated_at"] = mergeRequest.UpdatedAt.Format(time.RFC3339)
+	}
+
+	var approvedBy []string
+	for _, approver := range approvals.ApprovedBy {
+		if approver.User != nil {
+			approvedBy = append(approvedBy, approver.User.Username)
+		}
+	}
+	stateMap["approved_by"] = approvedBy
+
+	var headPipeline []map[string]interface{}
+	if p := mergeRequest.HeadPipeline; p != nil {
+		headPipeline = append(headPipeline, map[string]interface{}{
+			"id":      p.ID,
+			"sha":     p.SHA,
+			"ref":     p.Ref,
+			"status":  p.Status,
+			"web_url": p.WebURL,
+		})
+	}
+	stateMap["head_pipeline"] = headPipeline
+
+	return stateMap
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabProjectMergeRequest_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testMergeRequest := testAccCreateMergeRequest(t, testProject, &gitlab.CreateMergeRequestOptions{
+		Title:  gitlab.String("Add feature"),
+		Labels: &gitlab.LabelOptions{"feature"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_merge_request" "this" {
+						project = "%s"
+						iid     = %d
+					}
+				`, testProject.PathWithNamespace, testMergeRequest.IID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_request.this", "merge_request_id", fmt.Sprintf("%d", testMergeRequest.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_request.this", "title", "Add feature"),
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_request.this", "state", "opened"),
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_request.this", "source_branch", testMergeRequest.SourceBranch),
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_request.this", "target_branch", testProject.DefaultBranch),
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_request.this", "labels.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_request.this", "draft", "false"),
+					resource.TestCheckResourceAttrSet("data.gitlab_project_merge_request.this", "detailed_merge_status"),
+					resource.TestCheckResourceAttrSet("data.gitlab_project_merge_request.this", "sha"),
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_request.this", "approved_by.#", "0"),
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_request.this", "head_pipeline.#", "0"),
+				),
+			},
+		},
+	})
+}
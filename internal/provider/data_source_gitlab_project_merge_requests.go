@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_merge_requests", func() *schema.Resource {
+	validMergeRequestStateValues := []string{"opened", "closed", "locked", "merged", "all"}
+	validMergeRequestOrderByValues := []string{"created_at", "updated_at", "title"}
+	validMergeRequestSortValues := []string{"asc", "desc"}
+
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_merge_requests`" + ` data source allows to retrieve details about merge requests in a project, including their merge status, approvals and head pipeline.
+
+-> The merge status, approvals and head pipeline are only returned by the single merge request endpoints, thus two additional requests are made for each merge request matching the filters.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/merge_requests.html#list-project-merge-requests)`,
+
+		ReadContext: dataSourceGitlabProjectMergeRequestsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"iids": {
+				Description: "Return only the merge requests having the given internal IDs.",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Optional:    true,
+			},
+			"state": {
+				Description:      fmt.Sprintf("Return merge requests with the given state. Valid values are %s. Defaults to all.", renderValueListForDocs(validMergeRequestStateValues)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validMergeRequestStateValues, false)),
+			},
+			"source_branch": {
+				Description: "Return merge requests with the given source branch.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"target_branch": {
+				Description: "Return merge requests with the given target branch.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"labels": {
+				Description: "Return merge requests with labels. Merge requests must have all labels to be returned. None lists all merge requests with no labels. Any lists all merge requests with at least one label.",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			"milestone": {
+				Description: "Return merge requests for the given milestone title. None lists all merge requests with no milestone. Any lists all merge requests that have an assigned milestone.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"author_username": {
+				Description: "Return merge requests created by the given username.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"search": {
+				Description: "Search merge requests against their title and description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"order_by": {
+				Description:      fmt.Sprintf("Return merge requests ordered by. Valid values are %s. Default is created_at", renderValueListForDocs(validMergeRequestOrderByValues)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validMergeRequestOrderByValues, false)),
+			},
+			"sort": {
+				Description:      "Return merge requests sorted in asc or desc order. Default is desc",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validMergeRequestSortValues, false)),
+			},
+			"merge_requests": {
+				Description: "The list of merge requests returned by the search.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: datasourceSchemaFromResourceSchema(gitlabProjectMergeRequestGetSchema(), nil, nil),
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectMergeRequestsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	project := d.Get("project").(string)
+	options := gitlab.ListProjectMergeRequestsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 20,
+			Page:    1,
+		},
+	}
+
+	if v, ok := d.GetOk("iids"); ok {
+		options.IIDs = intSetToIntSlice(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("state"); ok {
+		options.State = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_branch"); ok {
+		options.SourceBranch = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_branch"); ok {
+		options.TargetBranch = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("labels"); ok {
+		gitlabLabels := gitlab.LabelOptions(*stringSetToStringSlice(v.(*schema.Set)))
+		options.Labels = &gitlabLabels
+	}
+
+	if v, ok := d.GetOk("milestone"); ok {
+		options.Milestone = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("author_username"); ok {
+		options.AuthorUsername = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("search"); ok {
+		options.Search = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("order_by"); ok {
+		options.OrderBy = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sort"); ok {
+		options.Sort = gitlab.String(v.(string))
+	}
+
+	optionsHash, err := hashstructure.Hash(&options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list merge requests of project %s", project)
+
+	var mergeRequests []*gitlab.MergeRequest
+	for options.Page != 0 {
+		paginatedMergeRequests, resp, err := client.MergeRequests.ListProjectMergeRequests(project, &options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		mergeRequests = append(mergeRequests, paginatedMergeRequests...)
+		options.Page = resp.NextPage
+	}
+
+	var values []map[string]interface{}
+	for _, mergeRequest := range mergeRequests {
+		stateMap, err := dataSourceGitlabProjectMergeRequestGetStateMap(ctx, client, project, mergeRequest.IID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		values = append(values, stateMap)
+	}
+
+	d.SetId(fmt.Sprintf("%s-%d", project, optionsHash))
+	if err = d.Set("merge_requests", values); err != nil {
+		return diag.Errorf("failed to set merge requests to state: %v", err)
+	}
+
+	return nil
+}
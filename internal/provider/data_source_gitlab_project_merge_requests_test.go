@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabProjectMergeRequests_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testFeature := testAccCreateMergeRequest(t, testProject, &gitlab.CreateMergeRequestOptions{
+		Title:  gitlab.String("Add feature"),
+		Labels: &gitlab.LabelOptions{"feature"},
+	})
+	testBugfix := testAccCreateMergeRequest(t, testProject, &gitlab.CreateMergeRequestOptions{
+		Title:  gitlab.String("Fix bug"),
+		Labels: &gitlab.LabelOptions{"bug"},
+	})
+	if _, _, err := testGitlabClient.MergeRequests.UpdateMergeRequest(testProject.ID, testBugfix.IID, &gitlab.UpdateMergeRequestOptions{
+		StateEvent: gitlab.String("close"),
+	}); err != nil {
+		t.Fatalf("could not close test merge request: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_merge_requests" "all" {
+						project = %[1]d
+					}
+
+					data "gitlab_project_merge_requests" "opened" {
+						project       = %[1]d
+						state         = "opened"
+						target_branch = "%[2]s"
+					}
+
+					data "gitlab_project_merge_requests" "bug" {
+						project = %[1]d
+						labels  = ["bug"]
+					}
+				`, testProject.ID, testProject.DefaultBranch),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_requests.all", "merge_requests.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_requests.opened", "merge_requests.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_requests.opened", "merge_requests.0.iid", fmt.Sprintf("%d", testFeature.IID)),
+					resource.TestCheckResourceAttrSet("data.gitlab_project_merge_requests.opened", "merge_requests.0.detailed_merge_status"),
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_requests.opened", "merge_requests.0.approved", "true"),
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_requests.bug", "merge_requests.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_requests.bug", "merge_requests.0.iid", fmt.Sprintf("%d", testBugfix.IID)),
+					resource.TestCheckResourceAttr("data.gitlab_project_merge_requests.bug", "merge_requests.0.state", "closed"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_milestones", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_milestones`" + ` data source allows to retrieve the milestones of a project.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/milestones.html#list-project-milestones)`,
+
+		ReadContext: dataSourceGitlabProjectMilestonesRead,
+		Schema: constructSchema(
+			gitlabMilestonesDataSourceFilterSchema(),
+			map[string]*schema.Schema{
+				"project": {
+					Description: "The ID or full path of the project.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"include_parent_milestones": {
+					Description: "Include the milestones of the parent group and its ancestors.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
+				"milestones": {
+					Description: "The list of milestones of the project.",
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: gitlabMilestonesDataSourceMilestoneSchema(),
+					},
+				},
+			},
+		),
+	}
+})
+
+func dataSourceGitlabProjectMilestonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := gitlab.ListMilestonesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		IncludeParentMilestones: gitlab.Bool(d.Get("include_parent_milestones").(bool)),
+	}
+	if v, ok := d.GetOk("iids"); ok {
+		options.IIDs = intSetToIntSlice(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("title"); ok {
+		options.Title = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("state"); ok {
+		options.State = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("search"); ok {
+		options.Search = gitlab.String(v.(string))
+	}
+
+	optionsHash, err := hashstructure.Hash(&options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list milestones of project %s", project)
+
+	var values []map[string]interface{}
+	for options.Page != 0 {
+		paginatedMilestones, resp, err := client.Milestones.ListMilestones(project, &options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, m := range paginatedMilestones {
+			values = append(values, gitlabMilestonesDataSourceMilestoneToStateMap(
+				m.ID, m.IID, m.ProjectID, m.GroupID, m.Title, m.Description, m.State, m.WebURL, m.StartDate, m.DueDate, m.CreatedAt, m.UpdatedAt, m.Expired,
+			))
+		}
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", project, optionsHash))
+	if err := d.Set("milestones", values); err != nil {
+		return diag.Errorf("failed to set milestones to state: %v", err)
+	}
+	return nil
+}
+
+// gitlabMilestonesDataSourceFilterSchema returns the filter arguments
+// shared by the project and group milestones data sources.
+func gitlabMilestonesDataSourceFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"iids": {
+			Description: "Return only the milestones having the given internal IDs.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeInt},
+		},
+		"title": {
+			Description: "Return only the milestone having the given title.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"state": {
+			Description:      "Return only the `active` or `closed` milestones.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"active", "closed"}, false)),
+		},
+		"search": {
+			Description: "Return only the milestones with a title or description matching the provided string.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+	}
+}
+
+// gitlabMilestonesDataSourceMilestoneSchema returns the schema of a milestone
+// shared by the project and group milestones data sources.
+func gitlabMilestonesDataSourceMilestoneSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"milestone_id": {
+			Description: "The instance-wide ID of the milestone.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"iid": {
+			Description: "The ID of the milestone in its project or group.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"project_id": {
+			Description: "The ID of the project the milestone belongs to, if it is a project milestone.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"group_id": {
+			Description: "The ID of the group the milestone belongs to, if it is a group milestone.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"title": {
+			Description: "The title of the milestone.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: "The description of the milestone.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"state": {
+			Description: "The state of the milestone, either `active` or `closed`.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"web_url": {
+			Description: "The web URL of the milestone. Not available for the milestones of the `gitlab_group_milestones` data source.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"start_date": {
+			Description: "The start date of the milestone, in `YYYY-MM-DD` format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"due_date": {
+			Description: "The due date of the milestone, in `YYYY-MM-DD` format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created_at": {
+			Description: "The time the milestone has been created, in RFC3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"updated_at": {
+			Description: "The time the milestone has last been updated, in RFC3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"expired": {
+			Description: "Whether the due date of the milestone is in the past.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	}
+}
+
+func gitlabMilestonesDataSourceMilestoneToStateMap(id, iid, projectID, groupID int, title, description, state, webURL string, startDate, dueDate *gitlab.ISOTime, createdAt, updatedAt *time.Time, expired *bool) map[string]interface{} {
+	stateMap := map[string]interface{}{
+		"milestone_id": id,
+		"iid":          iid,
+		"project_id":   projectID,
+		"group_id":     groupID,
+		"title":        title,
+		"description":  description,
+		"state":        state,
+		"web_url":      webURL,
+	}
+	if startDate != nil {
+		stateMap["start_date"] = startDate.String()
+	}
+	if dueDate != nil {
+		stateMap["due_date"] = dueDate.String()
+	}
+	if createdAt != nil {
+		stateMap["created_at"] = createdAt.Format(time.RFC3339)
+	}
+	if updatedAt != nil {
+		stateMap["updated_at"] = updatedAt.Format(time.RFC3339)
+	}
+	if expired != nil {
+		stateMap["expired"] = *expired
+	}
+	return stateMap
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabProjectMilestones_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testMilestones := testAccCreateProjectMilestones(t, testProject.ID, 3)
+
+	if _, _, err := testGitlabClient.Milestones.UpdateMilestone(testProject.ID, testMilestones[2].ID, &gitlab.UpdateMilestoneOptions{
+		StateEvent: gitlab.String("close"),
+	}); err != nil {
+		t.Fatalf("could not close test project milestone: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_milestones" "all" {
+						project = %[1]d
+					}
+
+					data "gitlab_project_milestones" "active" {
+						project = %[1]d
+						state   = "active"
+					}
+
+					data "gitlab_project_milestones" "by_title" {
+						project = %[1]d
+						title   = "%[2]s"
+					}
+
+					data "gitlab_project_milestones" "by_iid" {
+						project = %[1]d
+						iids    = [%[3]d]
+					}
+				`, testProject.ID, testMilestones[0].Title, testMilestones[1].IID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_milestones.all", "milestones.#", "3"),
+					resource.TestCheckResourceAttr("data.gitlab_project_milestones.active", "milestones.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlab_project_milestones.by_title", "milestones.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_milestones.by_title", "milestones.0.milestone_id", fmt.Sprintf("%d", testMilestones[0].ID)),
+					resource.TestCheckResourceAttr("data.gitlab_project_milestones.by_title", "milestones.0.project_id", fmt.Sprintf("%d", testProject.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_project_milestones.by_title", "milestones.0.description", "Milestone 0"),
+					resource.TestCheckResourceAttr("data.gitlab_project_milestones.by_title", "milestones.0.state", "active"),
+					resource.TestCheckResourceAttrSet("data.gitlab_project_milestones.by_title", "milestones.0.web_url"),
+					resource.TestCheckResourceAttr("data.gitlab_project_milestones.by_iid", "milestones.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_milestones.by_iid", "milestones.0.title", testMilestones[1].Title),
+				),
+			},
+		},
+	})
+}
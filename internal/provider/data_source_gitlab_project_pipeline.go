@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var validPipelineStatusValues = []string{
+	string(gitlab.Created),
+	string(gitlab.WaitingForResource),
+	string(gitlab.Preparing),
+	string(gitlab.Pending),
+	string(gitlab.Running),
+	string(gitlab.Success),
+	string(gitlab.Failed),
+	string(gitlab.Canceled),
+	string(gitlab.Skipped),
+	string(gitlab.Manual),
+	string(gitlab.Scheduled),
+}
+
+var _ = registerDataSource("gitlab_project_pipeline", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_pipeline`" + ` data source allows to retrieve details about a pipeline of a project and its jobs, either by its ID or the latest pipeline for a ref.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/pipelines.html#get-a-single-pipeline)`,
+
+		ReadContext: dataSourceGitlabProjectPipelineRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"pipeline_id": {
+				Description:   "The ID of the pipeline. Conflicts with `ref`.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"ref"},
+			},
+			"ref": {
+				Description:   "Retrieve the latest pipeline for the given branch or tag. Defaults to the default branch of the project if `pipeline_id` is not set. Conflicts with `pipeline_id`.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"pipeline_id"},
+			},
+			"iid": {
+				Description: "The ID of the pipeline in the project.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"status": {
+				Description: fmt.Sprintf("The status of the pipeline. One of %s.", renderValueListForDocs(validPipelineStatusValues)),
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"source": {
+				Description: "The event which triggered the pipeline, e.g. `push`, `schedule` or `merge_request_event`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"sha": {
+				Description: "The SHA of the commit the pipeline ran for.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"tag": {
+				Description: "Whether the pipeline ran for a tag.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"web_url": {
+				Description: "The web URL of the pipeline.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_username": {
+				Description: "The username of the user who triggered the pipeline.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"coverage": {
+				Description: "The code coverage of the pipeline.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"duration": {
+				Description: "The duration of the pipeline in seconds.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "The time the pipeline has been created, in RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "The time the pipeline has last been updated, in RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"started_at": {
+				Description: "The time the pipeline has been started, in RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"finished_at": {
+				Description: "The time the pipeline has finished, in RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"jobs": {
+				Description: "The jobs of the pipeline. Retried jobs are not included.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the job.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the job.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"stage": {
+							Description: "The stage of the job.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The status of the job.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"allow_failure": {
+							Description: "Whether the job is allowed to fail.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"failure_reason": {
+							Description: "The reason the job failed, if it failed.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"web_url": {
+							Description: "The web URL of the job.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The time the job has been created, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"started_at": {
+							Description: "The time the job has been started, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"finished_at": {
+							Description: "The time the job has finished, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"artifacts_expire_at": {
+							Description: "The time the artifacts of the job expire, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"artifacts": {
+							Description: "The artifacts of the job.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        gitlabJobArtifactsSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectPipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	var pipeline *gitlab.Pipeline
+	var err error
+	if v, ok := d.GetOk("pipeline_id"); ok {
+		log.Printf("[DEBUG] read pipeline %d of project %s", v.(int), project)
+		pipeline, _, err = client.Pipelines.GetPipeline(project, v.(int), gitlab.WithContext(ctx))
+	} else {
+		options := &gitlab.GetLatestPipelineOptions{}
+		if v, ok := d.GetOk("ref"); ok {
+			options.Ref = gitlab.String(v.(string))
+		}
+		log.Printf("[DEBUG] read latest pipeline of project %s", project)
+		pipeline, _, err = client.Pipelines.GetLatestPipeline(project, options, gitlab.WithContext(ctx))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &gitlab.ListJobsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+	}
+
+	var jobs []*gitlab.Job
+	for options.Page != 0 {
+		paginatedJobs, resp, err := client.Jobs.ListPipelineJobs(project, pipeline.ID, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		jobs = append(jobs, paginatedJobs...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", project, pipeline.ID))
+	if err := setStateMapInResourceData(gitlabProjectPipelineToStateMap(project, pipeline, jobs), d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func gitlabProjectPipelineToStateMap(project string, pipeline *gitlab.Pipeline, jobs []*gitlab.Job) map[string]interface{} {
+	stateMap := map[string]interface{}{
+		"project":     project,
+		"pipeline_id": pipeline.ID,
+		"iid":         pipeline.IID,
+		"ref":         pipeline.Ref,
+		"status":      pipeline.Status,
+		"source":      pipeline.Source,
+		"sha":         pipeline.SHA,
+		"tag":         pipeline.Tag,
+		"web_url":     pipeline.WebURL,
+		"coverage":    pipeline.Coverage,
+		"duration":    pipeline.Duration,
+		"jobs":        flattenGitlabPipelineJobs(jobs),
+	}
+	if pipeline.User != nil {
+		stateMap["user_username"] = pipeline.User.Username
+	}
+	if pipeline.CreatedAt != nil {
+		stateMap["created_at"] = pipeline.CreatedAt.Format(time.RFC3339)
+	}
+	if pipeline.UpdatedAt != nil {
+		stateMap["updated_at"] = pipeline.UpdatedAt.Format(time.RFC3339)
+	}
+	if pipeline.StartedAt != nil {
+		stateMap["started_at"] = pipeline.StartedAt.Format(time.RFC3339)
+	}
+	if pipeline.FinishedAt != nil {
+		stateMap["finished_at"] = pipeline.FinishedAt.Format(time.RFC3339)
+	}
+	return stateMap
+}
+
+func flattenGitlabPipelineJobs(jobs []*gitlab.Job) (values []map[string]interface{}) {
+	for _, job := range jobs {
+		v := map[string]interface{}{
+			"id":             job.ID,
+			"name":           job.Name,
+			"stage":          job.Stage,
+			"status":         job.Status,
+			"allow_failure":  job.AllowFailure,
+			"failure_reason": job.FailureReason,
+			"web_url":        job.WebURL,
+			"artifacts":      flattenGitlabJobArtifacts(job),
+		}
+		if job.CreatedAt != nil {
+			v["created_at"] = job.CreatedAt.Format(time.RFC3339)
+		}
+		if job.StartedAt != nil {
+			v["started_at"] = job.StartedAt.Format(time.RFC3339)
+		}
+		if job.FinishedAt != nil {
+			v["finished_at"] = job.FinishedAt.Format(time.RFC3339)
+		}
+		if job.ArtifactsExpireAt != nil {
+			v["artifacts_expire_at"] = job.ArtifactsExpireAt.Format(time.RFC3339)
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+// gitlabJobArtifactsSchema returns the schema of the artifacts of a job.
+func gitlabJobArtifactsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"file_type": {
+				Description: "The type of the artifact, e.g. `archive`, `metadata` or `junit`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filename": {
+				Description: "The file name of the artifact.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"file_format": {
+				Description: "The format of the artifact file, e.g. `zip` or `gzip`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"size": {
+				Description: "The size of the artifact in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func flattenGitlabJobArtifacts(job *gitlab.Job) (values []map[string]interface{}) {
+	for _, artifact := range job.Artifacts {
+		values = append(values, map[string]interface{}{
+			"file_type":   artifact.FileType,
+			"filename":    artifact.Filename,
+			"file_format": artifact.FileFormat,
+			"size":        artifact.Size,
+		})
+	}
+	return values
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_pipeline_schedules", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_pipeline_schedules`" + ` data source allows to retrieve the pipeline schedules of a project, including their owners and variables.
+
+-> The values of the pipeline schedule variables are never exposed by this data source, only their keys and types.
+   Each pipeline schedule is retrieved with an additional API request to get its variables and last pipeline.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/pipeline_schedules.html#get-a-single-pipeline-schedule)`,
+
+		ReadContext: dataSourceGitlabProjectPipelineSchedulesRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"pipeline_schedules": {
+				Description: "The list of pipeline schedules of the project.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the pipeline schedule.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the pipeline schedule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ref": {
+							Description: "The branch or tag the pipelines are run on.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"cron": {
+							Description: "The cron schedule, e.g. `0 1 * * *`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"cron_timezone": {
+							Description: "The timezone of the cron schedule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"next_run_at": {
+							Description: "The time of the next scheduled pipeline, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"active": {
+							Description: "Whether the pipeline schedule is active.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The time the pipeline schedule has been created, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"updated_at": {
+							Description: "The time the pipeline schedule has last been updated, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"owner_id": {
+							Description: "The ID of the owner of the pipeline schedule.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"owner_username": {
+							Description: "The username of the owner of the pipeline schedule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"owner_state": {
+							Description: "The state of the owner of the pipeline schedule, e.g. `active` or `blocked`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_pipeline": {
+							Description: "The last pipeline run by the pipeline schedule.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Description: "The ID of the pipeline.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"sha": {
+										Description: "The SHA of the commit the pipeline has run for.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"ref": {
+										Description: "The ref the pipeline has run for.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"status": {
+										Description: "The status of the pipeline.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"web_url": {
+										Description: "The URL of the pipeline.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"variables": {
+							Description: "The variables of the pipeline schedule, without their values.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Description: "The key of the variable.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"variable_type": {
+										Description: "The type of the variable, either `env_var` or `file`.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectPipelineSchedulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlab.ListPipelineSchedulesOptions{
+		PerPage: 20,
+		Page:    1,
+	}
+
+	log.Printf("[DEBUG] list pipeline schedules of project %s", project)
+
+	var schedules []*gitlab.PipelineSchedule
+	for options.Page != 0 {
+		paginatedSchedules, resp, err := client.PipelineSchedules.ListPipelineSchedules(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		schedules = append(schedules, paginatedSchedules...)
+		options.Page = resp.NextPage
+	}
+
+	var values []map[string]interface{}
+	for _, s := range schedules {
+		// NOTE: the variables and the last pipeline are only returned for a single pipeline schedule.
+		log.Printf("[DEBUG] read pipeline schedule %d of project %s", s.ID, project)
+		schedule, _, err := client.PipelineSchedules.GetPipelineSchedule(project, s.ID, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		values = append(values, gitlabPipelineScheduleToStateMap(schedule))
+	}
+
+	d.SetId(project)
+	if err := d.Set("pipeline_schedules", values); err != nil {
+		return diag.Errorf("failed to set pipeline schedules to state: %v", err)
+	}
+	return nil
+}
+
+func gitlabPipelineScheduleToStateMap(schedule *gitlab.PipelineSchedule) map[string]interface{} {
+	stateMap := map[string]interface{}{
+		"id":            schedule.ID,
+		"description":   schedule.Description,
+		"ref":           schedule.Ref,
+		"cron":          schedule.Cron,
+		"cron_timezone": schedule.CronTimezone,
+		"active":        schedule.Active,
+	}
+	if schedule.NextRunAt != nil {
+		stateMap["next_run_at"] = schedule.NextRunAt.Format(time.RFC3339)
+	}
+	if schedule.CreatedAt != nil {
+		stateMap["created_at"] = schedule.CreatedAt.Format(time.RFC3339)
+	}
+	if schedule.UpdatedAt != nil {
+		stateMap["updated_at"] = schedule.UpdatedAt.Format(time.RFC3339)
+	}
+	if schedule.Owner != nil {
+		stateMap["owner_id"] = schedule.Owner.ID
+		stateMap["owner_username"] = schedule.Owner.Username
+		stateMap["owner_state"] = schedule.Owner.State
+	}
+	if schedule.LastPipeline != nil {
+		stateMap["last_pipeline"] = []map[string]interface{}{
+			{
+				"id":      schedule.LastPipeline.ID,
+				"sha":     schedule.LastPipeline.SHA,
+				"ref":     schedule.LastPipeline.Ref,
+				"status":  schedule.LastPipeline.Status,
+				"web_url": schedule.LastPipeline.WebURL,
+			},
+		}
+	}
+
+	var variables []map[string]interface{}
+	for _, variable := range schedule.Variables {
+		variables = append(variables, map[string]interface{}{
+			"key":           variable.Key,
+			"variable_type": string(variable.VariableType),
+		})
+	}
+	stateMap["variables"] = variables
+
+	return stateMap
+}
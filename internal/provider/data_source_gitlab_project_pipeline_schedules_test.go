@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabProjectPipelineSchedules_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	currentUser := testAccCurrentUser(t)
+
+	schedule, _, err := testGitlabClient.PipelineSchedules.CreatePipelineSchedule(testProject.ID, &gitlab.CreatePipelineScheduleOptions{
+		Description:  gitlab.String("Nightly"),
+		Ref:          gitlab.String(testProject.DefaultBranch),
+		Cron:         gitlab.String("0 1 * * *"),
+		CronTimezone: gitlab.String("UTC"),
+	})
+	if err != nil {
+		t.Fatalf("could not create test pipeline schedule: %v", err)
+	}
+	if _, _, err := testGitlabClient.PipelineSchedules.CreatePipelineScheduleVariable(testProject.ID, schedule.ID, &gitlab.CreatePipelineScheduleVariableOptions{
+		Key:   gitlab.String("SECRET"),
+		Value: gitlab.String("sensitive-value"),
+	}); err != nil {
+		t.Fatalf("could not create test pipeline schedule variable: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_pipeline_schedules" "this" {
+						project = "%s"
+					}
+				`, testProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.0.id", fmt.Sprintf("%d", schedule.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.0.description", "Nightly"),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.0.ref", testProject.DefaultBranch),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.0.cron", "0 1 * * *"),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.0.cron_timezone", "UTC"),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.0.active", "true"),
+					resource.TestCheckResourceAttrSet("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.0.next_run_at"),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.0.owner_id", fmt.Sprintf("%d", currentUser.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.0.owner_username", currentUser.Username),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.0.owner_state", "active"),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.0.variables.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.0.variables.0.key", "SECRET"),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.0.variables.0.variable_type", "env_var"),
+					resource.TestCheckNoResourceAttr("data.gitlab_project_pipeline_schedules.this", "pipeline_schedules.0.variables.0.value"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabProjectPipeline_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testPipeline := testAccCreatePipeline(t, testProject)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_pipeline" "by_id" {
+						project     = %[1]d
+						pipeline_id = %[2]d
+					}
+
+					data "gitlab_project_pipeline" "latest" {
+						project = %[1]d
+						ref     = "%[3]s"
+					}
+				`, testProject.ID, testPipeline.ID, testProject.DefaultBranch),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline.by_id", "ref", testProject.DefaultBranch),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline.by_id", "sha", testPipeline.SHA),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline.by_id", "iid", fmt.Sprintf("%d", testPipeline.IID)),
+					resource.TestCheckResourceAttrSet("data.gitlab_project_pipeline.by_id", "status"),
+					resource.TestCheckResourceAttrSet("data.gitlab_project_pipeline.by_id", "web_url"),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline.by_id", "jobs.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_project_pipeline.by_id", "jobs.*", map[string]string{
+						"name":  "build",
+						"stage": "build",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_project_pipeline.by_id", "jobs.*", map[string]string{
+						"name":  "test",
+						"stage": "test",
+					}),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipeline.latest", "pipeline_id", fmt.Sprintf("%d", testPipeline.ID)),
+				),
+			},
+		},
+	})
+}
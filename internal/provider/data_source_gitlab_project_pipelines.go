@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_pipelines", func() *schema.Resource {
+	validPipelineScopeValues := []string{"running", "pending", "finished", "branches", "tags"}
+	validPipelineOrderByValues := []string{"id", "status", "ref", "updated_at", "user_id"}
+	validPipelineSortValues := []string{"asc", "desc"}
+
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_pipelines`" + ` data source allows to retrieve details about the pipelines of a project.
+
+-> Use the ` + "`gitlab_project_pipeline`" + ` data source to retrieve the jobs and artifacts of a pipeline returned by this data source.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/pipelines.html#list-project-pipelines)`,
+
+		ReadContext: dataSourceGitlabProjectPipelinesRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"scope": {
+				Description:      fmt.Sprintf("The scope of the pipelines. Valid values are %s.", renderValueListForDocs(validPipelineScopeValues)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validPipelineScopeValues, false)),
+			},
+			"status": {
+				Description:      fmt.Sprintf("The status of the pipelines. Valid values are %s.", renderValueListForDocs(validPipelineStatusValues)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validPipelineStatusValues, false)),
+			},
+			"source": {
+				Description: "Return only the pipelines triggered by the given event, e.g. `push` or `schedule`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ref": {
+				Description: "The ref of the pipelines.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"sha": {
+				Description: "The SHA of the pipelines.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"yaml_errors": {
+				Description: "Return only the pipelines with invalid configurations.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"username": {
+				Description: "The username of the user who triggered the pipelines.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"updated_after": {
+				Description: "Return pipelines updated after the specified date. Expected in ISO 8601 format (2019-03-15T08:00:00Z).",
+				Type:        schema.TypeString,
+				Optional:    true,
+				// NOTE: since RFC3339 is pretty much a subset of ISO8601 and actually expected by GitLab,
+				//       we use it here to avoid having to parse the string ourselves.
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"updated_before": {
+				Description: "Return pipelines updated before the specified date. Expected in ISO 8601 format (2019-03-15T08:00:00Z).",
+				Type:        schema.TypeString,
+				Optional:    true,
+				// NOTE: since RFC3339 is pretty much a subset of ISO8601 and actually expected by GitLab,
+				//       we use it here to avoid having to parse the string ourselves.
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"order_by": {
+				Description:      fmt.Sprintf("Order pipelines by %s. Default is id.", renderValueListForDocs(validPipelineOrderByValues)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validPipelineOrderByValues, false)),
+			},
+			"sort": {
+				Description:      "Sort pipelines in asc or desc order. Default is desc.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validPipelineSortValues, false)),
+			},
+			"pipelines": {
+				Description: "The list of pipelines.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the pipeline.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"iid": {
+							Description: "The ID of the pipeline in the project.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The status of the pipeline.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"source": {
+							Description: "The event which triggered the pipeline.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ref": {
+							Description: "The ref the pipeline ran for.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"sha": {
+							Description: "The SHA of the commit the pipeline ran for.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"web_url": {
+							Description: "The web URL of the pipeline.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The time the pipeline has been created, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"updated_at": {
+							Description: "The time the pipeline has last been updated, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectPipelinesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	project := d.Get("project").(string)
+	options := gitlab.ListProjectPipelinesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 20,
+			Page:    1,
+		},
+	}
+
+	if v, ok := d.GetOk("scope"); ok {
+		options.Scope = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		options.Status = gitlab.BuildState(gitlab.BuildStateValue(v.(string)))
+	}
+
+	if v, ok := d.GetOk("source"); ok {
+		options.Source = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ref"); ok {
+		options.Ref = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sha"); ok {
+		options.SHA = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("yaml_errors"); ok {
+		options.YamlErrors = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("username"); ok {
+		options.Username = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("updated_after"); ok {
+		parsedUpdatedAfter, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.Errorf("failed to parse updated_after: %s. It must be in valid RFC3339 format.", err)
+		}
+		options.UpdatedAfter = gitlab.Time(parsedUpdatedAfter)
+	}
+
+	if v, ok := d.GetOk("updated_before"); ok {
+		parsedUpdatedBefore, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.Errorf("failed to parse updated_before: %s. It must be in valid RFC3339 format.", err)
+		}
+		options.UpdatedBefore = gitlab.Time(parsedUpdatedBefore)
+	}
+
+	if v, ok := d.GetOk("order_by"); ok {
+		options.OrderBy = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sort"); ok {
+		options.Sort = gitlab.String(v.(string))
+	}
+
+	optionsHash, err := hashstructure.Hash(&options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list pipelines of project %s", project)
+
+	var pipelines []*gitlab.PipelineInfo
+	for options.Page != 0 {
+		paginatedPipelines, resp, err := client.Pipelines.ListProjectPipelines(project, &options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		pipelines = append(pipelines, paginatedPipelines...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%s-%d", project, optionsHash))
+	if err = d.Set("pipelines", flattenGitlabProjectPipelines(pipelines)); err != nil {
+		return diag.Errorf("failed to set pipelines to state: %v", err)
+	}
+
+	return nil
+}
+
+func flattenGitlabProjectPipelines(pipelines []*gitlab.PipelineInfo) (values []map[string]interface{}) {
+	for _, pipeline := range pipelines {
+		v := map[string]interface{}{
+			"id":      pipeline.ID,
+			"iid":     pipeline.IID,
+			"status":  pipeline.Status,
+			"source":  pipeline.Source,
+			"ref":     pipeline.Ref,
+			"sha":     pipeline.SHA,
+			"web_url": pipeline.WebURL,
+		}
+		if pipeline.CreatedAt != nil {
+			v["created_at"] = pipeline.CreatedAt.Format(time.RFC3339)
+		}
+		if pipeline.UpdatedAt != nil {
+			v["updated_at"] = pipeline.UpdatedAt.Format(time.RFC3339)
+		}
+		values = append(values, v)
+	}
+	return values
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabProjectPipelines_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testPipeline := testAccCreatePipeline(t, testProject)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_pipelines" "ref" {
+						project       = %[1]d
+						ref           = "%[2]s"
+						updated_after = "2020-01-01T00:00:00Z"
+					}
+
+					data "gitlab_project_pipelines" "unknown_ref" {
+						project = %[1]d
+						ref     = "does-not-exist"
+					}
+
+					data "gitlab_project_pipelines" "skipped" {
+						project = %[1]d
+						status  = "skipped"
+					}
+				`, testProject.ID, testProject.DefaultBranch),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_project_pipelines.ref", "pipelines.*", map[string]string{
+						"id":  fmt.Sprintf("%d", testPipeline.ID),
+						"iid": fmt.Sprintf("%d", testPipeline.IID),
+						"ref": testProject.DefaultBranch,
+						"sha": testPipeline.SHA,
+					}),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipelines.unknown_ref", "pipelines.#", "0"),
+					resource.TestCheckResourceAttr("data.gitlab_project_pipelines.skipped", "pipelines.#", "0"),
+				),
+			},
+		},
+	})
+}
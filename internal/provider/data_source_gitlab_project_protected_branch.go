@@ -36,8 +36,9 @@ var _ = registerDataSource("gitlab_project_protected_branch", func() *schema.Res
 				Type:        schema.TypeInt,
 				Computed:    true,
 			},
-			"push_access_levels":  dataSourceGitlabProjectProtectedBranchSchemaAccessLevels(),
-			"merge_access_levels": dataSourceGitlabProjectProtectedBranchSchemaAccessLevels(),
+			"push_access_levels":      dataSourceGitlabProjectProtectedBranchSchemaAccessLevels(),
+			"merge_access_levels":     dataSourceGitlabProjectProtectedBranchSchemaAccessLevels(),
+			"unprotect_access_levels": dataSourceGitlabProjectProtectedBranchSchemaAccessLevels(),
 			"allow_force_push": {
 				Description: "Whether force push is allowed.",
 				Type:        schema.TypeBool,
@@ -106,6 +107,9 @@ func dataSourceGitlabProjectProtectedBranchRead(ctx context.Context, d *schema.R
 	if err := d.Set("merge_access_levels", flattenBranchAccessDescriptions(pb.MergeAccessLevels)); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("unprotect_access_levels", flattenBranchAccessDescriptions(pb.UnprotectAccessLevels)); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set("allow_force_push", pb.AllowForcePush); err != nil {
 		return diag.FromErr(err)
 	}
@@ -40,8 +40,9 @@ var _ = registerDataSource("gitlab_project_protected_branches", func() *schema.R
 							Type:        schema.TypeInt,
 							Computed:    true,
 						},
-						"push_access_levels":  dataSourceGitlabProjectProtectedBranchSchemaAccessLevels(),
-						"merge_access_levels": dataSourceGitlabProjectProtectedBranchSchemaAccessLevels(),
+						"push_access_levels":      dataSourceGitlabProjectProtectedBranchSchemaAccessLevels(),
+						"merge_access_levels":     dataSourceGitlabProjectProtectedBranchSchemaAccessLevels(),
+						"unprotect_access_levels": dataSourceGitlabProjectProtectedBranchSchemaAccessLevels(),
 						"allow_force_push": {
 							Description: "Whether force push is allowed.",
 							Type:        schema.TypeBool,
@@ -64,9 +65,9 @@ func dataSourceGitlabProjectProtectedBranchesRead(ctx context.Context, d *schema
 
 	log.Printf("[INFO] Reading Gitlab protected branch")
 
-	project := d.Get("project_id")
+	project := d.Get("project_id").(string)
 
-	projectObject, _, err := client.Projects.GetProject(project, &gitlab.GetProjectOptions{}, gitlab.WithContext(ctx))
+	projectID, err := gitlabProjectID(ctx, client, project)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -93,7 +94,7 @@ func dataSourceGitlabProjectProtectedBranchesRead(ctx context.Context, d *schema
 		return diag.FromErr(err)
 	}
 
-	d.SetId(fmt.Sprintf("%d-%d", projectObject.ID, h))
+	d.SetId(fmt.Sprintf("%d-%d", projectID, h))
 
 	return nil
 }
@@ -105,6 +106,7 @@ func flattenProtectedBranches(protectedBranches []*gitlab.ProtectedBranch) (valu
 			"name":                         protectedBranch.Name,
 			"push_access_levels":           flattenBranchAccessDescriptions(protectedBranch.PushAccessLevels),
 			"merge_access_levels":          flattenBranchAccessDescriptions(protectedBranch.MergeAccessLevels),
+			"unprotect_access_levels":      flattenBranchAccessDescriptions(protectedBranch.UnprotectAccessLevels),
 			"allow_force_push":             protectedBranch.AllowForcePush,
 			"code_owner_approval_required": protectedBranch.CodeOwnerApprovalRequired,
 		})
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_protected_tags", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_protected_tags`" + ` data source allows details of the protected tags of a given project.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/protected_tags.html#list-protected-tags)`,
+
+		ReadContext: dataSourceGitlabProjectProtectedTagsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"protected_tags": {
+				Description: "A list of protected tags, as defined below.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag": {
+							Description: "The name of the protected tag or the wildcard pattern of protected tags.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"create_access_levels": {
+							Description: "Describes which access levels, users, or groups are allowed to create matching tags.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_level": {
+										Description: fmt.Sprintf("The access level allowed to create matching tags. Valid values are: %s", renderValueListForDocs(validProtectedBranchTagAccessLevelNames)),
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"access_level_description": {
+										Description: "A description of the allowed access level(s), or the name of the user or group if `user_id` or `group_id` are present.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"user_id": {
+										Description: "If present, indicates that the user is allowed to create matching tags. (only GitLab Premium or higher)",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"group_id": {
+										Description: "If present, indicates that the group is allowed to create matching tags. (only GitLab Premium or higher)",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectProtectedTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	log.Printf("[DEBUG] list protected tags of project %s", project)
+
+	options := &gitlab.ListProtectedTagsOptions{
+		Page:    1,
+		PerPage: 20,
+	}
+
+	var protectedTags []*gitlab.ProtectedTag
+	for options.Page != 0 {
+		paginatedProtectedTags, resp, err := client.ProtectedTags.ListProtectedTags(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		protectedTags = append(protectedTags, paginatedProtectedTags...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(project)
+	if err := d.Set("protected_tags", flattenProtectedTags(protectedTags)); err != nil {
+		return diag.Errorf("failed to set protected tags to state: %v", err)
+	}
+	return nil
+}
+
+func flattenProtectedTags(protectedTags []*gitlab.ProtectedTag) (values []map[string]interface{}) {
+	for _, protectedTag := range protectedTags {
+		values = append(values, map[string]interface{}{
+			"tag":                  protectedTag.Name,
+			"create_access_levels": flattenTagAccessDescriptions(protectedTag.CreateAccessLevels),
+		})
+	}
+	return values
+}
+
+func flattenTagAccessDescriptions(descriptions []*gitlab.TagAccessDescription) (values []map[string]interface{}) {
+	for _, description := range descriptions {
+		v := map[string]interface{}{
+			"access_level":             accessLevelValueToName[description.AccessLevel],
+			"access_level_description": description.AccessLevelDescription,
+		}
+		if description.UserID != 0 {
+			v["user_id"] = description.UserID
+		}
+		if description.GroupID != 0 {
+			v["group_id"] = description.GroupID
+		}
+		values = append(values, v)
+	}
+	return values
+}
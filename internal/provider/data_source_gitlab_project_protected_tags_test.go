@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabProjectProtectedTags_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_tag_protection" "release" {
+						project             = %d
+						tag                 = "v*"
+						create_access_level = "maintainer"
+					}
+
+					resource "gitlab_tag_protection" "nightly" {
+						project             = %d
+						tag                 = "nightly-*"
+						create_access_level = "developer"
+					}
+
+					data "gitlab_project_protected_tags" "this" {
+						project = %d
+
+						depends_on = [gitlab_tag_protection.release, gitlab_tag_protection.nightly]
+					}
+				`, testProject.ID, testProject.ID, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_protected_tags.this", "protected_tags.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_project_protected_tags.this", "protected_tags.*", map[string]string{
+						"tag":                                 "v*",
+						"create_access_levels.0.access_level": "maintainer",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_project_protected_tags.this", "protected_tags.*", map[string]string{
+						"tag":                                 "nightly-*",
+						"create_access_levels.0.access_level": "developer",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_project_statistics", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_statistics`" + ` data source allows to retrieve the storage statistics of a project.
+
+-> The statistics are updated asynchronously by GitLab, thus they may not immediately reflect the latest changes to the project.
+
+~> This data source requires at least the Reporter role in the project.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/projects.html#get-single-project)`,
+
+		ReadContext: dataSourceGitlabProjectStatisticsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"commit_count": {
+				Description: "The number of commits in the default branch of the repository.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"storage_size": {
+				Description: "The total storage used by the project, in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"repository_size": {
+				Description: "The size of the repository, in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"wiki_size": {
+				Description: "The size of the wiki repository, in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"lfs_objects_size": {
+				Description: "The size of the LFS objects, in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"job_artifacts_size": {
+				Description: "The size of the job artifacts, in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"pipeline_artifacts_size": {
+				Description: "The size of the pipeline artifacts, in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"packages_size": {
+				Description: "The size of the packages in the package registry, in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"snippets_size": {
+				Description: "The size of the snippets, in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"uploads_size": {
+				Description: "The size of the uploads, in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"container_registry_size": {
+				Description: "The size of the container registry, in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func dataSourceGitlabProjectStatisticsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	log.Printf("[DEBUG] read statistics of project %s", project)
+	found, _, err := client.Projects.GetProject(project, &gitlab.GetProjectOptions{Statistics: gitlab.Bool(true)}, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if found.Statistics == nil {
+		return diag.Errorf("statistics of project %s are not available, at least the Reporter role is required", project)
+	}
+
+	d.SetId(fmt.Sprintf("%d", found.ID))
+	stateMap := map[string]interface{}{
+		"project":                 project,
+		"commit_count":            found.Statistics.CommitCount,
+		"storage_size":            found.Statistics.StorageSize,
+		"repository_size":         found.Statistics.RepositorySize,
+		"wiki_size":               found.Statistics.WikiSize,
+		"lfs_objects_size":        found.Statistics.LFSObjectsSize,
+		"job_artifacts_size":      found.Statistics.JobArtifactsSize,
+		"pipeline_artifacts_size": found.Statistics.PipelineArtifactsSize,
+		"packages_size":           found.Statistics.PackagesSize,
+		"snippets_size":           found.Statistics.SnippetsSize,
+		"uploads_size":            found.Statistics.UploadsSize,
+		"container_registry_size": found.Statistics.ContainerRegistrySize,
+	}
+	if err := setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
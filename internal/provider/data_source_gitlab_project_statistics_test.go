@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabProjectStatistics_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_statistics" "this" {
+						project = "%s"
+					}
+				`, testProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_project_statistics.this", "id", fmt.Sprintf("%d", testProject.ID)),
+					resource.TestCheckResourceAttrSet("data.gitlab_project_statistics.this", "commit_count"),
+					resource.TestCheckResourceAttrSet("data.gitlab_project_statistics.this", "storage_size"),
+					resource.TestCheckResourceAttrSet("data.gitlab_project_statistics.this", "repository_size"),
+					resource.TestCheckResourceAttr("data.gitlab_project_statistics.this", "job_artifacts_size", "0"),
+					resource.TestCheckResourceAttr("data.gitlab_project_statistics.this", "packages_size", "0"),
+				),
+			},
+		},
+	})
+}
@@ -25,7 +25,7 @@ var _ = registerDataSource("gitlab_project_tags", func() *schema.Resource {
 				Required:    true,
 			},
 			"order_by": {
-				Description: "Return tags ordered by `name` or `updated` fields. Default is `updated`.",
+				Description: "Return tags ordered by `name`, `updated` or `version` fields. Default is `updated`. Ordering by `version` sorts the tags by their semantic version.",
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
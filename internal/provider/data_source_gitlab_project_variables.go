@@ -13,6 +13,8 @@ var _ = registerDataSource("gitlab_project_variables", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`gitlab_project_variables`" + ` data source allows to retrieve all project-level CI/CD variables.
 
+-> The ` + "`value`" + ` of masked or hidden variables is not exposed by this data source. Use the ` + "`gitlab_project_variable`" + ` data source to retrieve the value of a masked variable.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/project_level_variables.html)`,
 
 		ReadContext: dataSourceGitlabProjectVariablesRead,
@@ -71,7 +73,8 @@ func dataSourceGitlabProjectVariablesRead(ctx context.Context, d *schema.Resourc
 
 func flattenGitlabProjectVariables(project string, variables []*gitlab.ProjectVariable) (values []map[string]interface{}) {
 	for _, variable := range variables {
-		values = append(values, gitlabProjectVariableToStateMap(project, variable))
+		stateMap := gitlabProjectVariableToStateMap(project, variable)
+		values = append(values, redactListedVariableValue(stateMap, variable.Masked, variable.Hidden))
 	}
 	return values
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/xanzy/go-gitlab"
 )
@@ -38,3 +39,44 @@ func TestAccDataSourceGitlabProjectVariables_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceGitlabProjectVariables_maskedValuesNotExposed(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+
+	variable, _, err := testGitlabClient.ProjectVariables.CreateVariable(testProject.ID, &gitlab.CreateProjectVariableOptions{
+		Key:    gitlab.String(fmt.Sprintf("masked_key_%d", acctest.RandInt())),
+		Value:  gitlab.String("masked-secret-value"),
+		Masked: gitlab.Bool(true),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := testGitlabClient.ProjectVariables.RemoveVariable(testProject.ID, variable.Key, nil); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_variables" "this" {
+						project = %d
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_project_variables.this", "variables.*", map[string]string{
+						"key":    variable.Key,
+						"masked": "true",
+						"value":  "",
+					}),
+				),
+			},
+		},
+	})
+}
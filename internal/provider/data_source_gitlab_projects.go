@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -110,7 +111,7 @@ func flattenProjects(projects []*gitlab.Project) (values []map[string]interface{
 				"id":                                    project.ID,
 				"description":                           project.Description,
 				"default_branch":                        project.DefaultBranch,
-				"public":                                project.Public,
+				"public":                                project.Visibility == gitlab.PublicVisibility,
 				"visibility":                            string(project.Visibility),
 				"ssh_url_to_repo":                       project.SSHURLToRepo,
 				"http_url_to_repo":                      project.HTTPURLToRepo,
@@ -226,6 +227,12 @@ var _ = registerDataSource("gitlab_projects", func() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 			},
+			"max_results": {
+				Description:  "The maximum number of projects to return. The query stops requesting further pages as soon as this number is reached.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"page": {
 				Description: "The first page to begin the query on.",
 				Type:        schema.TypeInt,
@@ -332,6 +339,29 @@ var _ = registerDataSource("gitlab_projects", func() *schema.Resource {
 					"group_id",
 				},
 			},
+			"topic": {
+				Description: "Limit by projects with the given topics. Multiple topics are given as a comma-separated list and must all match.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"last_activity_after": {
+				Description:  "Limit by projects with a last activity after the given time, in RFC3339 format. Cannot be used with `group_id`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				ConflictsWith: []string{
+					"group_id",
+				},
+			},
+			"id_after": {
+				Description:  "Limit by projects with an ID greater than the given ID. Combined with `order_by = \"id\"` and `sort = \"asc\"` this allows to walk through large instances in bounded chunks by passing the highest ID of the previous result. Cannot be used with `group_id`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				ConflictsWith: []string{
+					"group_id",
+				},
+			},
 			"statistics": {
 				Description: "Include project statistics. Cannot be used with `group_id`.",
 				Type:        schema.TypeBool,
@@ -964,12 +994,15 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 	page := d.Get("page").(int)
 	perPage := d.Get("per_page").(int)
 	maxQueryablePages := d.Get("max_queryable_pages").(int)
+	maxResults := d.Get("max_results").(int)
 
 	// Conditional parameters
 	// Only way I found to conditionally pass a search parameter to the List(Group/Project)Options
 	// Json marshalling a complete List(Group/Project)Options JSON object had conversion issues with booleans
 	var archivedPtr *bool
+	var idAfterPtr *int
 	var includeSubGroupsPtr *bool
+	var lastActivityAfterPtr *time.Time
 	var membershipPtr *bool
 	var minAccessLevelPtr *gitlab.AccessLevelValue
 	var orderByPtr *string
@@ -979,6 +1012,7 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 	var sortPtr *string
 	var starredPtr *bool
 	var statisticsPtr *bool
+	var topicPtr *string
 	var visibilityPtr *gitlab.VisibilityValue
 	var withCustomAttributesPtr *bool
 	var withIssuesEnabledPtr *bool
@@ -997,10 +1031,23 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
 	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
+	if data, ok := d.GetOkExists("id_after"); ok {
+		d := data.(int)
+		idAfterPtr = &d
+	}
+	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
+	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
 	if data, ok := d.GetOkExists("include_subgroups"); ok {
 		d := data.(bool)
 		includeSubGroupsPtr = &d
 	}
+	if data, ok := d.GetOk("last_activity_after"); ok {
+		d, err := time.Parse(time.RFC3339, data.(string))
+		if err != nil {
+			return diag.Errorf("failed to parse last_activity_after %q: %v", data.(string), err)
+		}
+		lastActivityAfterPtr = &d
+	}
 	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
 	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
 	if data, ok := d.GetOkExists("membership"); ok {
@@ -1046,6 +1093,10 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 		d := data.(bool)
 		statisticsPtr = &d
 	}
+	if data, ok := d.GetOk("topic"); ok {
+		d := data.(string)
+		topicPtr = &d
+	}
 	if data, ok := d.GetOk("visibility"); ok {
 		visibilityPtr = gitlab.Visibility(gitlab.VisibilityValue(data.(string)))
 	}
@@ -1096,6 +1147,7 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 			Simple:                   simplePtr,
 			Owned:                    ownedPtr,
 			Starred:                  starredPtr,
+			Topic:                    topicPtr,
 			WithIssuesEnabled:        withIssuesEnabledPtr,
 			WithMergeRequestsEnabled: withMergeRequestsEnabledPtr,
 			WithShared:               withSharedPtr,
@@ -1115,6 +1167,12 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 			if response.CurrentPage == response.TotalPages || response.CurrentPage > maxQueryablePages {
 				break
 			}
+			if maxResults > 0 && len(projectList) >= maxResults {
+				break
+			}
+		}
+		if maxResults > 0 && len(projectList) > maxResults {
+			projectList = projectList[:maxResults]
 		}
 		h, err := hashstructure.Hash(*opts, nil)
 		if err != nil {
@@ -1133,6 +1191,8 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 				PerPage: perPage,
 			},
 			Archived:                 archivedPtr,
+			IDAfter:                  idAfterPtr,
+			LastActivityAfter:        lastActivityAfterPtr,
 			OrderBy:                  orderByPtr,
 			Sort:                     sortPtr,
 			Search:                   searchPtr,
@@ -1141,6 +1201,7 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 			Membership:               membershipPtr,
 			Starred:                  starredPtr,
 			Statistics:               statisticsPtr,
+			Topic:                    topicPtr,
 			Visibility:               visibilityPtr,
 			WithIssuesEnabled:        withIssuesEnabledPtr,
 			WithMergeRequestsEnabled: withMergeRequestsEnabledPtr,
@@ -1161,6 +1222,12 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 			if response.CurrentPage == response.TotalPages || response.CurrentPage > maxQueryablePages {
 				break
 			}
+			if maxResults > 0 && len(projectList) >= maxResults {
+				break
+			}
+		}
+		if maxResults > 0 && len(projectList) > maxResults {
+			projectList = projectList[:maxResults]
 		}
 		h, err := hashstructure.Hash(*opts, nil)
 		if err != nil {
@@ -143,6 +143,79 @@ func TestAccDataGitlabProjects_searchArchivedRepository(t *testing.T) {
 	})
 }
 
+func TestAccDataGitlabProjects_topicIDAfterAndMaxResults(t *testing.T) {
+	rInt := acctest.RandInt()
+	projectsConfig := fmt.Sprintf(`
+resource "gitlab_project" "this" {
+  count = 3
+
+  name             = "topic-${count.index}-%[1]d"
+  topics           = ["topic-%[1]d"]
+  visibility_level = "public"
+}
+`, rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "gitlab_projects" "this" {
+  topic               = "topic-%d"
+  order_by            = "id"
+  sort                = "asc"
+  last_activity_after = "2000-01-01T00:00:00Z"
+
+  depends_on = [gitlab_project.this]
+}
+`, projectsConfig, rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_projects.this", "projects.#", "3"),
+					resource.TestCheckResourceAttrPair("data.gitlab_projects.this", "projects.0.id", "gitlab_project.this.0", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "gitlab_projects" "this" {
+  topic       = "topic-%d"
+  order_by    = "id"
+  sort        = "asc"
+  per_page    = 1
+  max_results = 2
+
+  depends_on = [gitlab_project.this]
+}
+`, projectsConfig, rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_projects.this", "projects.#", "2"),
+					resource.TestCheckResourceAttrPair("data.gitlab_projects.this", "projects.1.id", "gitlab_project.this.1", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "gitlab_projects" "this" {
+  topic    = "topic-%d"
+  order_by = "id"
+  sort     = "asc"
+  id_after = gitlab_project.this[0].id
+}
+`, projectsConfig, rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_projects.this", "projects.#", "2"),
+					resource.TestCheckResourceAttrPair("data.gitlab_projects.this", "projects.0.id", "gitlab_project.this.1", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceGitlabProjects(src string, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
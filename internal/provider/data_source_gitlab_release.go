@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_release", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_release`" + ` data source allows details of a release to be retrieved by its tag name and the project it belongs to.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/releases/#get-a-release-by-a-tag-name)`,
+
+		ReadContext: dataSourceGitlabReleaseRead,
+		Schema:      datasourceSchemaFromResourceSchema(gitlabReleaseGetSchema(), []string{"project", "tag_name"}, nil),
+	}
+})
+
+// gitlabRelease extends the go-gitlab release with the evidences,
+// which go-gitlab doesn't expose yet.
+type gitlabRelease struct {
+	gitlab.Release
+	Evidences []struct {
+		SHA         string     `json:"sha"`
+		Filepath    string     `json:"filepath"`
+		CollectedAt *time.Time `json:"collected_at"`
+	} `json:"evidences"`
+}
+
+func gitlabReleaseGetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project": {
+			Description: "The ID or full path of the project.",
+			Type:        schema.TypeString,
+		},
+		"tag_name": {
+			Description: "The tag the release is created from.",
+			Type:        schema.TypeString,
+		},
+		"name": {
+			Description: "The name of the release.",
+			Type:        schema.TypeString,
+		},
+		"description": {
+			Description: "The description of the release.",
+			Type:        schema.TypeString,
+		},
+		"created_at": {
+			Description: "The time the release has been created, in RFC3339 format.",
+			Type:        schema.TypeString,
+		},
+		"released_at": {
+			Description: "The time the release is or has been ready, in RFC3339 format.",
+			Type:        schema.TypeString,
+		},
+		"upcoming_release": {
+			Description: "Whether the release is an upcoming release, i.e. `released_at` is in the future.",
+			Type:        schema.TypeBool,
+		},
+		"commit_sha": {
+			Description: "The SHA of the commit the release tag points to.",
+			Type:        schema.TypeString,
+		},
+		"author_username": {
+			Description: "The username of the author of the release.",
+			Type:        schema.TypeString,
+		},
+		"assets": {
+			Description: "The assets of the release.",
+			Type:        schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"count": {
+						Description: "The number of assets of the release.",
+						Type:        schema.TypeInt,
+						Computed:    true,
+					},
+					"sources": {
+						Description: "The source code archives of the release.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"format": {
+									Description: "The format of the archive, e.g. `zip` or `tar.gz`.",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+								"url": {
+									Description: "The URL to download the archive.",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+							},
+						},
+					},
+					"links": {
+						Description: "The asset links of the release.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"id": {
+									Description: "The ID of the link.",
+									Type:        schema.TypeInt,
+									Computed:    true,
+								},
+								"name": {
+									Description: "The name of the link.",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+								"url": {
+									Description: "The URL of the linked asset.",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+								"direct_asset_url": {
+									Description: "The permanent URL redirecting to the linked asset.",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+								"link_type": {
+									Description: "The type of the link, one of `other`, `runbook`, `image` or `package`.",
+									Type:        schema.TypeString,
+									Computed:    true,
+								},
+								"external": {
+									Description: "Whether the linked asset is hosted outside of GitLab.",
+									Type:        schema.TypeBool,
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"evidences": {
+			Description: "The evidences collected for the release.",
+			Type:        schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"sha": {
+						Description: "The SHA of the evidence.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"filepath": {
+						Description: "The URL to download the evidence.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"collected_at": {
+						Description: "The time the evidence has been collected, in RFC3339 format.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGitlabReleaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	tagName := d.Get("tag_name").(string)
+
+	log.Printf("[DEBUG] read release %s of project %s", tagName, project)
+
+	// NOTE: go-gitlab doesn't yet return the evidences of a release, thus we do the raw request.
+	u := fmt.Sprintf("projects/%s/releases/%s", gitlab.PathEscape(project), gitlab.PathEscape(tagName))
+	req, err := client.NewRequest(http.MethodGet, u, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	release := new(gitlabRelease)
+	if _, err := client.Do(req, release); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildTwoPartID(&project, &tagName))
+	if err := setStateMapInResourceData(gitlabReleaseToStateMap(project, release), d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func gitlabReleaseToStateMap(project string, release *gitlabRelease) map[string]interface{} {
+	stateMap := map[string]interface{}{
+		"project":          project,
+		"tag_name":         release.TagName,
+		"name":             release.Name,
+		"description":      release.Description,
+		"upcoming_release": release.UpcomingRelease,
+		"commit_sha":       release.Commit.ID,
+		"author_username":  release.Author.Username,
+	}
+	if release.CreatedAt != nil {
+		stateMap["created_at"] = release.CreatedAt.Format(time.RFC3339)
+	}
+	if release.ReleasedAt != nil {
+		stateMap["released_at"] = release.ReleasedAt.Format(time.RFC3339)
+	}
+
+	var sources []map[string]interface{}
+	for _, source := range release.Assets.Sources {
+		sources = append(sources, map[string]interface{}{
+			"format": source.Format,
+			"url":    source.URL,
+		})
+	}
+	var links []map[string]interface{}
+	for _, link := range release.Assets.Links {
+		links = append(links, map[string]interface{}{
+			"id":               link.ID,
+			"name":             link.Name,
+			"url":              link.URL,
+			"direct_asset_url": link.DirectAssetURL,
+			"link_type":        string(link.LinkType),
+			"external":         link.External,
+		})
+	}
+	stateMap["assets"] = []map[string]interface{}{
+		{
+			"count":   release.Assets.Count,
+			"sources": sources,
+			"links":   links,
+		},
+	}
+
+	var evidences []map[string]interface{}
+	for _, evidence := range release.Evidences {
+		v := map[string]interface{}{
+			"sha":      evidence.SHA,
+			"filepath": evidence.Filepath,
+		}
+		if evidence.CollectedAt != nil {
+			v["collected_at"] = evidence.CollectedAt.Format(time.RFC3339)
+		}
+		evidences = append(evidences, v)
+	}
+	stateMap["evidences"] = evidences
+
+	return stateMap
+}
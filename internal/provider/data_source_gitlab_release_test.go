@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabRelease_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testReleases := testAccCreateReleases(t, testProject, 1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_release" "this" {
+						project  = "%s"
+						tag_name = "%s"
+					}
+				`, testProject.PathWithNamespace, testReleases[0].TagName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_release.this", "name", testReleases[0].Name),
+					resource.TestCheckResourceAttr("data.gitlab_release.this", "commit_sha", testReleases[0].Commit.ID),
+					resource.TestCheckResourceAttr("data.gitlab_release.this", "upcoming_release", "false"),
+					resource.TestCheckResourceAttrSet("data.gitlab_release.this", "released_at"),
+					resource.TestCheckResourceAttr("data.gitlab_release.this", "assets.0.links.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_release.this", "assets.0.links.0.name", "artifact-0.tar.gz"),
+					resource.TestCheckResourceAttr("data.gitlab_release.this", "assets.0.links.0.url", "https://example.com/artifact-0.tar.gz"),
+					resource.TestCheckResourceAttrSet("data.gitlab_release.this", "assets.0.links.0.direct_asset_url"),
+					resource.TestCheckResourceAttrSet("data.gitlab_release.this", "assets.0.sources.0.url"),
+					resource.TestCheckResourceAttr("data.gitlab_release.this", "evidences.#", "1"),
+					resource.TestCheckResourceAttrSet("data.gitlab_release.this", "evidences.0.sha"),
+				),
+			},
+		},
+	})
+}
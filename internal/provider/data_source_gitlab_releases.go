@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_releases", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_releases`" + ` data source allows to retrieve all releases of a project.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/releases/#list-releases)`,
+
+		ReadContext: dataSourceGitlabReleasesRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"order_by": {
+				Description:      "Order the releases by `released_at` or `created_at`. Defaults to `released_at`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"released_at", "created_at"}, false)),
+			},
+			"sort": {
+				Description:      "Sort the releases in `asc` or `desc` order. Defaults to `desc`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"asc", "desc"}, false)),
+			},
+			"releases": {
+				Description: "The list of releases.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: datasourceSchemaFromResourceSchema(gitlabReleaseGetSchema(), nil, nil),
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabReleasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlab.ListReleasesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 20,
+			Page:    1,
+		},
+	}
+	if v, ok := d.GetOk("order_by"); ok {
+		options.OrderBy = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("sort"); ok {
+		options.Sort = gitlab.String(v.(string))
+	}
+
+	optionsHash, err := hashstructure.Hash(options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list releases of project %s", project)
+
+	// NOTE: go-gitlab doesn't yet return the evidences of a release, thus we do the raw request.
+	u := fmt.Sprintf("projects/%s/releases", gitlab.PathEscape(project))
+
+	var releases []*gitlabRelease
+	for options.Page != 0 {
+		req, err := client.NewRequest(http.MethodGet, u, options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var paginatedReleases []*gitlabRelease
+		resp, err := client.Do(req, &paginatedReleases)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		releases = append(releases, paginatedReleases...)
+		options.Page = resp.NextPage
+	}
+
+	var values []map[string]interface{}
+	for _, release := range releases {
+		values = append(values, gitlabReleaseToStateMap(project, release))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", project, optionsHash))
+	if err := d.Set("releases", values); err != nil {
+		return diag.Errorf("failed to set releases to state: %v", err)
+	}
+	return nil
+}
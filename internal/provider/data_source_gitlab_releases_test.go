@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabReleases_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testAccCreateReleases(t, testProject, 3)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_releases" "this" {
+						project  = %d
+						order_by = "created_at"
+						sort     = "asc"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_releases.this", "releases.#", "3"),
+					resource.TestCheckResourceAttr("data.gitlab_releases.this", "releases.0.tag_name", "v0.0.0"),
+					resource.TestCheckResourceAttr("data.gitlab_releases.this", "releases.2.tag_name", "v2.0.0"),
+					resource.TestCheckResourceAttr("data.gitlab_releases.this", "releases.1.assets.0.links.0.name", "artifact-1.tar.gz"),
+					resource.TestCheckResourceAttr("data.gitlab_releases.this", "releases.1.evidences.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
 
@@ -14,10 +15,21 @@ var _ = registerDataSource("gitlab_repository_file", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`gitlab_repository_file`" + ` data source allows details of a file in a repository to be retrieved.
 
+-> The ` + "`content`" + ` attribute is base64 encoded, use ` + "`raw_content`" + ` to consume the file as is, e.g. in a Kubernetes config map.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/repository_files.html)`,
 
 		ReadContext: dataSourceGitlabRepositoryFileRead,
-		Schema:      datasourceSchemaFromResourceSchema(gitlabRepositoryFileGetSchema(), []string{"project", "file_path", "ref"}, nil),
+		Schema: constructSchema(
+			datasourceSchemaFromResourceSchema(gitlabRepositoryFileGetSchema(), []string{"project", "file_path", "ref"}, nil),
+			map[string]*schema.Schema{
+				"raw_content": {
+					Description: "The decoded content of the file.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		),
 	}
 })
 
@@ -39,6 +51,11 @@ func dataSourceGitlabRepositoryFileRead(ctx context.Context, d *schema.ResourceD
 	d.SetId(fmt.Sprintf("%s:%s:%s", project, repositoryFile.Ref, repositoryFile.FilePath))
 
 	stateMap := gitlabRepositoryFileToStateMap(project, repositoryFile)
+	rawContent, err := base64.StdEncoding.DecodeString(repositoryFile.Content)
+	if err != nil {
+		return diag.Errorf("failed to decode content of file %s: %v", filePath, err)
+	}
+	stateMap["raw_content"] = string(rawContent)
 	if err := setStateMapInResourceData(stateMap, d); err != nil {
 		return diag.FromErr(err)
 	}
@@ -19,6 +19,8 @@ func TestAccDataGitlabRepositoryFile_basic(t *testing.T) {
 				Config: testAccDataGitlabRepositoryFile(project.PathWithNamespace),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourceGitlabRepositoryFile("gitlab_repository_file.foo", "data.gitlab_repository_file.foo"),
+					resource.TestCheckResourceAttr("data.gitlab_repository_file.foo", "raw_content", "Meow goes the cat"),
+					resource.TestCheckResourceAttr("data.gitlab_repository_file.foo", "size", "17"),
 				),
 			},
 		},
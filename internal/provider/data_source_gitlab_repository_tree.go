@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_repository_tree", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_repository_tree`" + ` data source allows details of directories and files in a repository to be retrieved.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/repositories.html#list-repository-tree)`,
+
+		ReadContext: dataSourceGitlabRepositoryTreeRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project owned by the authenticated user.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"ref": {
+				Description: "The name of a repository branch or tag.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"path": {
+				Description: "The path inside repository. Used to get content of subdirectories.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"recursive": {
+				Description: "Boolean value used to get a recursive tree.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"tree": {
+				Description: "The list of files and directories of the repository tree.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The SHA-1 hash of the tree or blob in the repository.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the blob or tree.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "The type of the entry, either `blob` for a file or `tree` for a directory.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"path": {
+							Description: "The path of the blob or tree relative to the repository root.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"mode": {
+							Description: "The Unix access mode of the file in the repository.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabRepositoryTreeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := gitlab.ListTreeOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		Ref:       gitlab.String(d.Get("ref").(string)),
+		Recursive: gitlab.Bool(d.Get("recursive").(bool)),
+	}
+	if v, ok := d.GetOk("path"); ok {
+		options.Path = gitlab.String(v.(string))
+	}
+
+	optionsHash, err := hashstructure.Hash(&options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list repository tree of project %s at ref %s", project, *options.Ref)
+
+	var nodes []*gitlab.TreeNode
+	for options.Page != 0 {
+		paginatedNodes, resp, err := client.Repositories.ListTree(project, &options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		nodes = append(nodes, paginatedNodes...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", project, optionsHash))
+	if err := d.Set("tree", flattenGitlabRepositoryTree(nodes)); err != nil {
+		return diag.Errorf("failed to set tree to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabRepositoryTree(nodes []*gitlab.TreeNode) (values []map[string]interface{}) {
+	for _, node := range nodes {
+		values = append(values, map[string]interface{}{
+			"id":   node.ID,
+			"name": node.Name,
+			"type": node.Type,
+			"path": node.Path,
+			"mode": node.Mode,
+		})
+	}
+	return values
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabRepositoryTree_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_repository_file" "staging" {
+						project        = %[1]d
+						file_path      = "environments/staging/main.tf"
+						branch         = "main"
+						content        = base64encode("# staging")
+						commit_message = "Add staging environment"
+					}
+
+					resource "gitlab_repository_file" "production" {
+						project        = %[1]d
+						file_path      = "environments/production/main.tf"
+						branch         = "main"
+						content        = base64encode("# production")
+						commit_message = "Add production environment"
+
+						depends_on = [gitlab_repository_file.staging]
+					}
+
+					data "gitlab_repository_tree" "environments" {
+						project = %[1]d
+						ref     = "main"
+						path    = "environments"
+
+						depends_on = [gitlab_repository_file.production]
+					}
+
+					data "gitlab_repository_tree" "recursive" {
+						project   = %[1]d
+						ref       = "main"
+						recursive = true
+
+						depends_on = [gitlab_repository_file.production]
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_repository_tree.environments", "tree.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_repository_tree.environments", "tree.*", map[string]string{
+						"name": "staging",
+						"type": "tree",
+						"path": "environments/staging",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_repository_tree.environments", "tree.*", map[string]string{
+						"name": "production",
+						"type": "tree",
+						"path": "environments/production",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_repository_tree.recursive", "tree.*", map[string]string{
+						"name": "README.md",
+						"type": "blob",
+						"path": "README.md",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_repository_tree.recursive", "tree.*", map[string]string{
+						"name": "main.tf",
+						"type": "blob",
+						"path": "environments/staging/main.tf",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+)
+
+var validTemplateTypes = []string{"gitignores", "licenses", "gitlab_ci_ymls", "dockerfiles"}
+
+var _ = registerDataSource("gitlab_template", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_template`" + ` data source allows to retrieve the content of a gitignore, license, GitLab CI YAML or Dockerfile template of the GitLab instance.
+
+**Upstream API**: GitLab REST API docs for [gitignores](https://docs.gitlab.com/ee/api/templates/gitignores.html#single-gitignore-template), [licenses](https://docs.gitlab.com/ee/api/templates/licenses.html#single-license-template), [GitLab CI YAMLs](https://docs.gitlab.com/ee/api/templates/gitlab_ci_ymls.html#single-gitlab-ci-yaml-template) and [Dockerfiles](https://docs.gitlab.com/ee/api/templates/dockerfiles.html#single-dockerfile-template)`,
+
+		ReadContext: dataSourceGitlabTemplateRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description:      fmt.Sprintf("The type of the template. Valid values are: %s.", renderValueListForDocs(validTemplateTypes)),
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validTemplateTypes, false)),
+			},
+			"key": {
+				Description: "The key of the template, e.g. `Go` for the gitignore template or `mit` for the license template.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"project": {
+				Description: "The copyrighted project name to replace the placeholder of a license template with. Only valid for the `licenses` type.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"fullname": {
+				Description: "The full name of the copyright holder to replace the placeholder of a license template with. Only valid for the `licenses` type.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"name": {
+				Description: "The name of the template.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"content": {
+				Description: "The content of the template.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func dataSourceGitlabTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	templateType := d.Get("type").(string)
+	key := d.Get("key").(string)
+
+	if templateType != "licenses" {
+		for _, attr := range []string{"project", "fullname"} {
+			if _, ok := d.GetOk(attr); ok {
+				return diag.Errorf("`%s` is only valid for the `licenses` template type", attr)
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] read %s template %s", templateType, key)
+
+	var name, content string
+	switch templateType {
+	case "gitignores":
+		template, _, err := client.GitIgnoreTemplates.GetTemplate(key, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		name, content = template.Name, template.Content
+	case "licenses":
+		options := &gitlab.GetLicenseTemplateOptions{}
+		if v, ok := d.GetOk("project"); ok {
+			options.Project = gitlab.String(v.(string))
+		}
+		if v, ok := d.GetOk("fullname"); ok {
+			options.Fullname = gitlab.String(v.(string))
+		}
+		template, _, err := client.LicenseTemplates.GetLicenseTemplate(key, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		name, content = template.Name, template.Content
+	case "gitlab_ci_ymls":
+		template, _, err := client.CIYMLTemplate.GetTemplate(key, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		name, content = template.Name, template.Content
+	case "dockerfiles":
+		template, _, err := client.DockerfileTemplate.GetTemplate(key, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		name, content = template.Name, template.Content
+	}
+
+	d.SetId(buildTwoPartID(&templateType, &key))
+	d.Set("name", name)
+	d.Set("content", content)
+	return nil
+}
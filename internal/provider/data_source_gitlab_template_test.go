@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabTemplate_basic(t *testing.T) {
+	testAccCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "gitlab_template" "gitignore" {
+						type = "gitignores"
+						key  = "Go"
+					}
+
+					data "gitlab_template" "license" {
+						type     = "licenses"
+						key      = "mit"
+						project  = "acctest"
+						fullname = "ACME Inc."
+					}
+
+					data "gitlab_template" "ci" {
+						type = "gitlab_ci_ymls"
+						key  = "Go"
+					}
+
+					data "gitlab_template" "dockerfile" {
+						type = "dockerfiles"
+						key  = "Binary"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_template.gitignore", "name", "Go"),
+					resource.TestMatchResourceAttr("data.gitlab_template.gitignore", "content", regexp.MustCompile(`\*\.test`)),
+					resource.TestCheckResourceAttr("data.gitlab_template.license", "name", "MIT License"),
+					resource.TestMatchResourceAttr("data.gitlab_template.license", "content", regexp.MustCompile(`ACME Inc\.`)),
+					resource.TestCheckResourceAttr("data.gitlab_template.ci", "name", "Go"),
+					resource.TestMatchResourceAttr("data.gitlab_template.ci", "content", regexp.MustCompile(`go test`)),
+					resource.TestCheckResourceAttr("data.gitlab_template.dockerfile", "name", "Binary"),
+					resource.TestMatchResourceAttr("data.gitlab_template.dockerfile", "content", regexp.MustCompile(`FROM `)),
+				),
+			},
+			{
+				Config: `
+					data "gitlab_template" "this" {
+						type     = "gitignores"
+						key      = "Go"
+						fullname = "ACME Inc."
+					}
+				`,
+				ExpectError: regexp.MustCompile("`fullname` is only valid for the `licenses` template type"),
+			},
+		},
+	})
+}
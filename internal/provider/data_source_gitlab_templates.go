@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_templates", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_templates`" + ` data source allows to retrieve the gitignore, license, GitLab CI YAML or Dockerfile templates of the GitLab instance.
+
+-> Use the ` + "`gitlab_template`" + ` data source to retrieve the content of a template.
+
+**Upstream API**: GitLab REST API docs for [gitignores](https://docs.gitlab.com/ee/api/templates/gitignores.html#get-all-gitignore-templates), [licenses](https://docs.gitlab.com/ee/api/templates/licenses.html#list-license-templates), [GitLab CI YAMLs](https://docs.gitlab.com/ee/api/templates/gitlab_ci_ymls.html#list-gitlab-ci-yaml-templates) and [Dockerfiles](https://docs.gitlab.com/ee/api/templates/dockerfiles.html#list-dockerfile-templates)`,
+
+		ReadContext: dataSourceGitlabTemplatesRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description:      fmt.Sprintf("The type of the templates. Valid values are: %s.", renderValueListForDocs(validTemplateTypes)),
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validTemplateTypes, false)),
+			},
+			"popular": {
+				Description: "Only return the popular license templates. Only valid for the `licenses` type.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"templates": {
+				Description: "The list of templates.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Description: "The key of the template.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the template.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	templateType := d.Get("type").(string)
+	popular := d.Get("popular").(bool)
+
+	if popular && templateType != "licenses" {
+		return diag.Errorf("`popular` is only valid for the `licenses` template type")
+	}
+
+	log.Printf("[DEBUG] list %s templates", templateType)
+
+	listOptions := gitlab.ListOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	var values []map[string]interface{}
+	for listOptions.Page != 0 {
+		var resp *gitlab.Response
+		switch templateType {
+		case "gitignores":
+			options := gitlab.ListTemplatesOptions(listOptions)
+			templates, r, err := client.GitIgnoreTemplates.ListTemplates(&options, gitlab.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			for _, template := range templates {
+				values = append(values, map[string]interface{}{"key": template.Key, "name": template.Name})
+			}
+			resp = r
+		case "licenses":
+			options := &gitlab.ListLicenseTemplatesOptions{ListOptions: listOptions}
+			if popular {
+				options.Popular = gitlab.Bool(true)
+			}
+			templates, r, err := client.LicenseTemplates.ListLicenseTemplates(options, gitlab.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			for _, template := range templates {
+				values = append(values, map[string]interface{}{"key": template.Key, "name": template.Name})
+			}
+			resp = r
+		case "gitlab_ci_ymls":
+			options := gitlab.ListCIYMLTemplatesOptions(listOptions)
+			templates, r, err := client.CIYMLTemplate.ListAllTemplates(&options, gitlab.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			for _, template := range templates {
+				values = append(values, map[string]interface{}{"key": template.Key, "name": template.Name})
+			}
+			resp = r
+		case "dockerfiles":
+			options := gitlab.ListDockerfileTemplatesOptions(listOptions)
+			templates, r, err := client.DockerfileTemplate.ListTemplates(&options, gitlab.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			for _, template := range templates {
+				values = append(values, map[string]interface{}{"key": template.Key, "name": template.Name})
+			}
+			resp = r
+		}
+		listOptions.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%s:%t", templateType, popular))
+	if err := d.Set("templates", values); err != nil {
+		return diag.Errorf("failed to set templates to state: %v", err)
+	}
+	return nil
+}
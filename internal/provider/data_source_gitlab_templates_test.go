@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabTemplates_basic(t *testing.T) {
+	testAccCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "gitlab_templates" "gitignores" {
+						type = "gitignores"
+					}
+
+					data "gitlab_templates" "licenses" {
+						type    = "licenses"
+						popular = true
+					}
+
+					data "gitlab_templates" "ci" {
+						type = "gitlab_ci_ymls"
+					}
+
+					data "gitlab_templates" "dockerfiles" {
+						type = "dockerfiles"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_templates.gitignores", "templates.*", map[string]string{
+						"key":  "Go",
+						"name": "Go",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_templates.licenses", "templates.*", map[string]string{
+						"key":  "mit",
+						"name": "MIT License",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_templates.ci", "templates.*", map[string]string{
+						"key": "Go",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_templates.dockerfiles", "templates.*", map[string]string{
+						"key": "Binary",
+					}),
+				),
+			},
+			{
+				Config: `
+					data "gitlab_templates" "this" {
+						type    = "dockerfiles"
+						popular = true
+					}
+				`,
+				ExpectError: regexp.MustCompile("`popular` is only valid for the `licenses` template type"),
+			},
+		},
+	})
+}
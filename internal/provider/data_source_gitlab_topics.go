@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_topics", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_topics`" + ` data source allows to retrieve the project topics of the GitLab instance, ordered by the number of associated projects.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/topics.html#list-topics)`,
+
+		ReadContext: dataSourceGitlabTopicsRead,
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Description: "Return the topics matching the search string in their names.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"without_projects": {
+				Description: "Only return the topics without any assigned projects.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"topics": {
+				Description: "The list of topics.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the topic.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the topic.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"title": {
+							Description: "The title of the topic.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the topic.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"total_projects_count": {
+							Description: "The number of projects the topic is assigned to.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"avatar_url": {
+							Description: "The URL of the avatar image of the topic.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+// gitlabListTopicsOptions represents the options to list the topics.
+// NOTE: go-gitlab doesn't yet implement the `without_projects` attribute.
+type gitlabListTopicsOptions struct {
+	gitlab.ListTopicsOptions
+	WithoutProjects *bool `url:"without_projects,omitempty" json:"without_projects,omitempty"`
+}
+
+func dataSourceGitlabTopicsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	options := &gitlabListTopicsOptions{
+		ListTopicsOptions: gitlab.ListTopicsOptions{
+			ListOptions: gitlab.ListOptions{
+				PerPage: 100,
+				Page:    1,
+			},
+		},
+	}
+	if v, ok := d.GetOk("search"); ok {
+		options.Search = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("without_projects"); ok {
+		options.WithoutProjects = gitlab.Bool(v.(bool))
+	}
+
+	optionsHash, err := hashstructure.Hash(options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] list topics")
+
+	var topics []*gitlab.Topic
+	for options.Page != 0 {
+		req, err := client.NewRequest(http.MethodGet, "topics", options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var paginatedTopics []*gitlab.Topic
+		resp, err := client.Do(req, &paginatedTopics)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		topics = append(topics, paginatedTopics...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(fmt.Sprintf("%d", optionsHash))
+	if err := d.Set("topics", flattenGitlabTopics(topics)); err != nil {
+		return diag.Errorf("failed to set topics to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabTopics(topics []*gitlab.Topic) (values []map[string]interface{}) {
+	for _, topic := range topics {
+		values = append(values, map[string]interface{}{
+			"id":                   topic.ID,
+			"name":                 topic.Name,
+			"title":                topic.Title,
+			"description":          topic.Description,
+			"total_projects_count": int(topic.TotalProjectsCount),
+			"avatar_url":           topic.AvatarURL,
+		})
+	}
+	return values
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabTopics_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	prefix := acctest.RandomWithPrefix("acctest-topic")
+
+	for _, name := range []string{prefix + "-assigned", prefix + "-unassigned"} {
+		topic, _, err := testGitlabClient.Topics.CreateTopic(&gitlab.CreateTopicOptions{
+			Name:        gitlab.String(name),
+			Title:       gitlab.String(name),
+			Description: gitlab.String("Topic for " + name),
+		})
+		if err != nil {
+			t.Fatalf("could not create test topic: %v", err)
+		}
+
+		topicID := topic.ID // Needed for closure.
+		t.Cleanup(func() {
+			if _, err := testGitlabClient.Topics.DeleteTopic(topicID); err != nil {
+				t.Fatalf("could not cleanup test topic: %v", err)
+			}
+		})
+	}
+
+	if _, _, err := testGitlabClient.Projects.EditProject(testProject.ID, &gitlab.EditProjectOptions{
+		Topics: &[]string{prefix + "-assigned"},
+	}); err != nil {
+		t.Fatalf("could not assign test topic to project: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_topics" "this" {
+						search = "%s"
+					}
+				`, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_topics.this", "topics.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlab_topics.this", "topics.0.name", prefix+"-assigned"),
+					resource.TestCheckResourceAttr("data.gitlab_topics.this", "topics.0.title", prefix+"-assigned"),
+					resource.TestCheckResourceAttr("data.gitlab_topics.this", "topics.0.description", "Topic for "+prefix+"-assigned"),
+					resource.TestCheckResourceAttr("data.gitlab_topics.this", "topics.0.total_projects_count", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_topics.this", "topics.1.name", prefix+"-unassigned"),
+					resource.TestCheckResourceAttr("data.gitlab_topics.this", "topics.1.total_projects_count", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_topics" "this" {
+						search           = "%s"
+						without_projects = true
+					}
+				`, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_topics.this", "topics.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_topics.this", "topics.0.name", prefix+"-unassigned"),
+				),
+			},
+		},
+	})
+}
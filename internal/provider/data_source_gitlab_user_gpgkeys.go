@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_user_gpgkeys", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_user_gpgkeys`" + ` data source allows to retrieve the GPG keys of a user.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/users.html#list-all-gpg-keys-for-given-user)`,
+
+		ReadContext: dataSourceGitlabUserGPGKeysRead,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The ID of the user.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"keys": {
+				Description: "The list of GPG keys of the user.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_id": {
+							Description: "The ID of the GPG key.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"key": {
+							Description: "The armored GPG public key.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The time the GPG key has been created, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabUserGPGKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	userID := d.Get("user_id").(int)
+
+	log.Printf("[DEBUG] list GPG keys of user %d", userID)
+	keys, _, err := client.Users.ListGPGKeysForUser(userID, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(userID))
+	if err := d.Set("keys", flattenGitlabUserGPGKeys(keys)); err != nil {
+		return diag.Errorf("failed to set keys to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabUserGPGKeys(keys []*gitlab.GPGKey) (values []map[string]interface{}) {
+	for _, key := range keys {
+		v := map[string]interface{}{
+			"key_id": key.ID,
+			"key":    key.Key,
+		}
+		if key.CreatedAt != nil {
+			v["created_at"] = key.CreatedAt.Format(time.RFC3339)
+		}
+		values = append(values, v)
+	}
+	return values
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabUserGPGKeys_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testUser := testAccCreateUsers(t, 1)[0]
+	testKey, _, err := testGitlabClient.Users.AddGPGKeyForUser(testUser.ID, &gitlab.AddGPGKeyOptions{
+		Key: gitlab.String(testGPGPubKey),
+	})
+	if err != nil {
+		t.Fatalf("failed to add GPG key: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_user_gpgkeys" "this" {
+						user_id = %d
+					}
+				`, testUser.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_user_gpgkeys.this", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_user_gpgkeys.this", "keys.0.key_id", fmt.Sprintf("%d", testKey.ID)),
+					resource.TestCheckResourceAttrSet("data.gitlab_user_gpgkeys.this", "keys.0.key"),
+					resource.TestCheckResourceAttrSet("data.gitlab_user_gpgkeys.this", "keys.0.created_at"),
+				),
+			},
+		},
+	})
+}
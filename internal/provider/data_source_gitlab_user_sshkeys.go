@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_user_sshkeys", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_user_sshkeys`" + ` data source allows to retrieve the SSH keys of a user.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/users.html#list-ssh-keys-for-user)`,
+
+		ReadContext: dataSourceGitlabUserSSHKeysRead,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The ID of the user.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"keys": {
+				Description: "The list of SSH keys of the user.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_id": {
+							Description: "The ID of the SSH key.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"title": {
+							Description: "The title of the SSH key.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"key": {
+							Description: "The SSH key.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"usage_type": {
+							Description: "The usage type of the SSH key, one of `auth`, `signing` or `auth_and_signing`. Only available on GitLab 15.7 and later.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The time the SSH key has been created, in RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"expires_at": {
+							Description: "The time the SSH key expires, in RFC3339 format. Empty if the key never expires.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_used_at": {
+							Description: "The time the SSH key has last been used, in RFC3339 format. Only available on GitLab 15.11 and later.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+// gitlabUserSSHKey extends the go-gitlab SSH key with the usage type and
+// the last usage time, which go-gitlab doesn't expose yet.
+type gitlabUserSSHKey struct {
+	gitlab.SSHKey
+	UsageType  string     `json:"usage_type"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+func dataSourceGitlabUserSSHKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	userID := d.Get("user_id").(int)
+
+	options := &gitlab.ListSSHKeysForUserOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	log.Printf("[DEBUG] list SSH keys of user %d", userID)
+
+	// NOTE: go-gitlab doesn't yet return the usage type and the last usage time of an SSH key, thus we do the raw request.
+	u := fmt.Sprintf("users/%d/keys", userID)
+
+	var keys []*gitlabUserSSHKey
+	for options.Page != 0 {
+		req, err := client.NewRequest(http.MethodGet, u, options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var paginatedKeys []*gitlabUserSSHKey
+		resp, err := client.Do(req, &paginatedKeys)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		keys = append(keys, paginatedKeys...)
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(strconv.Itoa(userID))
+	if err := d.Set("keys", flattenGitlabUserSSHKeys(keys)); err != nil {
+		return diag.Errorf("failed to set keys to state: %v", err)
+	}
+	return nil
+}
+
+func flattenGitlabUserSSHKeys(keys []*gitlabUserSSHKey) (values []map[string]interface{}) {
+	for _, key := range keys {
+		v := map[string]interface{}{
+			"key_id":     key.ID,
+			"title":      key.Title,
+			"key":        key.Key,
+			"usage_type": key.UsageType,
+		}
+		if key.CreatedAt != nil {
+			v["created_at"] = key.CreatedAt.Format(time.RFC3339)
+		}
+		if key.ExpiresAt != nil {
+			v["expires_at"] = key.ExpiresAt.Format(time.RFC3339)
+		}
+		if key.LastUsedAt != nil {
+			v["last_used_at"] = key.LastUsedAt.Format(time.RFC3339)
+		}
+		values = append(values, v)
+	}
+	return values
+}
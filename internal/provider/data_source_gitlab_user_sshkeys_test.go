@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabUserSSHKeys_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testUser := testAccCreateUsers(t, 1)[0]
+	testKey, _, err := testGitlabClient.Users.AddSSHKeyForUser(testUser.ID, &gitlab.AddSSHKeyOptions{
+		Title: gitlab.String("foo-key"),
+		Key:   gitlab.String(testRSAPubKey),
+	})
+	if err != nil {
+		t.Fatalf("failed to add SSH key: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_user_sshkeys" "this" {
+						user_id = %d
+					}
+				`, testUser.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_user_sshkeys.this", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_user_sshkeys.this", "keys.0.key_id", fmt.Sprintf("%d", testKey.ID)),
+					resource.TestCheckResourceAttr("data.gitlab_user_sshkeys.this", "keys.0.title", "foo-key"),
+					resource.TestCheckResourceAttrSet("data.gitlab_user_sshkeys.this", "keys.0.created_at"),
+					resource.TestCheckResourceAttr("data.gitlab_user_sshkeys.this", "keys.0.expires_at", ""),
+				),
+			},
+		},
+	})
+}
@@ -76,6 +76,37 @@ var _ = registerDataSource("gitlab_users", func() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"external": {
+				Description: "Filter users that are external. (Requires administrator privileges)",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"exclude_external": {
+				Description: "Filter out users that are external.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"exclude_internal": {
+				Description: "Filter out internal users, like the ghost or support bot users.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"admins": {
+				Description: "Filter users that are administrators. (Requires administrator privileges)",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"without_project_bots": {
+				Description: "Filter out project bot users, e.g. of project and group access tokens. (Requires administrator privileges)",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"two_factor": {
+				Description:  "Filter users by their two-factor authentication status. Valid values are `enabled` and `disabled`. (Requires administrator privileges)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
 			"users": {
 				Description: "The list of users.",
 				Type:        schema.TypeList,
@@ -364,6 +395,42 @@ func expandGitlabUsersOptions(d *schema.ResourceData) (*gitlab.ListUsersOptions,
 		listUsersOptions.CreatedAfter = &date
 		optionsHash.WriteString(createdAfter)
 	}
+	optionsHash.WriteString(",")
+	if data, ok := d.GetOk("external"); ok {
+		external := data.(bool)
+		listUsersOptions.External = &external
+		optionsHash.WriteString(strconv.FormatBool(external))
+	}
+	optionsHash.WriteString(",")
+	if data, ok := d.GetOk("exclude_external"); ok {
+		excludeExternal := data.(bool)
+		listUsersOptions.ExcludeExternal = &excludeExternal
+		optionsHash.WriteString(strconv.FormatBool(excludeExternal))
+	}
+	optionsHash.WriteString(",")
+	if data, ok := d.GetOk("exclude_internal"); ok {
+		excludeInternal := data.(bool)
+		listUsersOptions.ExcludeInternal = &excludeInternal
+		optionsHash.WriteString(strconv.FormatBool(excludeInternal))
+	}
+	optionsHash.WriteString(",")
+	if data, ok := d.GetOk("admins"); ok {
+		admins := data.(bool)
+		listUsersOptions.Admins = &admins
+		optionsHash.WriteString(strconv.FormatBool(admins))
+	}
+	optionsHash.WriteString(",")
+	if data, ok := d.GetOk("without_project_bots"); ok {
+		withoutProjectBots := data.(bool)
+		listUsersOptions.WithoutProjectBots = &withoutProjectBots
+		optionsHash.WriteString(strconv.FormatBool(withoutProjectBots))
+	}
+	optionsHash.WriteString(",")
+	if data, ok := d.GetOk("two_factor"); ok {
+		twoFactor := data.(string)
+		listUsersOptions.TwoFactor = &twoFactor
+		optionsHash.WriteString(twoFactor)
+	}
 
 	id := schema.HashString(optionsHash.String())
 
@@ -52,6 +52,73 @@ func TestAccDataSourceGitlabUsers_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceGitlabUsers_filters(t *testing.T) {
+	rInt := acctest.RandInt()
+	usersConfig := fmt.Sprintf(`
+resource "gitlab_user" "admin" {
+  name     = "filters-admin-%[1]d"
+  username = "filters-admin-%[1]d"
+  password = "test%[1]dtt"
+  email    = "filters-admin-%[1]d@test.test"
+  is_admin = true
+}
+
+resource "gitlab_user" "external" {
+  name        = "filters-external-%[1]d"
+  username    = "filters-external-%[1]d"
+  password    = "test%[1]dtt"
+  email       = "filters-external-%[1]d@test.test"
+  is_external = true
+}
+`, rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: usersConfig,
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "gitlab_users" "admins" {
+  search = "filters-"
+  admins = true
+}
+
+data "gitlab_users" "external" {
+  search   = "filters-"
+  external = true
+}
+
+data "gitlab_users" "exclude_external" {
+  search               = "filters-"
+  exclude_external     = true
+  exclude_internal     = true
+  without_project_bots = true
+}
+
+data "gitlab_users" "two_factor_disabled" {
+  search     = "filters-"
+  two_factor = "disabled"
+}
+`, usersConfig),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_users.admins", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.gitlab_users.admins", "users.0.id", "gitlab_user.admin", "id"),
+					resource.TestCheckResourceAttr("data.gitlab_users.external", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.gitlab_users.external", "users.0.id", "gitlab_user.external", "id"),
+					resource.TestCheckResourceAttr("data.gitlab_users.exclude_external", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.gitlab_users.exclude_external", "users.0.id", "gitlab_user.admin", "id"),
+					resource.TestCheckResourceAttr("data.gitlab_users.two_factor_disabled", "users.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceGitlabUsersConfig(rInt int, rInt2 int) string {
 	return fmt.Sprintf(`
 resource "gitlab_user" "foo" {
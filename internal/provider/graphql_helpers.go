@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// graphQLClient sends queries and mutations to the GraphQL API of the GitLab instance of the given go-gitlab client.
+// The requests share the HTTP client, authentication, rate limiting and retries of the go-gitlab client.
+// NOTE: go-gitlab doesn't support the GraphQL API, thus we do the raw requests.
+type graphQLClient struct {
+	client *gitlab.Client
+}
+
+func newGraphQLClient(client *gitlab.Client) *graphQLClient {
+	return &graphQLClient{client: client}
+}
+
+// gitlabGraphQLClients holds the *graphQLClient per *gitlab.Client, i.e. per provider configuration,
+// because the provider meta is the go-gitlab client itself. It's populated when the provider is configured.
+var gitlabGraphQLClients sync.Map
+
+func registerGitlabGraphQLClient(client *gitlab.Client) {
+	gitlabGraphQLClients.Store(client, newGraphQLClient(client))
+}
+
+// graphQLClientFor returns the GraphQL client of the provider configuration of the given go-gitlab client.
+func graphQLClientFor(client *gitlab.Client) *graphQLClient {
+	if c, ok := gitlabGraphQLClients.Load(client); ok {
+		return c.(*graphQLClient)
+	}
+	// NOTE: clients which weren't created by the provider configuration, e.g. in tests, get their GraphQL client on first use.
+	c, _ := gitlabGraphQLClients.LoadOrStore(client, newGraphQLClient(client))
+	return c.(*graphQLClient)
+}
+
+// query sends the given GraphQL query and decodes its `data` into the given value.
+// Contrary to mutations, queries are idempotent and thus also retried when GitLab fails to process them.
+func (c *graphQLClient) query(ctx context.Context, query string, variables map[string]interface{}, data interface{}) error {
+	return c.send(withIdempotentRequest(ctx), query, variables, data)
+}
+
+// mutate sends the given GraphQL mutation and decodes its `data` into the given value.
+// An error is returned if any of the mutations returns `errors` in its payload.
+func (c *graphQLClient) mutate(ctx context.Context, mutation string, variables map[string]interface{}, data interface{}) error {
+	var payloads map[string]*struct {
+		Errors []string `json:"errors"`
+	}
+	if err := c.send(ctx, mutation, variables, &graphQLRawData{payloads: &payloads, data: data}); err != nil {
+		return err
+	}
+	for name, payload := range payloads {
+		if payload == nil {
+			continue
+		}
+		if err := graphQLMutationErrors(name, payload.Errors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *graphQLClient) send(ctx context.Context, query string, variables map[string]interface{}, data interface{}) error {
+	req, err := c.client.NewRequest(http.MethodPost, "", &graphQLRequest{Query: query, Variables: variables}, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+	// The GraphQL endpoint is located next to the REST API, e.g. `/api/graphql` for `/api/v4/`.
+	req.URL.Path = path.Join(path.Dir(strings.TrimSuffix(req.URL.Path, "/")), "graphql")
+	req.URL.RawPath = ""
+
+	response := new(graphQLResponse)
+	if _, err := c.client.Do(req, response); err != nil {
+		return err
+	}
+	if len(response.Errors) > 0 {
+		messages := make([]string, 0, len(response.Errors))
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+		return fmt.Errorf("GraphQL request failed: %s", strings.Join(messages, "; "))
+	}
+
+	if data == nil {
+		return nil
+	}
+	return json.Unmarshal(response.Data, data)
+}
+
+// graphQLRawData decodes the `data` of a mutation into both the mutation payloads and the value of the caller.
+type graphQLRawData struct {
+	payloads interface{}
+	data     interface{}
+}
+
+func (d *graphQLRawData) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, d.payloads); err != nil {
+		return err
+	}
+	if d.data == nil {
+		return nil
+	}
+	return json.Unmarshal(b, d.data)
+}
+
+// graphQLMutationErrors returns an error for the `errors` field of a mutation payload, if any.
+func graphQLMutationErrors(mutation string, errors []string) error {
+	if len(errors) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s failed: %s", mutation, strings.Join(errors, "; "))
+}
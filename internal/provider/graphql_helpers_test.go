@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+func testGraphQLClient(t *testing.T, handler http.HandlerFunc) *graphQLClient {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/graphql" {
+			t.Errorf("got request to %s, expected /api/graphql", r.URL.Path)
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	config := Config{
+		Token:                       "token",
+		BaseURL:                     server.URL + "/api/v4/",
+		Retries:                     2,
+		RetryOnlyIdempotentRequests: true,
+	}
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return newGraphQLClient(client)
+}
+
+func TestGraphQLClient_Retries(t *testing.T) {
+	requests := 0
+	graphQL := testGraphQLClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	if err := graphQL.query(context.Background(), `query { currentUser { id } }`, nil, nil); err == nil {
+		t.Fatal("expected the query to fail")
+	}
+	if requests != 3 {
+		t.Fatalf("got %d requests for the query, expected 3", requests)
+	}
+
+	requests = 0
+	if err := graphQL.mutate(context.Background(), `mutation { echoCreate(input: {}) { errors } }`, nil, nil); err == nil {
+		t.Fatal("expected the mutation to fail")
+	}
+	if requests != 1 {
+		t.Fatalf("got %d requests for the mutation, expected 1", requests)
+	}
+}
+
+func TestGraphQLClient_Errors(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Response  string
+		WantError string
+	}{
+		{
+			Name:      "request errors",
+			Response:  `{"data": null, "errors": [{"message": "first"}, {"message": "second"}]}`,
+			WantError: "GraphQL request failed: first; second",
+		},
+		{
+			Name:      "mutation errors",
+			Response:  `{"data": {"echoCreate": {"errors": ["invalid"], "echo": null}}}`,
+			WantError: "echoCreate failed: invalid",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			graphQL := testGraphQLClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, tc.Response)
+			})
+
+			err := graphQL.mutate(context.Background(), `mutation { echoCreate(input: {}) { errors echo } }`, nil, nil)
+			if err == nil || err.Error() != tc.WantError {
+				t.Fatalf("got error %v, expected %q", err, tc.WantError)
+			}
+		})
+	}
+}
+
+func TestGraphQLClient_Mutate(t *testing.T) {
+	graphQL := testGraphQLClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"echoCreate": {"errors": [], "echo": "hello"}}}`)
+	})
+
+	var data struct {
+		EchoCreate struct {
+			Echo string `json:"echo"`
+		} `json:"echoCreate"`
+	}
+	if err := graphQL.mutate(context.Background(), `mutation { echoCreate(input: {}) { errors echo } }`, nil, &data); err != nil {
+		t.Fatalf("failed to send mutation: %v", err)
+	}
+	if data.EchoCreate.Echo != "hello" {
+		t.Fatalf("got echo %q, expected %q", data.EchoCreate.Echo, "hello")
+	}
+}
+
+func TestGraphQLClientFor(t *testing.T) {
+	client, err := gitlab.NewClient("token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	registerGitlabGraphQLClient(client)
+
+	graphQL := graphQLClientFor(client)
+	if graphQL.client != client {
+		t.Fatal("expected the GraphQL client to use the given go-gitlab client")
+	}
+	if graphQLClientFor(client) != graphQL {
+		t.Fatal("expected the GraphQL client to be shared by the provider configuration")
+	}
+}
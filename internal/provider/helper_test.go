@@ -1,8 +1,11 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -25,6 +28,40 @@ func init() {
 	})
 }
 
+// testResourceReadNotFound reads the given resource from a GitLab API which responds with 404 Not Found
+// to every request, e.g. because its project has been deleted out of band, and fails unless the resource
+// is removed from the state.
+func testResourceReadNotFound(t *testing.T, name string, raw map[string]interface{}, id string) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "404 Project Not Found"}`)
+	}))
+	t.Cleanup(server.Close)
+
+	config := Config{
+		Token:   "token",
+		BaseURL: server.URL + "/api/v4/",
+	}
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	r := New("dev")().ResourcesMap[name]
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId(id)
+
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("failed to read %s: %v", name, diags)
+	}
+	if d.Id() != "" {
+		t.Fatalf("expected %s to be removed from the state", name)
+	}
+}
+
 // testAccCompareGitLabAttribute compares an attribute in two ResourceData's for
 // equivalency.
 func testAccCompareGitLabAttribute(attr string, expected, received *schema.ResourceData) error {
@@ -147,7 +184,7 @@ func testAccCreateProjectWithNamespace(t *testing.T, namespaceID int) *gitlab.Pr
 	}
 
 	t.Cleanup(func() {
-		if _, err := testGitlabClient.Projects.DeleteProject(project.ID); err != nil {
+		if _, err := testGitlabClient.Projects.DeleteProject(project.ID, nil); err != nil {
 			t.Fatalf("could not cleanup test project: %v", err)
 		}
 	})
@@ -207,7 +244,7 @@ func testAccCreateGroups(t *testing.T, n int) []*gitlab.Group {
 
 		groupID := groups[i].ID // Needed for closure.
 		t.Cleanup(func() {
-			if _, err := testGitlabClient.Groups.DeleteGroup(groupID); err != nil {
+			if _, err := testGitlabClient.Groups.DeleteGroup(groupID, nil); err != nil {
 				t.Fatalf("could not cleanup test group: %v", err)
 			}
 		})
@@ -216,6 +253,25 @@ func testAccCreateGroups(t *testing.T, n int) []*gitlab.Group {
 	return groups
 }
 
+// testAccCreateDescendantGroup creates a subgroup of the given group.
+// It assumes the parent group will be destroyed at the end of the test and will not cleanup the subgroup.
+func testAccCreateDescendantGroup(t *testing.T, parentID int) *gitlab.Group {
+	t.Helper()
+
+	name := acctest.RandomWithPrefix("acctest-group")
+	group, _, err := testGitlabClient.Groups.CreateGroup(&gitlab.CreateGroupOptions{
+		Name:       gitlab.String(name),
+		Path:       gitlab.String(name),
+		ParentID:   gitlab.Int(parentID),
+		Visibility: gitlab.Visibility(gitlab.PublicVisibility),
+	})
+	if err != nil {
+		t.Fatalf("could not create test subgroup: %v", err)
+	}
+
+	return group
+}
+
 // testAccCreateBranches is a test helper for creating a specified number of branches.
 // It assumes the project will be destroyed at the end of the test and will not cleanup created branches.
 func testAccCreateBranches(t *testing.T, project *gitlab.Project, n int) []*gitlab.Branch {
@@ -237,6 +293,53 @@ func testAccCreateBranches(t *testing.T, project *gitlab.Project, n int) []*gitl
 	return branches
 }
 
+// testAccCreateMergeRequest is a test helper for creating a merge request from a new branch with a single commit.
+// It assumes the project will be destroyed at the end of the test and will not cleanup the merge request.
+func testAccCreateMergeRequest(t *testing.T, project *gitlab.Project, options *gitlab.CreateMergeRequestOptions) *gitlab.MergeRequest {
+	t.Helper()
+
+	branch := testAccCreateBranches(t, project, 1)[0]
+	if _, _, err := testGitlabClient.RepositoryFiles.CreateFile(project.ID, fmt.Sprintf("%s.txt", branch.Name), &gitlab.CreateFileOptions{
+		Branch:        gitlab.String(branch.Name),
+		Content:       gitlab.String(branch.Name),
+		CommitMessage: gitlab.String(fmt.Sprintf("Add %s.txt", branch.Name)),
+	}); err != nil {
+		t.Fatalf("could not create test merge request commit: %v", err)
+	}
+
+	options.SourceBranch = gitlab.String(branch.Name)
+	options.TargetBranch = gitlab.String(project.DefaultBranch)
+	mergeRequest, _, err := testGitlabClient.MergeRequests.CreateMergeRequest(project.ID, options)
+	if err != nil {
+		t.Fatalf("could not create test merge request: %v", err)
+	}
+
+	return mergeRequest
+}
+
+// testAccCreatePipeline is a test helper for creating a pipeline with a build and a test job on the default branch.
+// It assumes the project will be destroyed at the end of the test and will not cleanup the pipeline.
+func testAccCreatePipeline(t *testing.T, project *gitlab.Project) *gitlab.Pipeline {
+	t.Helper()
+
+	if _, _, err := testGitlabClient.RepositoryFiles.CreateFile(project.ID, ".gitlab-ci.yml", &gitlab.CreateFileOptions{
+		Branch:        gitlab.String(project.DefaultBranch),
+		Content:       gitlab.String("build:\n  stage: build\n  script: echo build\ntest:\n  stage: test\n  script: echo test\n"),
+		CommitMessage: gitlab.String("Add .gitlab-ci.yml"),
+	}); err != nil {
+		t.Fatalf("could not create test CI configuration: %v", err)
+	}
+
+	pipeline, _, err := testGitlabClient.Pipelines.CreatePipeline(project.ID, &gitlab.CreatePipelineOptions{
+		Ref: gitlab.String(project.DefaultBranch),
+	})
+	if err != nil {
+		t.Fatalf("could not create test pipeline: %v", err)
+	}
+
+	return pipeline
+}
+
 // testAccCreateProtectedBranches is a test helper for creating a specified number of protected branches.
 // It assumes the project will be destroyed at the end of the test and will not cleanup created branches.
 func testAccCreateProtectedBranches(t *testing.T, project *gitlab.Project, n int) []*gitlab.ProtectedBranch {
@@ -351,7 +454,7 @@ func testAccCreateProjectEnvironment(t *testing.T, projectID int, options *gitla
 
 	t.Cleanup(func() {
 		if projectEnvironment.State != "stopped" {
-			_, err = testGitlabClient.Environments.StopEnvironment(projectID, projectEnvironment.ID)
+			_, _, err = testGitlabClient.Environments.StopEnvironment(projectID, projectEnvironment.ID, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -400,6 +503,97 @@ func testAccCreateGroupVariable(t *testing.T, groupID int) *gitlab.GroupVariable
 	return variable
 }
 
+func testAccCreateProjectHooks(t *testing.T, pid interface{}, n int) []*gitlab.ProjectHook {
+	var hooks []*gitlab.ProjectHook
+	for i := 0; i < n; i++ {
+		hook, _, err := testGitlabClient.Projects.AddProjectHook(pid, &gitlab.AddProjectHookOptions{
+			URL:          gitlab.String(fmt.Sprintf("https://%s.com", acctest.RandomWithPrefix("acctest"))),
+			PushEvents:   gitlab.Bool(true),
+			IssuesEvents: gitlab.Bool(i%2 == 0),
+		})
+		if err != nil {
+			t.Fatalf("could not create test project hook: %v", err)
+		}
+		hooks = append(hooks, hook)
+	}
+
+	return hooks
+}
+
+func testAccCreateReleases(t *testing.T, project *gitlab.Project, n int) []*gitlab.Release {
+	var releases []*gitlab.Release
+	for i := 0; i < n; i++ {
+		release, _, err := testGitlabClient.Releases.CreateRelease(project.ID, &gitlab.CreateReleaseOptions{
+			Name:    gitlab.String(fmt.Sprintf("Release %d", i)),
+			TagName: gitlab.String(fmt.Sprintf("v%d.0.0", i)),
+			Ref:     gitlab.String(project.DefaultBranch),
+			Assets: &gitlab.ReleaseAssetsOptions{
+				Links: []*gitlab.ReleaseAssetLinkOptions{
+					{
+						Name: gitlab.String(fmt.Sprintf("artifact-%d.tar.gz", i)),
+						URL:  gitlab.String(fmt.Sprintf("https://example.com/artifact-%d.tar.gz", i)),
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("could not create test release: %v", err)
+		}
+		releases = append(releases, release)
+	}
+
+	return releases
+}
+
+func testAccCreateProjectMilestones(t *testing.T, pid interface{}, n int) []*gitlab.Milestone {
+	var milestones []*gitlab.Milestone
+	for i := 0; i < n; i++ {
+		milestone, _, err := testGitlabClient.Milestones.CreateMilestone(pid, &gitlab.CreateMilestoneOptions{
+			Title:       gitlab.String(acctest.RandomWithPrefix("acctest")),
+			Description: gitlab.String(fmt.Sprintf("Milestone %d", i)),
+		})
+		if err != nil {
+			t.Fatalf("could not create test project milestone: %v", err)
+		}
+		milestones = append(milestones, milestone)
+	}
+
+	return milestones
+}
+
+func testAccCreateGroupMilestones(t *testing.T, gid interface{}, n int) []*gitlab.GroupMilestone {
+	var milestones []*gitlab.GroupMilestone
+	for i := 0; i < n; i++ {
+		milestone, _, err := testGitlabClient.GroupMilestones.CreateGroupMilestone(gid, &gitlab.CreateGroupMilestoneOptions{
+			Title:       gitlab.String(acctest.RandomWithPrefix("acctest")),
+			Description: gitlab.String(fmt.Sprintf("Milestone %d", i)),
+		})
+		if err != nil {
+			t.Fatalf("could not create test group milestone: %v", err)
+		}
+		milestones = append(milestones, milestone)
+	}
+
+	return milestones
+}
+
+func testAccCreateGroupHooks(t *testing.T, gid interface{}, n int) []*gitlab.GroupHook {
+	var hooks []*gitlab.GroupHook
+	for i := 0; i < n; i++ {
+		hook, _, err := testGitlabClient.Groups.AddGroupHook(gid, &gitlab.AddGroupHookOptions{
+			URL:          gitlab.String(fmt.Sprintf("https://%s.com", acctest.RandomWithPrefix("acctest"))),
+			PushEvents:   gitlab.Bool(true),
+			IssuesEvents: gitlab.Bool(i%2 == 0),
+		})
+		if err != nil {
+			t.Fatalf("could not create test group hook: %v", err)
+		}
+		hooks = append(hooks, hook)
+	}
+
+	return hooks
+}
+
 func testAccCreateInstanceVariable(t *testing.T) *gitlab.InstanceVariable {
 	variable, _, err := testGitlabClient.InstanceVariables.CreateVariable(&gitlab.CreateInstanceVariableOptions{
 		Key:   gitlab.String(fmt.Sprintf("test_key_%d", acctest.RandInt())),
@@ -444,7 +638,7 @@ func testAccGitlabProjectStart(t *testing.T) testAccGitlabProjectContext {
 	}
 
 	t.Cleanup(func() {
-		if _, err := testGitlabClient.Projects.DeleteProject(project.ID); err != nil {
+		if _, err := testGitlabClient.Projects.DeleteProject(project.ID, nil); err != nil {
 			t.Fatalf("could not delete test project: %v", err)
 		}
 	})
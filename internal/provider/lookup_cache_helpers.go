@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// gitlabLookupCache memoizes the resolution of project and group full paths to their numeric IDs
+// during a single run of the provider. Most resources pass the full path to the GitLab API as it is,
+// thus it's only used by the resources and data sources which require the numeric ID, see gitlabProjectID and gitlabGroupID.
+type gitlabLookupCache struct {
+	mu  sync.Mutex
+	ids map[gitlabLookupKey]int
+	// generation is incremented on every invalidation, so that lookups in progress don't repopulate the cache.
+	generation int
+}
+
+type gitlabLookupKey struct {
+	kind string
+	// path is lowercased, because GitLab resolves full paths case-insensitively.
+	path string
+}
+
+// gitlabLookupCaches holds a *gitlabLookupCache per *gitlab.Client, i.e. per provider configuration,
+// because the provider meta is the go-gitlab client itself.
+var gitlabLookupCaches sync.Map
+
+func gitlabLookupCacheFor(client *gitlab.Client) *gitlabLookupCache {
+	cache, _ := gitlabLookupCaches.LoadOrStore(client, &gitlabLookupCache{ids: make(map[gitlabLookupKey]int)})
+	return cache.(*gitlabLookupCache)
+}
+
+// lookup returns the cached ID of the given kind and full path, or resolves and caches it otherwise.
+// NOTE: the lock isn't held during the resolution, thus concurrent lookups of the same path may both resolve it.
+func (c *gitlabLookupCache) lookup(kind string, path string, resolve func() (int, error)) (int, error) {
+	key := gitlabLookupKey{kind: kind, path: strings.ToLower(path)}
+
+	c.mu.Lock()
+	id, ok := c.ids[key]
+	generation := c.generation
+	c.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	id, err := resolve()
+	if err != nil {
+		return 0, err
+	}
+
+	c.mu.Lock()
+	if c.generation == generation {
+		c.ids[key] = id
+	}
+	c.mu.Unlock()
+	return id, nil
+}
+
+// invalidateGitlabLookupCache clears the cache of the given client. It must be called whenever a project or group
+// is deleted or its full path changes, because its former full path may be reused by another project or group.
+func invalidateGitlabLookupCache(client *gitlab.Client) {
+	c := gitlabLookupCacheFor(client)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ids = make(map[gitlabLookupKey]int)
+	c.generation++
+}
+
+// gitlabProjectID returns the numeric ID of the given project ID or full path.
+func gitlabProjectID(ctx context.Context, client *gitlab.Client, project string) (int, error) {
+	if id, err := strconv.Atoi(project); err == nil {
+		return id, nil
+	}
+
+	return gitlabLookupCacheFor(client).lookup("project", project, func() (int, error) {
+		p, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return 0, err
+		}
+		return p.ID, nil
+	})
+}
+
+// gitlabGroupID returns the numeric ID of the given group ID or full path.
+func gitlabGroupID(ctx context.Context, client *gitlab.Client, group string) (int, error) {
+	if id, err := strconv.Atoi(group); err == nil {
+		return id, nil
+	}
+
+	return gitlabLookupCacheFor(client).lookup("group", group, func() (int, error) {
+		g, _, err := client.Groups.GetGroup(group, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return 0, err
+		}
+		return g.ID, nil
+	})
+}
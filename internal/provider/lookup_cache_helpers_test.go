@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGitlabProjectID_Cache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.EscapedPath() != "/api/v4/projects/foo%2Fbar" && r.URL.EscapedPath() != "/api/v4/projects/Foo%2FBar" {
+			t.Errorf("got request to %s, expected /api/v4/projects/foo%%2Fbar", r.URL.EscapedPath())
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 42}`)
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:   "token",
+		BaseURL: server.URL + "/api/v4/",
+	}
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	for _, project := range []string{"foo/bar", "foo/bar", "Foo/Bar", "42"} {
+		id, err := gitlabProjectID(context.Background(), client, project)
+		if err != nil {
+			t.Fatalf("failed to resolve project %s: %v", project, err)
+		}
+		if id != 42 {
+			t.Fatalf("got ID %d for project %s, expected 42", id, project)
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("got %d requests, expected 1", requests)
+	}
+
+	invalidateGitlabLookupCache(client)
+	if _, err := gitlabProjectID(context.Background(), client, "foo/bar"); err != nil {
+		t.Fatalf("failed to resolve project foo/bar: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("got %d requests after invalidating the cache, expected 2", requests)
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var (
@@ -34,9 +37,22 @@ func New(version string) func() *schema.Provider {
 			Schema: map[string]*schema.Schema{
 				"token": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc("GITLAB_TOKEN", nil),
-					Description: "The OAuth2 Token, Project, Group, Personal Access Token or CI Job Token used to connect to GitLab. The OAuth method is used in this provider for authentication (using Bearer authorization token). See https://docs.gitlab.com/ee/api/#authentication for details. It may be sourced from the `GITLAB_TOKEN` environment variable.",
+					Description: "The OAuth2 Token, Project, Group, Personal Access Token or CI Job Token used to connect to GitLab. The OAuth method is used in this provider for authentication (using Bearer authorization token). See https://docs.gitlab.com/ee/api/#authentication for details. It may be sourced from the `GITLAB_TOKEN` environment variable. Required unless the provider authenticates with `oauth_client_id` or `ci_job_token`.",
+				},
+				"token_command": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The command and its arguments to execute to retrieve the token, e.g. `[\"glab\", \"auth\", \"token\"]`. The command is executed whenever the provider is configured and must print the token to its standard output; surrounding whitespace is trimmed. The command is not executed by a shell. Takes precedence over `token`.",
+				},
+				"sudo": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GITLAB_SUDO", ""),
+					Description: "The ID or username of the user to impersonate with every request, using the `Sudo` header. This requires an administrator token with the `sudo` scope. Use multiple provider configurations with an `alias` to manage resources on behalf of different users. It may be sourced from the `GITLAB_SUDO` environment variable.",
 				},
 				"base_url": {
 					Type:        schema.TypeString,
@@ -51,11 +67,30 @@ func New(version string) func() *schema.Provider {
 						return
 					},
 				},
-				"cacert_file": {
+				"headers": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Additional HTTP headers to send with every request to GitLab, e.g. the headers required by a gateway in front of the GitLab instance. They override the headers set by the provider with the same name.",
+				},
+				"audit_log_file": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: "This is a file containing the ca cert to verify the gitlab instance. This is available for use when working with GitLab CE or Gitlab Enterprise with a locally-issued or self-signed certificate chain.",
+					Description: "The path of a file to append an audit log of all requests to GitLab to, e.g. to trace the changes Terraform applied to the instance. Every request, including retries, is logged as a line of JSON with its `time`, `method`, `path`, response `status`, `duration_ms` and `correlation_id`, or the `error` if it failed.",
+				},
+				"cacert_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Default:       "",
+					Description:   "This is a file containing the ca cert to verify the gitlab instance. This is available for use when working with GitLab CE or Gitlab Enterprise with a locally-issued or self-signed certificate chain.",
+					ConflictsWith: []string{"cacert_pem"},
+				},
+				"cacert_pem": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Default:       "",
+					Description:   "The PEM encoded ca cert bundle to verify the gitlab instance, as an alternative to `cacert_file`. This is useful where neither files nor the host trust store can be provided, e.g. on hosted runners.",
+					ConflictsWith: []string{"cacert_file"},
 				},
 				"insecure": {
 					Type:        schema.TypeBool,
@@ -63,17 +98,122 @@ func New(version string) func() *schema.Provider {
 					Default:     false,
 					Description: "When set to true this disables SSL verification of the connection to the GitLab instance.",
 				},
-				"client_cert": {
+				"proxy_url": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "",
+					Description:      "The URL of the proxy to connect to the GitLab instance through, e.g. `http://proxy.example.com:3128`. The `http`, `https` and `socks5` schemes are supported. Defaults to the proxy configured in the `HTTPS_PROXY` and `HTTP_PROXY` environment variables.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithScheme([]string{"http", "https", "socks5"})),
+				},
+				"no_proxy": {
 					Type:        schema.TypeString,
 					Optional:    true,
 					Default:     "",
-					Description: "File path to client certificate when GitLab instance is behind company proxy. File must contain PEM encoded data.",
+					Description: "A comma-separated list of hosts, domains and IP ranges which are connected to directly instead of through the proxy, in the same format as the `NO_PROXY` environment variable. Defaults to the `NO_PROXY` environment variable.",
+				},
+				"client_cert": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					Description:  "File path to client certificate when GitLab instance is behind company proxy enforcing mutual TLS. File must contain PEM encoded data. Alternatively, the PEM encoded certificate itself may be given. Required when `client_key` is set.",
+					RequiredWith: []string{"client_key"},
 				},
 				"client_key": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Default:      "",
+					Description:  "File path to client key when GitLab instance is behind company proxy enforcing mutual TLS. File must contain PEM encoded data. Alternatively, the PEM encoded key itself may be given. Required when `client_cert` is set.",
+					RequiredWith: []string{"client_cert"},
+				},
+				"oauth_client_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("GITLAB_OAUTH_CLIENT_ID", ""),
+					Description: "The ID of the OAuth application used to obtain access tokens. If `oauth_refresh_token` is set, it is used to refresh the access token, e.g. obtained with the device authorization flow. Otherwise, an access token is requested with the client credentials flow, which requires `oauth_client_secret`. It may be sourced from the `GITLAB_OAUTH_CLIENT_ID` environment variable. When set, `token` is ignored.",
+				},
+				"oauth_client_secret": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					DefaultFunc:  schema.EnvDefaultFunc("GITLAB_OAUTH_CLIENT_SECRET", ""),
+					Description:  "The secret of the OAuth application. Required for the client credentials flow and for refreshing tokens of confidential applications. It may be sourced from the `GITLAB_OAUTH_CLIENT_SECRET` environment variable.",
+					RequiredWith: []string{"oauth_client_id"},
+				},
+				"oauth_refresh_token": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					DefaultFunc:  schema.EnvDefaultFunc("GITLAB_OAUTH_REFRESH_TOKEN", ""),
+					Description:  "The OAuth refresh token used to obtain access tokens, e.g. obtained with the device authorization or authorization code flow. A new access token is requested when the provider is configured and whenever it expires. Note that GitLab revokes a refresh token once it has been used. It may be sourced from the `GITLAB_OAUTH_REFRESH_TOKEN` environment variable.",
+					RequiredWith: []string{"oauth_client_id"},
+				},
+				"oauth_scopes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The scopes to request for the access token with the client credentials flow. Defaults to the scopes of the OAuth application.",
+				},
+				"ci_job_token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The CI/CD job token used to connect to GitLab from within a pipeline job, using the `JOB-TOKEN` header. It is only used if neither `token` nor `oauth_client_id` is configured. Because a job token can only access [a few API endpoints](https://docs.gitlab.com/ee/ci/jobs/ci_job_token.html), only the " + renderValueListForDocs(jobTokenSupportedDataSources) + " data sources can be used, all other data sources and resources fail. It must be configured explicitly and is not sourced from the `CI_JOB_TOKEN` environment variable, e.g. with `ci_job_token = var.ci_job_token` and `TF_VAR_ci_job_token: $CI_JOB_TOKEN` in the pipeline job.",
+				},
+				"request_timeout": {
 					Type:        schema.TypeString,
 					Optional:    true,
 					Default:     "",
-					Description: "File path to client key when GitLab instance is behind company proxy. File must contain PEM encoded data. Required when `client_cert` is set.",
+					Description: "The timeout of a single request to GitLab as a duration string, e.g. `30s` or `2m`, including the time to read the response. Defaults to no timeout.",
+					ValidateDiagFunc: func(value interface{}, path cty.Path) diag.Diagnostics {
+						if v := value.(string); v != "" {
+							if _, err := time.ParseDuration(v); err != nil {
+								return diag.Errorf("invalid request timeout %q: %v", v, err)
+							}
+						}
+						return nil
+					},
+				},
+				"retries": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          5,
+					Description:      "The maximum number of times a rate limited (HTTP status `429`) or failed (HTTP status `5xx`) request is retried. Set to `0` to disable retries.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				},
+				"retry_only_idempotent_requests": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Only retry failed requests (HTTP status `5xx`) if they are idempotent, e.g. `GET` requests, because a failed non-idempotent request, e.g. creating a resource, may have been processed by GitLab nonetheless. Rate limited requests are always retried. Defaults to `false`, which retries all failed requests.",
+				},
+				"min_retry_wait_seconds": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Description:      "The minimum time to wait before retrying a request, in seconds. The wait time is doubled on every retry, up to `max_retry_wait_seconds`. Rate limited requests are retried after the time requested by GitLab in the `Retry-After` or `RateLimit-Reset` headers instead. If neither `min_retry_wait_seconds` nor `max_retry_wait_seconds` is set, the wait times of the GitLab client library are used, i.e. less than a second.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+					RequiredWith:     []string{"max_retry_wait_seconds"},
+				},
+				"max_retry_wait_seconds": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Description:      "The maximum time to wait before retrying a request, in seconds. Must be greater than or equal to `min_retry_wait_seconds`.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+					RequiredWith:     []string{"min_retry_wait_seconds"},
+				},
+				"requests_per_second": {
+					Type:             schema.TypeFloat,
+					Optional:         true,
+					Default:          0,
+					Description:      "The maximum number of requests per second sent to GitLab, shared by all concurrent operations of the provider. This may be used to avoid hitting the rate limits of the GitLab instance. Defaults to `0`, which adapts to the rate limit reported by GitLab in the `RateLimit-Limit` header.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.FloatAtLeast(0)),
+				},
+				"requests_burst": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          0,
+					Description:      "The maximum number of requests sent to GitLab at once, before `requests_per_second` is enforced. Defaults to `requests_per_second`, rounded up.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 				},
 				"early_auth_check": {
 					Type:        schema.TypeBool,
@@ -83,8 +223,8 @@ func New(version string) func() *schema.Provider {
 				},
 			},
 
-			DataSourcesMap: resourceFactoriesToMap(allDataSources),
-			ResourcesMap:   resourceFactoriesToMap(allResources),
+			DataSourcesMap: resourceFactoriesToMap(allDataSources, "data source"),
+			ResourcesMap:   resourceFactoriesToMap(allResources, "resource"),
 		}
 
 		provider.ConfigureContextFunc = configure(version, provider)
@@ -99,10 +239,63 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			Token:         d.Get("token").(string),
 			BaseURL:       d.Get("base_url").(string),
 			CACertFile:    d.Get("cacert_file").(string),
+			CACertPEM:     d.Get("cacert_pem").(string),
 			Insecure:      d.Get("insecure").(bool),
+			ProxyURL:      d.Get("proxy_url").(string),
+			NoProxy:       d.Get("no_proxy").(string),
 			ClientCert:    d.Get("client_cert").(string),
 			ClientKey:     d.Get("client_key").(string),
 			EarlyAuthFail: d.Get("early_auth_check").(bool),
+
+			OAuthClientID:     d.Get("oauth_client_id").(string),
+			OAuthClientSecret: d.Get("oauth_client_secret").(string),
+			OAuthRefreshToken: d.Get("oauth_refresh_token").(string),
+			OAuthScopes:       *stringSetToStringSlice(d.Get("oauth_scopes").(*schema.Set)),
+
+			JobToken: d.Get("ci_job_token").(string),
+			Sudo:     d.Get("sudo").(string),
+
+			AuditLogFile: d.Get("audit_log_file").(string),
+
+			Retries:                     d.Get("retries").(int),
+			RetryOnlyIdempotentRequests: d.Get("retry_only_idempotent_requests").(bool),
+			MinRetryWaitSeconds:         d.Get("min_retry_wait_seconds").(int),
+			MaxRetryWaitSeconds:         d.Get("max_retry_wait_seconds").(int),
+
+			RequestsPerSecond: d.Get("requests_per_second").(float64),
+			RequestsBurst:     d.Get("requests_burst").(int),
+		}
+
+		if v, ok := d.GetOk("headers"); ok {
+			config.Headers = make(map[string]string)
+			for name, value := range v.(map[string]interface{}) {
+				config.Headers[name] = value.(string)
+			}
+		}
+
+		if v := d.Get("request_timeout").(string); v != "" {
+			// NOTE: the duration has already been validated.
+			config.RequestTimeout, _ = time.ParseDuration(v)
+		}
+
+		if config.MaxRetryWaitSeconds < config.MinRetryWaitSeconds {
+			return nil, diag.Errorf("`max_retry_wait_seconds` must be greater than or equal to `min_retry_wait_seconds`")
+		}
+
+		if v, ok := d.GetOk("token_command"); ok {
+			var command []string
+			for _, arg := range v.([]interface{}) {
+				command = append(command, arg.(string))
+			}
+			token, err := tokenFromCommand(ctx, command)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			config.Token = token
+		}
+
+		if config.Token == "" && config.OAuthClientID == "" && config.JobToken == "" {
+			return nil, diag.Errorf("one of `token`, `oauth_client_id` or `ci_job_token` must be configured")
 		}
 
 		client, err := config.Client(ctx)
@@ -113,6 +306,11 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		userAgent := p.UserAgent("terraform-provider-gitlab", version)
 		client.UserAgent = userAgent
 
+		registerGitlabGraphQLClient(client)
+		if config.usesJobToken() {
+			registerGitlabJobTokenClient(client)
+		}
+
 		return client, nil
 	}
 }
@@ -139,11 +337,11 @@ func makeRegisterResourceFunc(factories map[string]func() *schema.Resource, reso
 	}
 }
 
-func resourceFactoriesToMap(factories map[string]func() *schema.Resource) map[string]*schema.Resource {
+func resourceFactoriesToMap(factories map[string]func() *schema.Resource, resourceType string) map[string]*schema.Resource {
 	resourcesMap := make(map[string]*schema.Resource)
 
 	for name, fn := range factories {
-		resourcesMap[name] = fn()
+		resourcesMap[name] = guardJobTokenUnsupported(resourceType, name, fn())
 	}
 
 	return resourcesMap
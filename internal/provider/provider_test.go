@@ -26,10 +26,15 @@ var testGitlabConfig = Config{
 	Token:         os.Getenv("GITLAB_TOKEN"),
 	BaseURL:       os.Getenv("GITLAB_BASE_URL"),
 	CACertFile:    "",
+	CACertPEM:     "",
 	Insecure:      false,
+	ProxyURL:      "",
+	NoProxy:       "",
 	ClientCert:    "",
 	ClientKey:     "",
 	EarlyAuthFail: true,
+
+	Retries: 5,
 }
 
 var testGitlabClient *gitlab.Client
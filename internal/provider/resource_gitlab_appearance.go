@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// NOTE: the appearance is a singleton, thus the resource always has the same ID.
+const appearanceID = "gitlab"
+
+// appearanceImageAttributes are the appearance attributes which are uploaded as images.
+var appearanceImageAttributes = []string{"logo", "header_logo", "favicon", "pwa_icon"}
+
+var _ = registerResource("gitlab_appearance", func() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"title": {
+			Description: "Instance title on the sign in / sign up page.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"description": {
+			Description: "Markdown text shown on the sign in / sign up page.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"pwa_name": {
+			Description: "Full name of the Progressive Web App. Used for the attribute `name` in `manifest.json`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"pwa_short_name": {
+			Description: "Short name for the Progressive Web App.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"pwa_description": {
+			Description: "An explanation of what the Progressive Web App does. Used for the attribute `description` in `manifest.json`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"new_project_guidelines": {
+			Description: "Markdown text shown on the new project page.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"profile_image_guidelines": {
+			Description: "Markdown text shown on the profile page below the Public Avatar.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"header_message": {
+			Description: "Message in the system header bar.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"footer_message": {
+			Description: "Message in the system footer bar.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"message_background_color": {
+			Description: "Background color for the system header / footer bar.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"message_font_color": {
+			Description: "Font color for the system header / footer bar.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"email_header_and_footer_enabled": {
+			Description: "Add header and footer to all outgoing emails if enabled.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+	}
+
+	for _, image := range appearanceImageAttributes {
+		s[image] = &schema.Schema{
+			Description: fmt.Sprintf("A local path to the %s image to upload. **Note**: not available for imported resources. Removing this attribute doesn't remove the image from GitLab.", image),
+			Type:        schema.TypeString,
+			Optional:    true,
+		}
+		s[image+"_hash"] = &schema.Schema{
+			Description:  fmt.Sprintf("The hash of the %[1]s image. Use `filesha256(\"path/to/%[1]s.png\")` whenever possible. **Note**: this is used to trigger an update of the %[1]s. If it's not given, but a %[1]s is given, the %[1]s will be updated each time.", image),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			RequiredWith: []string{image},
+		}
+		s[image+"_url"] = &schema.Schema{
+			Description: fmt.Sprintf("The URL of the %s image.", image),
+			Type:        schema.TypeString,
+			Computed:    true,
+		}
+	}
+
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_appearance`" + ` resource allows to manage the appearance of a GitLab instance.
+
+-> This resource requires administration privileges.
+
+~> This resource manages a singleton per GitLab instance. Only the configured attributes are managed, all others are imported as computed values.
+   Destroying this resource does not reset the appearance, it only removes it from the Terraform state.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/appearance.html)`,
+
+		CreateContext: resourceGitlabAppearanceCreate,
+		ReadContext:   resourceGitlabAppearanceRead,
+		UpdateContext: resourceGitlabAppearanceUpdate,
+		DeleteContext: resourceGitlabAppearanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: s,
+		CustomizeDiff: func(ctx context.Context, rd *schema.ResourceDiff, i interface{}) error {
+			for _, image := range appearanceImageAttributes {
+				if _, ok := rd.GetOk(image); ok {
+					if v, ok := rd.GetOk(image + "_hash"); !ok || v.(string) == "" {
+						if err := rd.SetNewComputed(image + "_hash"); err != nil {
+							return err
+						}
+					}
+				}
+			}
+			return nil
+		},
+	}
+})
+
+func resourceGitlabAppearanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	rawConfig := d.GetRawConfig()
+	options := &gitlab.ChangeAppearanceOptions{}
+	for name, option := range resourceGitlabAppearanceStringOptions(options) {
+		if !rawConfig.GetAttr(name).IsNull() {
+			*option = gitlab.String(d.Get(name).(string))
+		}
+	}
+	if !rawConfig.GetAttr("email_header_and_footer_enabled").IsNull() {
+		options.EmailHeaderAndFooterEnabled = gitlab.Bool(d.Get("email_header_and_footer_enabled").(bool))
+	}
+
+	log.Printf("[DEBUG] create gitlab appearance")
+
+	if _, _, err := client.Appearance.ChangeAppearance(options, gitlab.WithContext(ctx)); err != nil {
+		return diag.Errorf("Failed to change appearance: %s", err)
+	}
+
+	for _, image := range appearanceImageAttributes {
+		if v, ok := d.GetOk(image); ok {
+			if err := resourceGitlabAppearanceUploadImage(ctx, client, image, v.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	d.SetId(appearanceID)
+	return resourceGitlabAppearanceRead(ctx, d, meta)
+}
+
+func resourceGitlabAppearanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] read gitlab appearance")
+
+	appearance, _, err := client.Appearance.GetAppearance(gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("Failed to read appearance: %s", err)
+	}
+
+	d.Set("title", appearance.Title)
+	d.Set("description", appearance.Description)
+	d.Set("pwa_name", appearance.PWAName)
+	d.Set("pwa_short_name", appearance.PWAShortName)
+	d.Set("pwa_description", appearance.PWADescription)
+	d.Set("new_project_guidelines", appearance.NewProjectGuidelines)
+	d.Set("profile_image_guidelines", appearance.ProfileImageGuidelines)
+	d.Set("header_message", appearance.HeaderMessage)
+	d.Set("footer_message", appearance.FooterMessage)
+	d.Set("message_background_color", appearance.MessageBackgroundColor)
+	d.Set("message_font_color", appearance.MessageFontColor)
+	d.Set("email_header_and_footer_enabled", appearance.EmailHeaderAndFooterEnabled)
+	d.Set("logo_url", appearance.Logo)
+	d.Set("header_logo_url", appearance.HeaderLogo)
+	d.Set("favicon_url", appearance.Favicon)
+	d.Set("pwa_icon_url", appearance.PWAIcon)
+	return nil
+}
+
+func resourceGitlabAppearanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	options := &gitlab.ChangeAppearanceOptions{}
+	hasChanges := false
+	for name, option := range resourceGitlabAppearanceStringOptions(options) {
+		if d.HasChange(name) {
+			*option = gitlab.String(d.Get(name).(string))
+			hasChanges = true
+		}
+	}
+	if d.HasChange("email_header_and_footer_enabled") {
+		options.EmailHeaderAndFooterEnabled = gitlab.Bool(d.Get("email_header_and_footer_enabled").(bool))
+		hasChanges = true
+	}
+
+	if hasChanges {
+		log.Printf("[DEBUG] update gitlab appearance")
+
+		if _, _, err := client.Appearance.ChangeAppearance(options, gitlab.WithContext(ctx)); err != nil {
+			return diag.Errorf("Failed to change appearance: %s", err)
+		}
+	}
+
+	for _, image := range appearanceImageAttributes {
+		imagePath := d.Get(image).(string)
+		if imagePath == "" {
+			// NOTE: the API doesn't support removing images, thus we only remove it from the state.
+			d.Set(image+"_hash", "")
+			continue
+		}
+		if d.HasChanges(image, image+"_hash") || d.Get(image+"_hash").(string) == "" {
+			if err := resourceGitlabAppearanceUploadImage(ctx, client, image, imagePath); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceGitlabAppearanceRead(ctx, d, meta)
+}
+
+func resourceGitlabAppearanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] gitlab appearance cannot be deleted, only removing it from the state")
+	return nil
+}
+
+// resourceGitlabAppearanceStringOptions maps the string attributes to their fields in the given options.
+func resourceGitlabAppearanceStringOptions(options *gitlab.ChangeAppearanceOptions) map[string]**string {
+	return map[string]**string{
+		"title":                    &options.Title,
+		"description":              &options.Description,
+		"pwa_name":                 &options.PWAName,
+		"pwa_short_name":           &options.PWAShortName,
+		"pwa_description":          &options.PWADescription,
+		"new_project_guidelines":   &options.NewProjectGuidelines,
+		"profile_image_guidelines": &options.ProfileImageGuidelines,
+		"header_message":           &options.HeaderMessage,
+		"footer_message":           &options.FooterMessage,
+		"message_background_color": &options.MessageBackgroundColor,
+		"message_font_color":       &options.MessageFontColor,
+	}
+}
+
+// resourceGitlabAppearanceUploadImage uploads the image at the given path as the given appearance attribute.
+// NOTE: go-gitlab only supports the images as plain strings, thus we do the raw multipart request.
+func resourceGitlabAppearanceUploadImage(ctx context.Context, client *gitlab.Client, attribute string, imagePath string) error {
+	imageFile, err := os.Open(imagePath)
+	if err != nil {
+		return fmt.Errorf("Unable to open %s file %s: %s", attribute, imagePath, err)
+	}
+	defer imageFile.Close()
+
+	log.Printf("[DEBUG] upload gitlab appearance %s from %s", attribute, imagePath)
+
+	req, err := client.UploadRequest(http.MethodPut, "application/appearance", imageFile, filepath.Base(imagePath), gitlab.UploadType(attribute), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Do(req, nil); err != nil {
+		return fmt.Errorf("Failed to upload appearance %s: %s", attribute, err)
+	}
+	return nil
+}
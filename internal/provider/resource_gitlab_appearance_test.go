@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabAppearance_basic(t *testing.T) {
+	testAccCheck(t)
+
+	// lintignore: AT001 // Appearance cannot be destroyed, thus we restore it after the test.
+	appearance, _, err := testGitlabClient.Appearance.GetAppearance()
+	if err != nil {
+		t.Fatalf("failed to get appearance: %v", err)
+	}
+	t.Cleanup(func() {
+		options := &gitlab.ChangeAppearanceOptions{
+			Title:                gitlab.String(appearance.Title),
+			Description:          gitlab.String(appearance.Description),
+			HeaderMessage:        gitlab.String(appearance.HeaderMessage),
+			FooterMessage:        gitlab.String(appearance.FooterMessage),
+			NewProjectGuidelines: gitlab.String(appearance.NewProjectGuidelines),
+		}
+		if _, _, err := testGitlabClient.Appearance.ChangeAppearance(options); err != nil {
+			t.Fatalf("failed to restore appearance: %v", err)
+		}
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Verify empty appearance
+			{
+				Config: `
+					resource "gitlab_appearance" "this" {}
+				`,
+				Check: resource.TestCheckResourceAttr("gitlab_appearance.this", "id", "gitlab"),
+			},
+			// Verify changing the appearance
+			{
+				Config: `
+					resource "gitlab_appearance" "this" {
+						title                  = "Terraform"
+						description            = "Managed by Terraform"
+						header_message         = "Header message of the day"
+						footer_message         = "Footer message of the day"
+						new_project_guidelines = "Follow the guidelines"
+						logo                   = "testdata/gitlab_appearance/logo.png"
+						logo_hash              = filesha256("testdata/gitlab_appearance/logo.png")
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_appearance.this", "title", "Terraform"),
+					resource.TestCheckResourceAttr("gitlab_appearance.this", "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("gitlab_appearance.this", "header_message", "Header message of the day"),
+					resource.TestCheckResourceAttr("gitlab_appearance.this", "footer_message", "Footer message of the day"),
+					resource.TestCheckResourceAttr("gitlab_appearance.this", "new_project_guidelines", "Follow the guidelines"),
+					resource.TestCheckResourceAttrSet("gitlab_appearance.this", "logo_url"),
+					resource.TestCheckResourceAttr("gitlab_appearance.this", "logo_hash", "8d29d9c393facb9d86314eb347a03fde503f2c0422bf55af7df086deb126107e"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_appearance.this",
+				ImportState:       true,
+				ImportStateId:     "gitlab",
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"logo", "logo_hash",
+				},
+			},
+		},
+	})
+}
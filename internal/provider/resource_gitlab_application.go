@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var validApplicationScopes = []string{
+	"api",
+	"read_api",
+	"read_user",
+	"create_runner",
+	"manage_runner",
+	"k8s_proxy",
+	"read_repository",
+	"write_repository",
+	"read_registry",
+	"write_registry",
+	"sudo",
+	"admin_mode",
+	"openid",
+	"profile",
+	"email",
+	"read_observability",
+	"write_observability",
+	"ai_features",
+}
+
+var _ = registerResource("gitlab_application", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_application`" + ` resource allows to manage the lifecycle of an instance-wide OAuth application.
+
+-> This resource requires administration privileges.
+
+~> The ` + "`secret`" + ` is only available in the state of resources created or renewed by Terraform, it's not available for imported resources.
+
+~> The GitLab API doesn't return the ` + "`scopes`" + ` of an application, thus they are only stored in the state: changes of the scopes made outside of Terraform aren't detected, and imported resources are replaced unless their ` + "`scopes`" + ` are ignored with the ` + "`ignore_changes`" + ` lifecycle.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/applications.html)`,
+
+		CreateContext: resourceGitlabApplicationCreate,
+		ReadContext:   resourceGitlabApplicationRead,
+		UpdateContext: resourceGitlabApplicationUpdate,
+		DeleteContext: resourceGitlabApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name of the application.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"redirect_uri": {
+				Description:      "The URL gitlab should send the user to after authentication.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithScheme([]string{"http", "https"})),
+			},
+			"scopes": {
+				Description: fmt.Sprintf("Scopes of the application. The GitLab API doesn't return the scopes, thus changes made outside of Terraform aren't detected and they are not available for imported resources. Valid values are: %s.", renderValueListForDocs(validApplicationScopes)),
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(validApplicationScopes, false),
+				},
+			},
+			"confidential": {
+				Description: "The application is used where the client secret can be kept confidential. Native mobile apps and Single Page Apps are considered non-confidential. Defaults to true if not supplied.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+			},
+			"secret_keepers": {
+				Description: "Arbitrary map of values that, when changed, will renew the application secret in-place. Requires GitLab 16.11.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"application_id": {
+				Description: "Internal name of the application.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"secret": {
+				Description: "Application secret. Sensitive and must be kept secret. This is only populated when creating or renewing the secret of the application, it's not available for imported resources.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+})
+
+func resourceGitlabApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	options := &gitlab.CreateApplicationOptions{
+		Name:         gitlab.String(d.Get("name").(string)),
+		RedirectURI:  gitlab.String(d.Get("redirect_uri").(string)),
+		Scopes:       gitlab.String(strings.Join(*stringSetToStringSlice(d.Get("scopes").(*schema.Set)), " ")),
+		Confidential: gitlab.Bool(d.Get("confidential").(bool)),
+	}
+
+	log.Printf("[DEBUG] create gitlab application %q", *options.Name)
+
+	application, _, err := client.Applications.CreateApplication(options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", application.ID))
+	d.Set("secret", application.Secret)
+	return resourceGitlabApplicationRead(ctx, d, meta)
+}
+
+func resourceGitlabApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	applicationID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] read gitlab application %d", applicationID)
+
+	// NOTE: there is no API to get a single application, thus we have to search through all of them.
+	options := &gitlab.ListApplicationsOptions{
+		Page:    1,
+		PerPage: 100,
+	}
+
+	var application *gitlab.Application
+	for options.Page != 0 && application == nil {
+		applications, resp, err := client.Applications.ListApplications(options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, a := range applications {
+			if a.ID == applicationID {
+				application = a
+				break
+			}
+		}
+
+		options.Page = resp.NextPage
+	}
+
+	if application == nil {
+		log.Printf("[DEBUG] gitlab application not found %d, removing from state", applicationID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", application.ApplicationName)
+	d.Set("redirect_uri", application.CallbackURL)
+	d.Set("confidential", application.Confidential)
+	d.Set("application_id", application.ApplicationID)
+	return nil
+}
+
+func resourceGitlabApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	applicationID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// NOTE: the `secret_keepers` are the only updatable attribute.
+	if d.HasChange("secret_keepers") {
+		log.Printf("[DEBUG] renew secret of gitlab application %d", applicationID)
+
+		application, err := resourceGitlabApplicationRenewSecret(ctx, client, applicationID)
+		if err != nil {
+			return diag.Errorf("failed to renew secret of application %d: %v", applicationID, err)
+		}
+		d.Set("secret", application.Secret)
+	}
+
+	return resourceGitlabApplicationRead(ctx, d, meta)
+}
+
+func resourceGitlabApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	applicationID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] Delete gitlab application %d", applicationID)
+
+	if _, err := client.Applications.DeleteApplication(applicationID, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceGitlabApplicationRenewSecret renews the secret of an application.
+// NOTE: the ApplicationsService of go-gitlab lacks the renew-secret endpoint, which was added in GitLab 16.11.
+func resourceGitlabApplicationRenewSecret(ctx context.Context, client *gitlab.Client, applicationID int) (*gitlab.Application, error) {
+	u := fmt.Sprintf("applications/%d/renew-secret", applicationID)
+	req, err := client.NewRequest(http.MethodPost, u, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	application := new(gitlab.Application)
+	if _, err := client.Do(req, application); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// NOTE: the application settings are a singleton, thus the resource always has the same ID.
+const applicationSettingsID = "gitlab"
+
+var _ = registerResource("gitlab_application_settings", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_application_settings`" + ` resource allows to manage the GitLab application settings.
+
+-> This resource requires administration privileges.
+
+-> Only a selection of the application settings is supported: sign-up and sign-in restrictions, terms of service, outbound requests, rate limits and protected paths, the defaults of new projects, groups and snippets, imports and exports, CI/CD defaults, and a few general settings like ` + "`gravatar_enabled`" + ` or ` + "`housekeeping_enabled`" + `.
+   Deliberately left out are settings containing secrets, e.g. ` + "`akismet_api_key`" + `, ` + "`recaptcha_private_key`" + ` or ` + "`eks_secret_access_key`" + `, so that they aren't stored in the Terraform state,
+   and the settings of integrations with external services, e.g. Elasticsearch, Sentry, Snowplow, Kroki, PlantUML or Gitpod.
+
+~> This resource manages a singleton per GitLab instance. Only the configured attributes are managed, all others are imported as computed values.
+   Destroying this resource does not reset the application settings, it only removes them from the Terraform state.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/settings.html)`,
+
+		CreateContext: resourceGitlabApplicationSettingsCreate,
+		ReadContext:   resourceGitlabApplicationSettingsRead,
+		UpdateContext: resourceGitlabApplicationSettingsUpdate,
+		DeleteContext: resourceGitlabApplicationSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: gitlabApplicationSettingsGetSchema(),
+	}
+})
+
+func resourceGitlabApplicationSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	rawConfig := d.GetRawConfig()
+	options := make(map[string]interface{})
+	for name, s := range gitlabApplicationSettingsGetSchema() {
+		if rawConfig.GetAttr(name).IsNull() {
+			continue
+		}
+		options[name] = applicationSettingsValueFromState(s, d.Get(name))
+	}
+
+	log.Printf("[DEBUG] create gitlab application settings with %d attributes", len(options))
+
+	if len(options) > 0 {
+		if _, err := updateApplicationSettings(ctx, client, options); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(applicationSettingsID)
+	return resourceGitlabApplicationSettingsRead(ctx, d, meta)
+}
+
+func resourceGitlabApplicationSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] read gitlab application settings")
+
+	settings, err := getApplicationSettings(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setApplicationSettingsToState(d, settings); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabApplicationSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	options := make(map[string]interface{})
+	for name, s := range gitlabApplicationSettingsGetSchema() {
+		if d.HasChange(name) {
+			options[name] = applicationSettingsValueFromState(s, d.Get(name))
+		}
+	}
+
+	log.Printf("[DEBUG] update gitlab application settings with %d changed attributes", len(options))
+
+	if len(options) > 0 {
+		if _, err := updateApplicationSettings(ctx, client, options); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGitlabApplicationSettingsRead(ctx, d, meta)
+}
+
+func resourceGitlabApplicationSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] gitlab application settings cannot be deleted, only removing them from the state")
+	return nil
+}
+
+// getApplicationSettings returns the raw application settings.
+// The raw settings are used instead of `gitlab.Settings`, because the schema attributes
+// map 1:1 to the API keys, which also covers attributes not (yet) supported by go-gitlab.
+func getApplicationSettings(ctx context.Context, client *gitlab.Client) (map[string]interface{}, error) {
+	req, err := client.NewRequest(http.MethodGet, "application/settings", nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make(map[string]interface{})
+	if _, err := client.Do(req, &settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// updateApplicationSettings updates the given raw application settings.
+func updateApplicationSettings(ctx context.Context, client *gitlab.Client, options map[string]interface{}) (map[string]interface{}, error) {
+	req, err := client.NewRequest(http.MethodPut, "application/settings", options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make(map[string]interface{})
+	if _, err := client.Do(req, &settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func setApplicationSettingsToState(d *schema.ResourceData, settings map[string]interface{}) error {
+	stateMap := make(map[string]interface{})
+	for name, s := range gitlabApplicationSettingsGetSchema() {
+		v, ok := settings[name]
+		if !ok {
+			// NOTE: the setting is not available in this GitLab version or edition.
+			continue
+		}
+		stateMap[name] = applicationSettingsValueToState(s, v)
+	}
+
+	return setStateMapInResourceData(stateMap, d)
+}
+
+// applicationSettingsValueToState converts a decoded JSON value of the application settings API to a state value.
+func applicationSettingsValueToState(s *schema.Schema, v interface{}) interface{} {
+	switch s.Type {
+	case schema.TypeBool:
+		b, _ := v.(bool)
+		return b
+	case schema.TypeInt:
+		// NOTE: JSON numbers are decoded as float64.
+		f, _ := v.(float64)
+		return int(f)
+	case schema.TypeString:
+		str, _ := v.(string)
+		return str
+	case schema.TypeList:
+		values, _ := v.([]interface{})
+		result := make([]string, 0, len(values))
+		for _, value := range values {
+			result = append(result, fmt.Sprintf("%v", value))
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// applicationSettingsValueFromState converts a state value to a value for the application settings API.
+func applicationSettingsValueFromState(s *schema.Schema, v interface{}) interface{} {
+	if s.Type != schema.TypeList {
+		return v
+	}
+
+	values := v.([]interface{})
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != nil {
+			result = append(result, value.(string))
+		}
+	}
+	return result
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGitlabApplicationSettings_basic(t *testing.T) {
+	testAccCheck(t)
+
+	// lintignore: AT001 // Application Settings cannot be destroyed, thus we restore them after the test.
+	settings, err := getApplicationSettings(context.Background(), testGitlabClient)
+	if err != nil {
+		t.Fatalf("failed to get application settings: %v", err)
+	}
+	t.Cleanup(func() {
+		restore := make(map[string]interface{})
+		for _, name := range []string{"after_sign_out_path", "help_page_text", "gravatar_enabled", "max_attachment_size", "protected_paths", "default_branch_name", "issues_create_limit", "minimum_password_length"} {
+			restore[name] = settings[name]
+		}
+		if _, err := updateApplicationSettings(context.Background(), testGitlabClient, restore); err != nil {
+			t.Fatalf("failed to restore application settings: %v", err)
+		}
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Verify empty application settings
+			{
+				Config: `
+					resource "gitlab_application_settings" "this" {}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "id", "gitlab"),
+					resource.TestCheckResourceAttrSet("gitlab_application_settings.this", "default_branch_name"),
+				),
+			},
+			// Verify changing some application settings
+			{
+				Config: `
+					resource "gitlab_application_settings" "this" {
+						after_sign_out_path     = "https://example.com/signed-out"
+						help_page_text          = "Managed by Terraform"
+						gravatar_enabled        = false
+						max_attachment_size     = 42
+						protected_paths         = ["/users/sign_in", "/users/password"]
+						default_branch_name     = "trunk"
+						issues_create_limit     = 100
+						minimum_password_length = 12
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "after_sign_out_path", "https://example.com/signed-out"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "help_page_text", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "gravatar_enabled", "false"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "max_attachment_size", "42"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "protected_paths.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "default_branch_name", "trunk"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "issues_create_limit", "100"),
+					resource.TestCheckResourceAttr("gitlab_application_settings.this", "minimum_password_length", "12"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_application_settings.this",
+				ImportState:       true,
+				ImportStateId:     "gitlab",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
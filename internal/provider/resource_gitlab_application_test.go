@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabApplication_basic(t *testing.T) {
+	var application gitlab.Application
+	var initialSecret string
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabApplicationDestroy,
+		Steps: []resource.TestStep{
+			// Create an application
+			{
+				Config: testAccGitlabApplicationConfig(rInt, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabApplicationExists("gitlab_application.this", &application),
+					resource.TestCheckResourceAttr("gitlab_application.this", "name", fmt.Sprintf("app-%d", rInt)),
+					resource.TestCheckResourceAttr("gitlab_application.this", "confidential", "true"),
+					resource.TestCheckResourceAttrSet("gitlab_application.this", "application_id"),
+					resource.TestCheckResourceAttrSet("gitlab_application.this", "secret"),
+					func(s *terraform.State) error {
+						initialSecret = s.RootModule().Resources["gitlab_application.this"].Primary.Attributes["secret"]
+						return nil
+					},
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_application.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"scopes", "secret", "secret_keepers"},
+			},
+			// Renew the secret
+			{
+				SkipFunc: isGitLabVersionLessThan(context.Background(), testGitlabClient, "16.11"),
+				Config:   testAccGitlabApplicationConfig(rInt, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabApplicationExists("gitlab_application.this", &application),
+					resource.TestCheckResourceAttrSet("gitlab_application.this", "secret"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["gitlab_application.this"].Primary.Attributes["secret"] == initialSecret {
+							return fmt.Errorf("expected application secret to be renewed")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabApplicationExists(n string, application *gitlab.Application) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		gotApplication, err := testAccGitlabApplicationGet(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if gotApplication == nil {
+			return fmt.Errorf("application %s does not exist", rs.Primary.ID)
+		}
+		*application = *gotApplication
+		return nil
+	}
+}
+
+func testAccCheckGitlabApplicationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_application" {
+			continue
+		}
+
+		gotApplication, err := testAccGitlabApplicationGet(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if gotApplication != nil {
+			return fmt.Errorf("application %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccGitlabApplicationGet(id string) (*gitlab.Application, error) {
+	applicationID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, err
+	}
+
+	applications, _, err := testGitlabClient.Applications.ListApplications(&gitlab.ListApplicationsOptions{PerPage: 100})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range applications {
+		if a.ID == applicationID {
+			return a, nil
+		}
+	}
+	return nil, nil
+}
+
+func testAccGitlabApplicationConfig(rInt int, secretKeeper string) string {
+	return fmt.Sprintf(`
+resource "gitlab_application" "this" {
+  name         = "app-%d"
+  redirect_uri = "https://example.com/oauth/callback"
+  scopes       = ["openid", "read_user"]
+
+  secret_keepers = {
+    rotation = %q
+  }
+}
+`, rInt, secretKeeper)
+}
@@ -250,7 +250,7 @@ func resourceGitlabBranchProtectionUpdate(ctx context.Context, d *schema.Resourc
 	if _, err := client.ProtectedBranches.RequireCodeOwnerApprovals(project, branch, options, gitlab.WithContext(ctx)); err != nil {
 		// The user might be running a version of GitLab that does not support this feature.
 		// We enhance the generic 404 error with a more informative message.
-		if errResponse, ok := err.(*gitlab.ErrorResponse); ok && errResponse.Response.StatusCode == 404 {
+		if is404(err) {
 			return diag.Errorf("feature unavailable: code owner approvals: %v", err)
 		}
 
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var validBroadcastMessageTypes = []string{"banner", "notification"}
+
+var validBroadcastMessageTargetAccessLevelNames = []string{
+	"guest", "reporter", "developer", "maintainer", "owner",
+}
+
+var _ = registerResource("gitlab_broadcast_message", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_broadcast_message`" + ` resource allows to manage the lifecycle of a broadcast message.
+
+-> This resource requires administration privileges.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/broadcast_messages.html)`,
+
+		CreateContext: resourceGitlabBroadcastMessageCreate,
+		ReadContext:   resourceGitlabBroadcastMessageRead,
+		UpdateContext: resourceGitlabBroadcastMessageUpdate,
+		DeleteContext: resourceGitlabBroadcastMessageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"message": {
+				Description: "The message to display.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"starts_at": {
+				Description:      "Starting time of the message, RFC3339 format. Defaults to the current time.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: expiresAtSuppressFunc,
+			},
+			"ends_at": {
+				Description:      "Ending time of the message, RFC3339 format. Defaults to one hour from the current time.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: expiresAtSuppressFunc,
+			},
+			"color": {
+				Description: "Background color hex code of the message. Removed in GitLab 15.6 in favor of themes.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"font": {
+				Description: "Foreground color hex code of the message.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"target_access_levels": {
+				Description: fmt.Sprintf("Target access levels (roles) of the message. Valid values are: %s.", renderValueListForDocs(validBroadcastMessageTargetAccessLevelNames)),
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(validBroadcastMessageTargetAccessLevelNames, false),
+				},
+			},
+			"target_path": {
+				Description: "Target path of the message, e.g. `*/welcome`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"broadcast_type": {
+				Description:  fmt.Sprintf("Appearance type of the message. Valid values are: %s. Defaults to `banner`.", renderValueListForDocs(validBroadcastMessageTypes)),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(validBroadcastMessageTypes, false),
+			},
+			"dismissable": {
+				Description: "Whether users can dismiss the message.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"active": {
+				Description: "Whether the message is currently active.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabBroadcastMessageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	options := &gitlab.CreateBroadcastMessageOptions{
+		Message:            gitlab.String(d.Get("message").(string)),
+		TargetAccessLevels: expandGitlabBroadcastMessageTargetAccessLevels(d.Get("target_access_levels").(*schema.Set)),
+		Dismissable:        gitlab.Bool(d.Get("dismissable").(bool)),
+	}
+
+	if v, ok := d.GetOk("starts_at"); ok {
+		startsAt, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.Errorf("Invalid starts_at date: %v", err)
+		}
+		options.StartsAt = &startsAt
+	}
+	if v, ok := d.GetOk("ends_at"); ok {
+		endsAt, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return diag.Errorf("Invalid ends_at date: %v", err)
+		}
+		options.EndsAt = &endsAt
+	}
+	if v, ok := d.GetOk("color"); ok {
+		options.Color = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("font"); ok {
+		options.Font = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("target_path"); ok {
+		options.TargetPath = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("broadcast_type"); ok {
+		options.BroadcastType = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create gitlab broadcast message")
+
+	message, _, err := client.BroadcastMessage.CreateBroadcastMessage(options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", message.ID))
+	return resourceGitlabBroadcastMessageRead(ctx, d, meta)
+}
+
+func resourceGitlabBroadcastMessageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	messageID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] read gitlab broadcast message %d", messageID)
+
+	message, _, err := client.BroadcastMessage.GetBroadcastMessage(messageID, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab broadcast message %d not found, removing from state", messageID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("message", message.Message)
+	if message.StartsAt != nil {
+		d.Set("starts_at", message.StartsAt.Format(time.RFC3339))
+	}
+	if message.EndsAt != nil {
+		d.Set("ends_at", message.EndsAt.Format(time.RFC3339))
+	}
+	d.Set("color", message.Color)
+	d.Set("font", message.Font)
+	d.Set("target_access_levels", flattenGitlabBroadcastMessageTargetAccessLevels(message.TargetAccessLevels))
+	d.Set("target_path", message.TargetPath)
+	d.Set("broadcast_type", message.BroadcastType)
+	d.Set("dismissable", message.Dismissable)
+	d.Set("active", message.Active)
+	return nil
+}
+
+func resourceGitlabBroadcastMessageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	messageID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &gitlab.UpdateBroadcastMessageOptions{}
+
+	if d.HasChange("message") {
+		options.Message = gitlab.String(d.Get("message").(string))
+	}
+	if d.HasChange("starts_at") {
+		startsAt, err := time.Parse(time.RFC3339, d.Get("starts_at").(string))
+		if err != nil {
+			return diag.Errorf("Invalid starts_at date: %v", err)
+		}
+		options.StartsAt = &startsAt
+	}
+	if d.HasChange("ends_at") {
+		endsAt, err := time.Parse(time.RFC3339, d.Get("ends_at").(string))
+		if err != nil {
+			return diag.Errorf("Invalid ends_at date: %v", err)
+		}
+		options.EndsAt = &endsAt
+	}
+	if d.HasChange("color") {
+		options.Color = gitlab.String(d.Get("color").(string))
+	}
+	if d.HasChange("font") {
+		options.Font = gitlab.String(d.Get("font").(string))
+	}
+	if d.HasChange("target_access_levels") {
+		options.TargetAccessLevels = expandGitlabBroadcastMessageTargetAccessLevels(d.Get("target_access_levels").(*schema.Set))
+	}
+	if d.HasChange("target_path") {
+		options.TargetPath = gitlab.String(d.Get("target_path").(string))
+	}
+	if d.HasChange("broadcast_type") {
+		options.BroadcastType = gitlab.String(d.Get("broadcast_type").(string))
+	}
+	if d.HasChange("dismissable") {
+		options.Dismissable = gitlab.Bool(d.Get("dismissable").(bool))
+	}
+
+	log.Printf("[DEBUG] update gitlab broadcast message %d", messageID)
+
+	if _, _, err := client.BroadcastMessage.UpdateBroadcastMessage(messageID, options, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabBroadcastMessageRead(ctx, d, meta)
+}
+
+func resourceGitlabBroadcastMessageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	messageID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] delete gitlab broadcast message %d", messageID)
+
+	if _, err := client.BroadcastMessage.DeleteBroadcastMessage(messageID, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandGitlabBroadcastMessageTargetAccessLevels(levels *schema.Set) []gitlab.AccessLevelValue {
+	result := []gitlab.AccessLevelValue{}
+	for _, level := range levels.List() {
+		result = append(result, accessLevelNameToValue[level.(string)])
+	}
+	return result
+}
+
+func flattenGitlabBroadcastMessageTargetAccessLevels(levels []gitlab.AccessLevelValue) []string {
+	result := make([]string, 0, len(levels))
+	for _, level := range levels {
+		result = append(result, accessLevelValueToName[level])
+	}
+	return result
+}
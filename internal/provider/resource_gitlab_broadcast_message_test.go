@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabBroadcastMessage_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabBroadcastMessageDestroy,
+		Steps: []resource.TestStep{
+			// Create a broadcast message with default options
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_broadcast_message" "this" {
+						message = "Message %d"
+					}
+				`, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_broadcast_message.this", "message", fmt.Sprintf("Message %d", rInt)),
+					resource.TestCheckResourceAttr("gitlab_broadcast_message.this", "broadcast_type", "banner"),
+					resource.TestCheckResourceAttrSet("gitlab_broadcast_message.this", "starts_at"),
+					resource.TestCheckResourceAttrSet("gitlab_broadcast_message.this", "ends_at"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_broadcast_message.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the broadcast message
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_broadcast_message" "this" {
+						message              = "Updated message %d"
+						starts_at            = "2022-01-01T00:00:00Z"
+						ends_at              = "2099-01-01T00:00:00Z"
+						font                 = "#ffffff"
+						target_path          = "*/welcome"
+						target_access_levels = ["maintainer", "owner"]
+						broadcast_type       = "notification"
+						dismissable          = true
+					}
+				`, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_broadcast_message.this", "message", fmt.Sprintf("Updated message %d", rInt)),
+					resource.TestCheckResourceAttr("gitlab_broadcast_message.this", "broadcast_type", "notification"),
+					resource.TestCheckResourceAttr("gitlab_broadcast_message.this", "target_access_levels.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_broadcast_message.this", "dismissable", "true"),
+					resource.TestCheckResourceAttr("gitlab_broadcast_message.this", "active", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_broadcast_message.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabBroadcastMessageDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_broadcast_message" {
+			continue
+		}
+
+		messageID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = testGitlabClient.BroadcastMessage.GetBroadcastMessage(messageID)
+		if err == nil {
+			return fmt.Errorf("broadcast message %d still exists", messageID)
+		}
+		if !is404(err) {
+			return err
+		}
+	}
+	return nil
+}
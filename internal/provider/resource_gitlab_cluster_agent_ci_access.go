@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var gitlabClusterAgentCIAccessEntrySchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Description: "The full path of the project or group, e.g. `my-group/my-project`.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"default_namespace": {
+			Description: "The Kubernetes namespace to use for CI jobs of the project or group, if none is set in the job.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+	},
+}
+
+var _ = registerResource("gitlab_cluster_agent_ci_access", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_cluster_agent_ci_access`" + ` resource allows to authorize projects and groups to use a GitLab Agent for Kubernetes in their CI/CD jobs.
+
+-> The authorization is managed in the ` + "`ci_access`" + ` section of the agent configuration file ` + "`.gitlab/agents/<agent_name>/config.yaml`" + ` of the agent configuration project. All other sections of the file are kept as-is. Each change is committed to the given branch.
+
+~> Comments and formatting within the ` + "`ci_access`" + ` section are not preserved.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/repository_files.html)`,
+
+		CreateContext: resourceGitlabClusterAgentCIAccessCreate,
+		ReadContext:   resourceGitlabClusterAgentCIAccessRead,
+		UpdateContext: resourceGitlabClusterAgentCIAccessUpdate,
+		DeleteContext: resourceGitlabClusterAgentCIAccessDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the agent configuration project.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"agent_name": {
+				Description: "The name of the agent.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"branch": {
+				Description: "The branch to commit the agent configuration file to. Defaults to the default branch of the project.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"commit_message": {
+				Description: "The commit message used for changes to the agent configuration file.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Update CI/CD access of GitLab agent",
+			},
+			"projects": {
+				Description:  "The projects authorized to use the agent.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         gitlabClusterAgentCIAccessEntrySchema,
+				AtLeastOneOf: []string{"projects", "groups"},
+			},
+			"groups": {
+				Description:  "The groups, including their subgroups and projects, authorized to use the agent.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         gitlabClusterAgentCIAccessEntrySchema,
+				AtLeastOneOf: []string{"projects", "groups"},
+			},
+		},
+	}
+})
+
+// gitlabClusterAgentCIAccess represents the `ci_access` section of an agent configuration file.
+type gitlabClusterAgentCIAccess struct {
+	Projects []gitlabClusterAgentAccessEntry `yaml:"projects,omitempty"`
+	Groups   []gitlabClusterAgentAccessEntry `yaml:"groups,omitempty"`
+}
+
+func resourceGitlabClusterAgentCIAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	agentName := d.Get("agent_name").(string)
+
+	branch := d.Get("branch").(string)
+	if branch == "" {
+		defaultBranch, err := gitlabClusterAgentConfigDefaultBranch(ctx, client, project)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		branch = defaultBranch
+		d.Set("branch", branch)
+	}
+
+	log.Printf("[DEBUG] create ci_access of agent %s in gitlab project %s", agentName, project)
+
+	d.SetId(buildTwoPartID(&project, &agentName))
+	if err := resourceGitlabClusterAgentCIAccessCommit(ctx, d, client); err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabClusterAgentCIAccessRead(ctx, d, meta)
+}
+
+func resourceGitlabClusterAgentCIAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, agentName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	branch := d.Get("branch").(string)
+	if branch == "" {
+		defaultBranch, err := gitlabClusterAgentConfigDefaultBranch(ctx, client, project)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		branch = defaultBranch
+	}
+
+	log.Printf("[DEBUG] read ci_access of agent %s in gitlab project %s", agentName, project)
+
+	ciAccess := new(gitlabClusterAgentCIAccess)
+	found, err := getGitlabClusterAgentConfigSection(ctx, client, project, branch, agentName, "ci_access", ciAccess)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab project %s not found, removing ci_access of agent %s from state", project, agentName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[DEBUG] ci_access of agent %s in gitlab project %s not found, removing from state", agentName, project)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", project)
+	d.Set("agent_name", agentName)
+	d.Set("branch", branch)
+	if err := d.Set("projects", flattenGitlabClusterAgentCIAccessEntries(ciAccess.Projects)); err != nil {
+		return diag.Errorf("error setting projects: %v", err)
+	}
+	if err := d.Set("groups", flattenGitlabClusterAgentCIAccessEntries(ciAccess.Groups)); err != nil {
+		return diag.Errorf("error setting groups: %v", err)
+	}
+	return nil
+}
+
+func resourceGitlabClusterAgentCIAccessUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	if d.HasChanges("projects", "groups") {
+		log.Printf("[DEBUG] update ci_access of agent %s in gitlab project %s", d.Get("agent_name").(string), d.Get("project").(string))
+
+		if err := resourceGitlabClusterAgentCIAccessCommit(ctx, d, client); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGitlabClusterAgentCIAccessRead(ctx, d, meta)
+}
+
+func resourceGitlabClusterAgentCIAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, agentName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete ci_access of agent %s in gitlab project %s", agentName, project)
+
+	if err := setGitlabClusterAgentConfigSection(ctx, client, project, d.Get("branch").(string), agentName, "ci_access", nil, d.Get("commit_message").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabClusterAgentCIAccessCommit(ctx context.Context, d *schema.ResourceData, client *gitlab.Client) error {
+	ciAccess := &gitlabClusterAgentCIAccess{
+		Projects: expandGitlabClusterAgentCIAccessEntries(d.Get("projects").(*schema.Set)),
+		Groups:   expandGitlabClusterAgentCIAccessEntries(d.Get("groups").(*schema.Set)),
+	}
+
+	return setGitlabClusterAgentConfigSection(ctx, client, d.Get("project").(string), d.Get("branch").(string), d.Get("agent_name").(string), "ci_access", ciAccess, d.Get("commit_message").(string))
+}
+
+func expandGitlabClusterAgentCIAccessEntries(entries *schema.Set) []gitlabClusterAgentAccessEntry {
+	result := []gitlabClusterAgentAccessEntry{}
+	for _, entry := range entries.List() {
+		e := entry.(map[string]interface{})
+		result = append(result, gitlabClusterAgentAccessEntry{
+			ID:               e["id"].(string),
+			DefaultNamespace: e["default_namespace"].(string),
+		})
+	}
+	return result
+}
+
+func flattenGitlabClusterAgentCIAccessEntries(entries []gitlabClusterAgentAccessEntry) []interface{} {
+	result := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, map[string]interface{}{
+			"id":                entry.ID,
+			"default_namespace": entry.DefaultNamespace,
+		})
+	}
+	return result
+}
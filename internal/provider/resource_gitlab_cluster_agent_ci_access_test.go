@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabClusterAgentCIAccess_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testAuthorizedProject := testAccCreateProject(t)
+	testAuthorizedGroup := testAccCreateGroups(t, 1)[0]
+
+	// Create an existing agent configuration, which must be kept.
+	_, _, err := testGitlabClient.RepositoryFiles.CreateFile(testProject.ID, gitlabClusterAgentConfigPath("test-agent"), &gitlab.CreateFileOptions{
+		Branch:        gitlab.String(testProject.DefaultBranch),
+		Content:       gitlab.String("observability:\n  logging:\n    level: debug\n"),
+		CommitMessage: gitlab.String("Add agent configuration"),
+	})
+	if err != nil {
+		t.Fatalf("could not create test agent configuration: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabClusterAgentConfigSectionDestroy("gitlab_cluster_agent_ci_access", "ci_access"),
+		Steps: []resource.TestStep{
+			// Authorize a project
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_cluster_agent_ci_access" "this" {
+						project    = %d
+						agent_name = "test-agent"
+
+						projects {
+							id = "%s"
+						}
+					}
+				`, testProject.ID, testAuthorizedProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_cluster_agent_ci_access.this", "branch", testProject.DefaultBranch),
+					resource.TestCheckResourceAttr("gitlab_cluster_agent_ci_access.this", "projects.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_cluster_agent_ci_access.this", "groups.#", "0"),
+					testAccCheckGitlabClusterAgentConfigSectionExists(testProject, "test-agent", "observability"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_cluster_agent_ci_access.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"commit_message"},
+			},
+			// Authorize a group with a default namespace
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_cluster_agent_ci_access" "this" {
+						project        = %d
+						agent_name     = "test-agent"
+						commit_message = "Authorize group"
+
+						projects {
+							id = "%s"
+						}
+
+						groups {
+							id                = "%s"
+							default_namespace = "production"
+						}
+					}
+				`, testProject.ID, testAuthorizedProject.PathWithNamespace, testAuthorizedGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_cluster_agent_ci_access.this", "projects.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_cluster_agent_ci_access.this", "groups.#", "1"),
+					testAccCheckGitlabClusterAgentConfigSectionExists(testProject, "test-agent", "observability"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_cluster_agent_ci_access.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"commit_message"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabClusterAgentConfigSectionExists(project *gitlab.Project, agentName, section string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var value interface{}
+		found, err := getGitlabClusterAgentConfigSection(context.Background(), testGitlabClient, fmt.Sprintf("%d", project.ID), project.DefaultBranch, agentName, section, &value)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("section %s of configuration file of agent %s not found", section, agentName)
+		}
+		return nil
+	}
+}
+
+func testAccCheckGitlabClusterAgentConfigSectionDestroy(resourceType, section string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			project, agentName, err := parseTwoPartID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			var value interface{}
+			found, err := getGitlabClusterAgentConfigSection(context.Background(), testGitlabClient, project, rs.Primary.Attributes["branch"], agentName, section, &value)
+			if err != nil {
+				if is404(err) {
+					continue
+				}
+				return err
+			}
+			if found {
+				return fmt.Errorf("section %s of configuration file of agent %s still exists", section, agentName)
+			}
+		}
+		return nil
+	}
+}
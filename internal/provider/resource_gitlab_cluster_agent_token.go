@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_cluster_agent_token", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_cluster_agent_token`" + ` resource allows to manage the lifecycle of a token for a GitLab Agent for Kubernetes.
+
+-> The token is revoked and a new one is created whenever one of the ` + "`rotation_keepers`" + ` changes or once ` + "`expires_at`" + ` has passed. An expiry only rotates a token created before it, thus a token is rotated once per ` + "`expires_at`" + `. On expiry the new token is created before the expired one is revoked. For changes of the ` + "`rotation_keepers`" + ` use the ` + "`create_before_destroy`" + ` lifecycle to avoid downtime of the agent during the rotation.
+
+~> GitLab doesn't expire agent tokens itself, thus the ` + "`expires_at`" + ` is only enforced by Terraform during plan. The token stays valid until it is rotated by a subsequent apply.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/cluster_agents.html#create-an-agent-token)`,
+
+		CreateContext: resourceGitlabClusterAgentTokenCreate,
+		ReadContext:   resourceGitlabClusterAgentTokenRead,
+		UpdateContext: resourceGitlabClusterAgentTokenUpdate,
+		DeleteContext: resourceGitlabClusterAgentTokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceGitlabClusterAgentTokenCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project owning the agent.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"agent_id": {
+				Description: "The ID of the agent.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the token.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "The description of the token.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"rotation_keepers": {
+				Description: "Arbitrary map of values that, when changed, revokes the token and creates a new one.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"expires_at": {
+				Description:  "The time after which the token is rotated, in RFC3339 format, e.g. `2024-12-31T00:00:00Z`. It must be in the future when the token is created. Use the `time_rotating` resource of the `hashicorp/time` provider to rotate the token regularly.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"token_id": {
+				Description: "The ID of the token.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"token": {
+				Description: "The secret token. This is only populated when creating a new token, it's not available for imported resources.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"status": {
+				Description: "The status of the token, e.g. `active`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "The time when the token was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_by_user_id": {
+				Description: "The ID of the user who created the token.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"last_used_at": {
+				Description: "The time when the token was last used.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabClusterAgentTokenCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("expires_at") {
+		return nil
+	}
+
+	if d.Id() == "" {
+		if v, ok := d.GetOk("expires_at"); ok {
+			// NOTE: the expiry has already been validated.
+			expiresAt, _ := time.Parse(time.RFC3339, v.(string))
+			if !time.Now().Before(expiresAt) {
+				return fmt.Errorf("`expires_at` must be in the future when creating a token, but it is %s", expiresAt.Format(time.RFC3339))
+			}
+		}
+		return nil
+	}
+
+	expired, err := resourceGitlabClusterAgentTokenExpired(d.Get("expires_at").(string), d.Get("created_at").(string))
+	if err != nil || !expired {
+		return err
+	}
+
+	log.Printf("[DEBUG] gitlab cluster agent token %s expired at %s, forcing rotation", d.Id(), d.Get("expires_at"))
+	for _, k := range []string{"token", "token_id", "status", "created_at", "created_by_user_id", "last_used_at"} {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceGitlabClusterAgentTokenExpired returns whether a token created at the given time has expired.
+// Only a token created before its expiry can expire, thus an `expires_at` in the past rotates a token only once
+// and not the token created by the rotation again.
+func resourceGitlabClusterAgentTokenExpired(expiresAt, createdAt string) (bool, error) {
+	if expiresAt == "" {
+		return false, nil
+	}
+	expires, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false, err
+	}
+	if time.Now().Before(expires) {
+		return false, nil
+	}
+	if createdAt == "" {
+		return true, nil
+	}
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return false, err
+	}
+	return created.Before(expires), nil
+}
+
+func resourceGitlabClusterAgentTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	agentID := d.Get("agent_id").(int)
+
+	token, err := resourceGitlabClusterAgentTokenCreateToken(ctx, client, d, project, agentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resourceGitlabClusterAgentTokenBuildID(project, agentID, token.ID))
+	// NOTE: the token is only returned when creating it.
+	d.Set("token", token.Token)
+	return resourceGitlabClusterAgentTokenRead(ctx, d, meta)
+}
+
+func resourceGitlabClusterAgentTokenCreateToken(ctx context.Context, client *gitlab.Client, d *schema.ResourceData, project string, agentID int) (*gitlab.AgentToken, error) {
+	options := &gitlab.CreateAgentTokenOptions{
+		Name: gitlab.String(d.Get("name").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		options.Description = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create token for cluster agent %d of gitlab project %s", agentID, project)
+
+	token, _, err := client.ClusterAgents.CreateAgentToken(project, agentID, options, gitlab.WithContext(ctx))
+	return token, err
+}
+
+func resourceGitlabClusterAgentTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, agentID, tokenID, err := resourceGitlabClusterAgentTokenParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read token %d of cluster agent %d of gitlab project %s", tokenID, agentID, project)
+
+	token, _, err := client.ClusterAgents.GetAgentToken(project, agentID, tokenID, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] token %d of cluster agent %d of gitlab project %s not found, removing from state", tokenID, agentID, project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if token.Status == "revoked" {
+		log.Printf("[DEBUG] token %d of cluster agent %d of gitlab project %s is revoked, removing from state", tokenID, agentID, project)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", project)
+	d.Set("agent_id", token.AgentID)
+	d.Set("name", token.Name)
+	d.Set("description", token.Description)
+	d.Set("token_id", token.ID)
+	d.Set("status", token.Status)
+	if token.CreatedAt != nil {
+		d.Set("created_at", token.CreatedAt.Format(time.RFC3339))
+	}
+	d.Set("created_by_user_id", token.CreatedByUserID)
+	if token.LastUsedAt != nil {
+		d.Set("last_used_at", token.LastUsedAt.Format(time.RFC3339))
+	} else {
+		d.Set("last_used_at", "")
+	}
+	return nil
+}
+
+func resourceGitlabClusterAgentTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	createdAt, _ := d.GetChange("created_at")
+	expired, err := resourceGitlabClusterAgentTokenExpired(d.Get("expires_at").(string), createdAt.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !expired {
+		// NOTE: the `expires_at` is the only updatable attribute and it's only stored in the state.
+		return resourceGitlabClusterAgentTokenRead(ctx, d, meta)
+	}
+
+	client := meta.(*gitlab.Client)
+	project, agentID, tokenID, err := resourceGitlabClusterAgentTokenParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The new token is created before the expired one is revoked, so that the agent can be reconfigured without downtime.
+	token, err := resourceGitlabClusterAgentTokenCreateToken(ctx, client, d, project, agentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(resourceGitlabClusterAgentTokenBuildID(project, agentID, token.ID))
+	d.Set("token", token.Token)
+
+	log.Printf("[DEBUG] revoke expired token %d of cluster agent %d of gitlab project %s", tokenID, agentID, project)
+
+	if _, err := client.ClusterAgents.RevokeAgentToken(project, agentID, tokenID, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabClusterAgentTokenRead(ctx, d, meta)
+}
+
+func resourceGitlabClusterAgentTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, agentID, tokenID, err := resourceGitlabClusterAgentTokenParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] revoke token %d of cluster agent %d of gitlab project %s", tokenID, agentID, project)
+
+	if _, err := client.ClusterAgents.RevokeAgentToken(project, agentID, tokenID, gitlab.WithContext(ctx)); err != nil {
+		if is404(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabClusterAgentTokenParseID(id string) (string, int, int, error) {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 {
+		return "", 0, 0, fmt.Errorf("Unexpected ID format (%q). Expected project:agent_id:token_id", id)
+	}
+
+	agentID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("failed to parse agent ID %q: %w", parts[1], err)
+	}
+	tokenID, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("failed to parse token ID %q: %w", parts[2], err)
+	}
+	return parts[0], agentID, tokenID, nil
+}
+
+func resourceGitlabClusterAgentTokenBuildID(project string, agentID, tokenID int) string {
+	return fmt.Sprintf("%s:%d:%d", project, agentID, tokenID)
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabClusterAgentToken_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testAgent, _, err := testGitlabClient.ClusterAgents.RegisterAgent(testProject.ID, &gitlab.RegisterAgentOptions{
+		Name: gitlab.String("test-agent"),
+	})
+	if err != nil {
+		t.Fatalf("could not register test cluster agent: %v", err)
+	}
+
+	var firstTokenID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabClusterAgentTokenDestroy,
+		Steps: []resource.TestStep{
+			// Create a token
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_cluster_agent_token" "this" {
+						project     = %d
+						agent_id    = %d
+						name        = "test-token"
+						description = "Test token"
+
+						rotation_keepers = {
+							rotation = "1"
+						}
+					}
+				`, testProject.ID, testAgent.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_cluster_agent_token.this", "token"),
+					resource.TestCheckResourceAttr("gitlab_cluster_agent_token.this", "status", "active"),
+					func(s *terraform.State) error {
+						firstTokenID = s.RootModule().Resources["gitlab_cluster_agent_token.this"].Primary.Attributes["token_id"]
+						return nil
+					},
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_cluster_agent_token.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token", "rotation_keepers"},
+			},
+			// Rotate the token
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_cluster_agent_token" "this" {
+						project     = %d
+						agent_id    = %d
+						name        = "test-token"
+						description = "Test token"
+
+						rotation_keepers = {
+							rotation = "2"
+						}
+					}
+				`, testProject.ID, testAgent.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_cluster_agent_token.this", "token"),
+					func(s *terraform.State) error {
+						tokenID := s.RootModule().Resources["gitlab_cluster_agent_token.this"].Primary.Attributes["token_id"]
+						if tokenID == firstTokenID {
+							return fmt.Errorf("token %s was not rotated", tokenID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabClusterAgentToken_expiresAt(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testAgent, _, err := testGitlabClient.ClusterAgents.RegisterAgent(testProject.ID, &gitlab.RegisterAgentOptions{
+		Name: gitlab.String("test-agent"),
+	})
+	if err != nil {
+		t.Fatalf("could not register test cluster agent: %v", err)
+	}
+
+	config := func(expiresAt time.Time) string {
+		return fmt.Sprintf(`
+			resource "gitlab_cluster_agent_token" "this" {
+				project    = %d
+				agent_id   = %d
+				name       = "test-token"
+				expires_at = "%s"
+			}
+		`, testProject.ID, testAgent.ID, expiresAt.Format(time.RFC3339))
+	}
+
+	expiresAt := time.Now().Add(30 * time.Second)
+	var firstTokenID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabClusterAgentTokenDestroy,
+		Steps: []resource.TestStep{
+			// Creating a token with an expiry in the past fails
+			{
+				Config:      config(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+				ExpectError: regexp.MustCompile("`expires_at` must be in the future"),
+			},
+			// Create a token with an expiry in the future
+			{
+				Config: config(expiresAt),
+				Check: func(s *terraform.State) error {
+					firstTokenID = s.RootModule().Resources["gitlab_cluster_agent_token.this"].Primary.Attributes["token_id"]
+					return nil
+				},
+			},
+			// Once expired, the token is rotated
+			{
+				PreConfig: func() { time.Sleep(time.Until(expiresAt) + time.Second) },
+				Config:    config(expiresAt),
+				Check: func(s *terraform.State) error {
+					tokenID := s.RootModule().Resources["gitlab_cluster_agent_token.this"].Primary.Attributes["token_id"]
+					if tokenID == firstTokenID {
+						return fmt.Errorf("token %s was not rotated", tokenID)
+					}
+					return nil
+				},
+			},
+			// The rotated token isn't rotated again
+			{
+				Config:   config(expiresAt),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabClusterAgentTokenDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_cluster_agent_token" {
+			continue
+		}
+
+		project, agentID, tokenID, err := resourceGitlabClusterAgentTokenParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		token, _, err := testGitlabClient.ClusterAgents.GetAgentToken(project, agentID, tokenID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if token.Status != "revoked" {
+			return fmt.Errorf("token %d of cluster agent %d of project %s is not revoked", tokenID, agentID, project)
+		}
+	}
+	return nil
+}
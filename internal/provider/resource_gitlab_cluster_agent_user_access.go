@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var validClusterAgentUserAccessAsValues = []string{"agent", "user"}
+
+var _ = registerResource("gitlab_cluster_agent_user_access", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_cluster_agent_user_access`" + ` resource allows to authorize members of projects and groups to access a Kubernetes cluster through a GitLab Agent for Kubernetes.
+
+-> The authorization is managed in the ` + "`user_access`" + ` section of the agent configuration file ` + "`.gitlab/agents/<agent_name>/config.yaml`" + ` of the agent configuration project. All other sections of the file are kept as-is. Each change is committed to the given branch.
+
+~> Comments and formatting within the ` + "`user_access`" + ` section are not preserved.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/repository_files.html)`,
+
+		CreateContext: resourceGitlabClusterAgentUserAccessCreate,
+		ReadContext:   resourceGitlabClusterAgentUserAccessRead,
+		UpdateContext: resourceGitlabClusterAgentUserAccessUpdate,
+		DeleteContext: resourceGitlabClusterAgentUserAccessDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the agent configuration project.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"agent_name": {
+				Description: "The name of the agent.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"branch": {
+				Description: "The branch to commit the agent configuration file to. Defaults to the default branch of the project.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"commit_message": {
+				Description: "The commit message used for changes to the agent configuration file.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Update user access of GitLab agent",
+			},
+			"access_as": {
+				Description:  fmt.Sprintf("The identity used to access the cluster. Valid values are: %s.", renderValueListForDocs(validClusterAgentUserAccessAsValues)),
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(validClusterAgentUserAccessAsValues, false),
+			},
+			"projects": {
+				Description:  "The full paths of the projects whose members are authorized to access the cluster.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"projects", "groups"},
+			},
+			"groups": {
+				Description:  "The full paths of the groups whose members are authorized to access the cluster.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: []string{"projects", "groups"},
+			},
+		},
+	}
+})
+
+// gitlabClusterAgentUserAccess represents the `user_access` section of an agent configuration file.
+type gitlabClusterAgentUserAccess struct {
+	AccessAs map[string]map[string]interface{} `yaml:"access_as"`
+	Projects []gitlabClusterAgentAccessEntry   `yaml:"projects,omitempty"`
+	Groups   []gitlabClusterAgentAccessEntry   `yaml:"groups,omitempty"`
+}
+
+func resourceGitlabClusterAgentUserAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	agentName := d.Get("agent_name").(string)
+
+	branch := d.Get("branch").(string)
+	if branch == "" {
+		defaultBranch, err := gitlabClusterAgentConfigDefaultBranch(ctx, client, project)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		branch = defaultBranch
+		d.Set("branch", branch)
+	}
+
+	log.Printf("[DEBUG] create user_access of agent %s in gitlab project %s", agentName, project)
+
+	d.SetId(buildTwoPartID(&project, &agentName))
+	if err := resourceGitlabClusterAgentUserAccessCommit(ctx, d, client); err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabClusterAgentUserAccessRead(ctx, d, meta)
+}
+
+func resourceGitlabClusterAgentUserAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, agentName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	branch := d.Get("branch").(string)
+	if branch == "" {
+		defaultBranch, err := gitlabClusterAgentConfigDefaultBranch(ctx, client, project)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		branch = defaultBranch
+	}
+
+	log.Printf("[DEBUG] read user_access of agent %s in gitlab project %s", agentName, project)
+
+	userAccess := new(gitlabClusterAgentUserAccess)
+	found, err := getGitlabClusterAgentConfigSection(ctx, client, project, branch, agentName, "user_access", userAccess)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab project %s not found, removing user_access of agent %s from state", project, agentName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[DEBUG] user_access of agent %s in gitlab project %s not found, removing from state", agentName, project)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", project)
+	d.Set("agent_name", agentName)
+	d.Set("branch", branch)
+	for accessAs := range userAccess.AccessAs {
+		d.Set("access_as", accessAs)
+	}
+	d.Set("projects", flattenGitlabClusterAgentUserAccessEntries(userAccess.Projects))
+	d.Set("groups", flattenGitlabClusterAgentUserAccessEntries(userAccess.Groups))
+	return nil
+}
+
+func resourceGitlabClusterAgentUserAccessUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	if d.HasChanges("access_as", "projects", "groups") {
+		log.Printf("[DEBUG] update user_access of agent %s in gitlab project %s", d.Get("agent_name").(string), d.Get("project").(string))
+
+		if err := resourceGitlabClusterAgentUserAccessCommit(ctx, d, client); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGitlabClusterAgentUserAccessRead(ctx, d, meta)
+}
+
+func resourceGitlabClusterAgentUserAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, agentName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete user_access of agent %s in gitlab project %s", agentName, project)
+
+	if err := setGitlabClusterAgentConfigSection(ctx, client, project, d.Get("branch").(string), agentName, "user_access", nil, d.Get("commit_message").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabClusterAgentUserAccessCommit(ctx context.Context, d *schema.ResourceData, client *gitlab.Client) error {
+	userAccess := &gitlabClusterAgentUserAccess{
+		AccessAs: map[string]map[string]interface{}{
+			d.Get("access_as").(string): {},
+		},
+		Projects: expandGitlabClusterAgentUserAccessEntries(d.Get("projects").(*schema.Set)),
+		Groups:   expandGitlabClusterAgentUserAccessEntries(d.Get("groups").(*schema.Set)),
+	}
+
+	return setGitlabClusterAgentConfigSection(ctx, client, d.Get("project").(string), d.Get("branch").(string), d.Get("agent_name").(string), "user_access", userAccess, d.Get("commit_message").(string))
+}
+
+func expandGitlabClusterAgentUserAccessEntries(entries *schema.Set) []gitlabClusterAgentAccessEntry {
+	result := []gitlabClusterAgentAccessEntry{}
+	for _, entry := range entries.List() {
+		result = append(result, gitlabClusterAgentAccessEntry{ID: entry.(string)})
+	}
+	return result
+}
+
+func flattenGitlabClusterAgentUserAccessEntries(entries []gitlabClusterAgentAccessEntry) []string {
+	result := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, entry.ID)
+	}
+	return result
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGitlabClusterAgentUserAccess_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	testAuthorizedGroup := testAccCreateGroups(t, 1)[0]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabClusterAgentConfigSectionDestroy("gitlab_cluster_agent_user_access", "user_access"),
+		Steps: []resource.TestStep{
+			// Authorize the agent configuration project, which creates the configuration file
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_cluster_agent_user_access" "this" {
+						project    = %d
+						agent_name = "test-agent"
+						access_as  = "agent"
+						projects   = ["%s"]
+					}
+				`, testProject.ID, testProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_cluster_agent_user_access.this", "access_as", "agent"),
+					resource.TestCheckResourceAttr("gitlab_cluster_agent_user_access.this", "projects.#", "1"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_cluster_agent_user_access.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"commit_message"},
+			},
+			// Authorize a group to access the cluster as user
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_cluster_agent_user_access" "this" {
+						project    = %d
+						agent_name = "test-agent"
+						access_as  = "user"
+						groups     = ["%s"]
+					}
+				`, testProject.ID, testAuthorizedGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_cluster_agent_user_access.this", "access_as", "user"),
+					resource.TestCheckResourceAttr("gitlab_cluster_agent_user_access.this", "projects.#", "0"),
+					resource.TestCheckResourceAttr("gitlab_cluster_agent_user_access.this", "groups.#", "1"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_cluster_agent_user_access.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"commit_message"},
+			},
+		},
+	})
+}
@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var validGeoNodeSelectiveSyncTypes = []string{"namespaces", "shards"}
+
+var _ = registerResource("gitlab_geo_node", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_geo_node`" + ` resource allows to manage the lifecycle of a Geo node (Geo site).
+
+-> This resource requires administration privileges on a GitLab Enterprise instance with a Premium license.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/geo_nodes.html)`,
+
+		CreateContext: resourceGitlabGeoNodeCreate,
+		ReadContext:   resourceGitlabGeoNodeRead,
+		UpdateContext: resourceGitlabGeoNodeUpdate,
+		DeleteContext: resourceGitlabGeoNodeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The unique identifier of the Geo node. Must match `geo_node_name` in `gitlab.rb` of the node.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"url": {
+				Description:  "The user-facing URL of the Geo node.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateURLFunc,
+			},
+			"internal_url": {
+				Description:  "The URL defined on the primary node that secondary nodes should use to contact it. Defaults to `url`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateURLFunc,
+			},
+			"primary": {
+				Description: "Whether the node is the primary node.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"enabled": {
+				Description: "Whether the Geo node is enabled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"files_max_capacity": {
+				Description:  "Control the maximum concurrency of LFS/attachment backfill for this secondary node.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"repos_max_capacity": {
+				Description:  "Control the maximum concurrency of repository backfill for this secondary node.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"verification_max_capacity": {
+				Description:  "Control the maximum concurrency of repository verification for this node.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"container_repositories_max_capacity": {
+				Description:  "Control the maximum concurrency of container repository sync for this node.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"minimum_reverification_interval": {
+				Description:  "The interval (in days) in which the repository verification is valid. Once expired, it is reverified.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"sync_object_storage": {
+				Description: "Whether the secondary node should replicate blobs in object storage.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"selective_sync_type": {
+				Description:  fmt.Sprintf("Limit syncing to only specific groups or shards. Valid values are: %s. Sync everything if not set.", renderValueListForDocs(validGeoNodeSelectiveSyncTypes)),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(validGeoNodeSelectiveSyncTypes, false),
+			},
+			"selective_sync_shards": {
+				Description: "The repository storages whose projects should be synced, if `selective_sync_type` is `shards`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"selective_sync_namespace_ids": {
+				Description: "The IDs of groups that should be synced, if `selective_sync_type` is `namespaces`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"current": {
+				Description: "Whether the node is the node the API request was sent to.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"web_edit_url": {
+				Description: "The URL to edit the Geo node in the GitLab UI.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabGeoNodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	options := &gitlab.CreateGeoNodesOptions{
+		Name:                      gitlab.String(d.Get("name").(string)),
+		URL:                       gitlab.String(d.Get("url").(string)),
+		Primary:                   gitlab.Bool(d.Get("primary").(bool)),
+		Enabled:                   gitlab.Bool(d.Get("enabled").(bool)),
+		SelectiveSyncType:         gitlab.String(d.Get("selective_sync_type").(string)),
+		SelectiveSyncShards:       stringSetToStringSlice(d.Get("selective_sync_shards").(*schema.Set)),
+		SelectiveSyncNamespaceIds: intSetToIntSlice(d.Get("selective_sync_namespace_ids").(*schema.Set)),
+	}
+	if v, ok := d.GetOk("internal_url"); ok {
+		options.InternalURL = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("files_max_capacity"); ok {
+		options.FilesMaxCapacity = gitlab.Int(v.(int))
+	}
+	if v, ok := d.GetOk("repos_max_capacity"); ok {
+		options.ReposMaxCapacity = gitlab.Int(v.(int))
+	}
+	if v, ok := d.GetOk("verification_max_capacity"); ok {
+		options.VerificationMaxCapacity = gitlab.Int(v.(int))
+	}
+	if v, ok := d.GetOk("container_repositories_max_capacity"); ok {
+		options.ContainerRepositoriesMaxCapacity = gitlab.Int(v.(int))
+	}
+	if v, ok := d.GetOk("minimum_reverification_interval"); ok {
+		options.MinimumReverificationInterval = gitlab.Int(v.(int))
+	}
+	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
+	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
+	if v, ok := d.GetOkExists("sync_object_storage"); ok {
+		options.SyncObjectStorage = gitlab.Bool(v.(bool))
+	}
+
+	log.Printf("[DEBUG] create gitlab geo node %s", *options.Name)
+
+	node, _, err := client.GeoNodes.CreateGeoNode(options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", node.ID))
+	return resourceGitlabGeoNodeRead(ctx, d, meta)
+}
+
+func resourceGitlabGeoNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	nodeID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] read gitlab geo node %d", nodeID)
+
+	node, _, err := client.GeoNodes.GetGeoNode(nodeID, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab geo node %d not found, removing from state", nodeID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", node.Name)
+	d.Set("url", node.URL)
+	d.Set("internal_url", node.InternalURL)
+	d.Set("primary", node.Primary)
+	d.Set("enabled", node.Enabled)
+	d.Set("files_max_capacity", node.FilesMaxCapacity)
+	d.Set("repos_max_capacity", node.ReposMaxCapacity)
+	d.Set("verification_max_capacity", node.VerificationMaxCapacity)
+	d.Set("container_repositories_max_capacity", node.ContainerRepositoriesMaxCapacity)
+	d.Set("minimum_reverification_interval", node.MinimumReverificationInterval)
+	d.Set("sync_object_storage", node.SyncObjectStorage)
+	d.Set("selective_sync_type", node.SelectiveSyncType)
+	d.Set("selective_sync_shards", node.SelectiveSyncShards)
+	d.Set("selective_sync_namespace_ids", node.SelectiveSyncNamespaceIds)
+	d.Set("current", node.Current)
+	d.Set("web_edit_url", node.WebEditURL)
+	return nil
+}
+
+func resourceGitlabGeoNodeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	nodeID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &gitlab.UpdateGeoNodesOptions{}
+
+	if d.HasChange("name") {
+		options.Name = gitlab.String(d.Get("name").(string))
+	}
+	if d.HasChange("url") {
+		options.URL = gitlab.String(d.Get("url").(string))
+	}
+	if d.HasChange("internal_url") {
+		options.InternalURL = gitlab.String(d.Get("internal_url").(string))
+	}
+	if d.HasChange("enabled") {
+		options.Enabled = gitlab.Bool(d.Get("enabled").(bool))
+	}
+	if d.HasChange("files_max_capacity") {
+		options.FilesMaxCapacity = gitlab.Int(d.Get("files_max_capacity").(int))
+	}
+	if d.HasChange("repos_max_capacity") {
+		options.ReposMaxCapacity = gitlab.Int(d.Get("repos_max_capacity").(int))
+	}
+	if d.HasChange("verification_max_capacity") {
+		options.VerificationMaxCapacity = gitlab.Int(d.Get("verification_max_capacity").(int))
+	}
+	if d.HasChange("container_repositories_max_capacity") {
+		options.ContainerRepositoriesMaxCapacity = gitlab.Int(d.Get("container_repositories_max_capacity").(int))
+	}
+	if d.HasChange("minimum_reverification_interval") {
+		options.MinimumReverificationInterval = gitlab.Int(d.Get("minimum_reverification_interval").(int))
+	}
+	if d.HasChange("sync_object_storage") {
+		options.SyncObjectStorage = gitlab.Bool(d.Get("sync_object_storage").(bool))
+	}
+	if d.HasChange("selective_sync_type") {
+		options.SelectiveSyncType = gitlab.String(d.Get("selective_sync_type").(string))
+	}
+	if d.HasChange("selective_sync_shards") {
+		options.SelectiveSyncShards = stringSetToStringSlice(d.Get("selective_sync_shards").(*schema.Set))
+	}
+	if d.HasChange("selective_sync_namespace_ids") {
+		options.SelectiveSyncNamespaceIds = intSetToIntSlice(d.Get("selective_sync_namespace_ids").(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] update gitlab geo node %d", nodeID)
+
+	if _, _, err := client.GeoNodes.EditGeoNode(nodeID, options, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabGeoNodeRead(ctx, d, meta)
+}
+
+func resourceGitlabGeoNodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	nodeID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] delete gitlab geo node %d", nodeID)
+
+	if _, err := client.GeoNodes.DeleteGeoNode(nodeID, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
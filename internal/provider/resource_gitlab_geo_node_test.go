@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabGeoNode_basic(t *testing.T) {
+	testAccCheck(t)
+	testAccCheckEE(t)
+
+	name := acctest.RandomWithPrefix("acctest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGeoNodeDestroy,
+		Steps: []resource.TestStep{
+			// Create a secondary Geo node
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_geo_node" "this" {
+						name = "%s"
+						url  = "https://%s.example.com/"
+					}
+				`, name, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_geo_node.this", "primary", "false"),
+					resource.TestCheckResourceAttr("gitlab_geo_node.this", "enabled", "true"),
+					resource.TestCheckResourceAttr("gitlab_geo_node.this", "current", "false"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_geo_node.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the Geo node with selective sync
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_geo_node" "this" {
+						name                  = "%s"
+						url                   = "https://%s.example.com/"
+						enabled               = false
+						files_max_capacity    = 5
+						selective_sync_type   = "shards"
+						selective_sync_shards = ["default"]
+					}
+				`, name, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_geo_node.this", "enabled", "false"),
+					resource.TestCheckResourceAttr("gitlab_geo_node.this", "files_max_capacity", "5"),
+					resource.TestCheckResourceAttr("gitlab_geo_node.this", "selective_sync_shards.#", "1"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_geo_node.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGeoNodeDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_geo_node" {
+			continue
+		}
+
+		nodeID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = testGitlabClient.GeoNodes.GetGeoNode(nodeID)
+		if err == nil {
+			return fmt.Errorf("geo node %d still exists", nodeID)
+		}
+		if !is404(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,6 +21,10 @@ var _ = registerResource("gitlab_group", func() *schema.Resource {
 
 -> On GitLab SaaS, you must use the GitLab UI to create groups without a parent group. You cannot use this provider nor the API to do this.
 
+~> Changing the ` + "`parent_id`" + ` transfers the group with all its subgroups and projects. GitLab redirects the previous paths to the new location, but only until these paths are used by another group or project. A warning about the redirect is only shown when the transfer is applied, not during plan.
+
+-> **Timeouts** Default timeout for *Delete* is ten minutes and can be configured in the ` + "`timeouts`" + ` block. It limits how long the deletion waits for the group to be deleted.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/groups.html)`,
 
 		CreateContext: resourceGitlabGroupCreate,
@@ -29,6 +34,14 @@ var _ = registerResource("gitlab_group", func() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("full_path", parentIDOrPathChanged),
+			customdiff.ComputedIf("full_name", parentIDOrPathChanged),
+			customdiff.ComputedIf("web_url", parentIDOrPathChanged),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -138,10 +151,9 @@ var _ = registerResource("gitlab_group", func() *schema.Resource {
 				Default:     48,
 			},
 			"parent_id": {
-				Description: "Id of the parent group (creates a nested group).",
+				Description: "Id of the parent group (creates a nested group). Changing it transfers the group to the new parent group, or makes it a top-level group when set to `0` (requires GitLab 14.6 or newer).",
 				Type:        schema.TypeInt,
 				Optional:    true,
-				ForceNew:    true,
 				Default:     0,
 			},
 			"runners_token": {
@@ -156,6 +168,26 @@ var _ = registerResource("gitlab_group", func() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"ip_restriction_ranges": {
+				Description: "The IP addresses or subnet masks, e.g. `192.168.0.0/24`, to restrict the access to the group to. Only available for top-level groups on GitLab Premium. Removing all of them removes the IP restriction of the group.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.Any(validation.IsIPAddress, validation.IsCIDR),
+				},
+			},
+			"allowed_email_domains_list": {
+				Description: "The email domains, e.g. `example.com`, users must have an email address in to be added to the group. Only available for top-level groups on GitLab Premium. Removing all of them removes the email domain restriction of the group.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"permanently_remove_on_destroy": {
+				Description: "Set to `true` to immediately remove the group on destroy, if the GitLab instance has delayed deletion enabled and only marks the group for deletion. Otherwise the group keeps its path until the deletion delay has passed, thus re-creating a group with the same path fails.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 		},
 	}
 })
@@ -230,6 +262,7 @@ func resourceGitlabGroupCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	d.SetId(fmt.Sprintf("%d", group.ID))
+	invalidateGitlabLookupCache(client)
 
 	var updateOptions gitlab.UpdateGroupOptions
 
@@ -237,6 +270,14 @@ func resourceGitlabGroupCreate(ctx context.Context, d *schema.ResourceData, meta
 		updateOptions.PreventForkingOutsideGroup = gitlab.Bool(v.(bool))
 	}
 
+	if v, ok := d.GetOk("ip_restriction_ranges"); ok {
+		updateOptions.IPRestrictionRanges = gitlab.String(strings.Join(*stringSetToStringSlice(v.(*schema.Set)), ","))
+	}
+
+	if v, ok := d.GetOk("allowed_email_domains_list"); ok {
+		updateOptions.AllowedEmailDomainsList = gitlab.String(strings.Join(*stringSetToStringSlice(v.(*schema.Set)), ","))
+	}
+
 	if (updateOptions != gitlab.UpdateGroupOptions{}) {
 		if _, _, err = client.Groups.UpdateGroup(d.Id(), &updateOptions, gitlab.WithContext(ctx)); err != nil {
 			return diag.Errorf("could not update group after creation %q: %s", d.Id(), err)
@@ -287,6 +328,8 @@ func resourceGitlabGroupRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("share_with_group_lock", group.ShareWithGroupLock)
 	d.Set("default_branch_protection", group.DefaultBranchProtection)
 	d.Set("prevent_forking_outside_group", group.PreventForkingOutsideGroup)
+	d.Set("ip_restriction_ranges", splitCommaSeparatedList(group.IPRestrictionRanges))
+	d.Set("allowed_email_domains_list", splitCommaSeparatedList(group.AllowedEmailDomainsList))
 
 	return nil
 }
@@ -362,54 +405,126 @@ func resourceGitlabGroupUpdate(ctx context.Context, d *schema.ResourceData, meta
 		options.PreventForkingOutsideGroup = gitlab.Bool(d.Get("prevent_forking_outside_group").(bool))
 	}
 
+	// NOTE: an empty list is sent as an empty string, which removes the restriction.
+	if d.HasChange("ip_restriction_ranges") {
+		options.IPRestrictionRanges = gitlab.String(strings.Join(*stringSetToStringSlice(d.Get("ip_restriction_ranges").(*schema.Set)), ","))
+	}
+
+	if d.HasChange("allowed_email_domains_list") {
+		options.AllowedEmailDomainsList = gitlab.String(strings.Join(*stringSetToStringSlice(d.Get("allowed_email_domains_list").(*schema.Set)), ","))
+	}
+
+	var diags diag.Diagnostics
+	if d.HasChange("parent_id") {
+		transferOptions := &gitlab.TransferSubGroupOptions{}
+		if v := d.Get("parent_id").(int); v != 0 {
+			transferOptions.GroupID = gitlab.Int(v)
+		}
+
+		log.Printf("[DEBUG] transferring group %s to parent group %d", d.Id(), d.Get("parent_id").(int))
+		if _, _, err := client.Groups.TransferSubGroup(d.Id(), transferOptions, gitlab.WithContext(ctx)); err != nil {
+			return diag.Errorf("failed to transfer group %s: %s", d.Id(), err)
+		}
+		invalidateGitlabLookupCache(client)
+
+		oldFullPath, _ := d.GetChange("full_path")
+		diags = append(diags, transferRedirectWarning("group", oldFullPath.(string)))
+	}
+
 	log.Printf("[DEBUG] update gitlab group %s", d.Id())
 
 	_, _, err := client.Groups.UpdateGroup(d.Id(), options, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if options.Path != nil {
+		invalidateGitlabLookupCache(client)
+	}
 
-	return resourceGitlabGroupRead(ctx, d, meta)
+	return append(diags, resourceGitlabGroupRead(ctx, d, meta)...)
+}
+
+// splitCommaSeparatedList splits a comma separated list as returned by the GitLab API,
+// e.g. for the `ip_restriction_ranges` of a group, into its trimmed values.
+func splitCommaSeparatedList(list string) []string {
+	values := []string{}
+	for _, v := range strings.Split(list, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func parentIDOrPathChanged(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+	return d.HasChange("parent_id") || d.HasChange("path")
 }
 
 func resourceGitlabGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*gitlab.Client)
 	log.Printf("[DEBUG] Delete gitlab group %s", d.Id())
 
-	_, err := client.Groups.DeleteGroup(d.Id(), gitlab.WithContext(ctx))
+	_, err := client.Groups.DeleteGroup(d.Id(), nil, gitlab.WithContext(ctx))
 	if err != nil && !strings.Contains(err.Error(), "Group has been already marked for deletion") {
 		return diag.Errorf("error deleting group %s: %s", d.Id(), err)
 	}
+	invalidateGitlabLookupCache(client)
 
-	// Wait for the group to be deleted.
-	// Deleting a group in gitlab is async.
+	group, err := resourceGitlabGroupWaitForDeletion(ctx, client, d.Id(), false, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error waiting for group (%s) to become deleted: %s", d.Id(), err)
+	}
+
+	// NOTE: on instances with delayed deletion the group is only marked for deletion,
+	//       which requires a second request with the (possibly renamed) full path to remove it immediately.
+	if group != nil && d.Get("permanently_remove_on_destroy").(bool) {
+		log.Printf("[DEBUG] Permanently remove gitlab group %s marked for deletion", d.Id())
+		options := &gitlab.DeleteGroupOptions{
+			FullPath:          gitlab.String(group.FullPath),
+			PermanentlyRemove: gitlab.Bool(true),
+		}
+		if _, err := client.Groups.DeleteGroup(d.Id(), options, gitlab.WithContext(ctx)); err != nil {
+			return diag.Errorf("error permanently removing group (%s): %s", d.Id(), err)
+		}
+
+		if _, err := resourceGitlabGroupWaitForDeletion(ctx, client, d.Id(), true, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.Errorf("error waiting for group (%s) to become permanently removed: %s", d.Id(), err)
+		}
+	}
+	return nil
+}
+
+// resourceGitlabGroupWaitForDeletion waits for the group to be deleted.
+// Deleting a group in gitlab is async.
+// Unless untilGone is set, a group marked for deletion counts as deleted and is returned.
+func resourceGitlabGroupWaitForDeletion(ctx context.Context, client *gitlab.Client, groupID string, untilGone bool, timeout time.Duration) (*gitlab.Group, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"Deleting"},
 		Target:  []string{"Deleted"},
 		Refresh: func() (interface{}, string, error) {
-			out, response, err := client.Groups.GetGroup(d.Id(), nil, gitlab.WithContext(ctx))
+			out, response, err := client.Groups.GetGroup(groupID, nil, gitlab.WithContext(ctx))
 			if err != nil {
 				if response != nil && response.StatusCode == 404 {
-					return out, "Deleted", nil
+					return (*gitlab.Group)(nil), "Deleted", nil
 				}
 				log.Printf("[ERROR] Received error: %#v", err)
 				return out, "Error", err
 			}
-			if out.MarkedForDeletionOn != nil {
+			if out.MarkedForDeletionOn != nil && !untilGone {
 				// Represents a Gitlab EE soft-delete
 				return out, "Deleted", nil
 			}
 			return out, "Deleting", nil
 		},
 
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 3 * time.Second,
 		Delay:      5 * time.Second,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
+	out, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return diag.Errorf("error waiting for group (%s) to become deleted: %s", d.Id(), err)
+		return nil, err
 	}
-	return nil
+	return out.(*gitlab.Group), nil
 }
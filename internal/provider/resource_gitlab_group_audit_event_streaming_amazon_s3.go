@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitlabAuditEventStreamingAmazonS3Fields = []auditEventStreamingField{
+	{attribute: "name", field: "name"},
+	{attribute: "access_key_xid", field: "accessKeyXid"},
+	{attribute: "secret_access_key", field: "secretAccessKey", secret: true},
+	{attribute: "bucket_name", field: "bucketName"},
+	{attribute: "aws_region", field: "awsRegion"},
+}
+
+var gitlabGroupAuditEventStreamingAmazonS3API = auditEventStreamingAPI{
+	gidType:         "AuditEvents::AmazonS3Configuration",
+	listField:       "amazonS3Configurations",
+	selection:       auditEventStreamingSelection(gitlabAuditEventStreamingAmazonS3Fields),
+	createMutation:  "auditEventsAmazonS3ConfigurationCreate",
+	updateMutation:  "auditEventsAmazonS3ConfigurationUpdate",
+	destroyMutation: "auditEventsAmazonS3ConfigurationDelete",
+	payloadField:    "amazonS3Configuration",
+}
+
+var _ = registerResource("gitlab_group_audit_event_streaming_amazon_s3", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_audit_event_streaming_amazon_s3`" + ` resource allows to manage the lifecycle of an Amazon S3 destination streaming the audit events of a top-level group.
+
+-> This resource requires GitLab Ultimate.
+
+~> The ` + "`secret_access_key`" + ` is a secret and not returned by the GitLab API, thus changes made to it outside of Terraform are not detected.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#mutationauditeventsamazons3configurationcreate)`,
+
+		CreateContext: resourceGitlabGroupAuditEventStreamingAmazonS3Create,
+		ReadContext:   resourceGitlabGroupAuditEventStreamingAmazonS3Read,
+		UpdateContext: resourceGitlabGroupAuditEventStreamingAmazonS3Update,
+		DeleteContext: resourceGitlabGroupAuditEventStreamingAmazonS3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: gitlabAuditEventStreamingAmazonS3Schema(map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the top-level group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		}),
+	}
+})
+
+// gitlabAuditEventStreamingAmazonS3Schema returns the schema of an Amazon S3
+// audit event streaming destination, extended by the given attributes.
+func gitlabAuditEventStreamingAmazonS3Schema(extra map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Description: "The name of the destination. Defaults to a name generated by GitLab.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"access_key_xid": {
+			Description: "The ID of the AWS access key used to upload the audit events.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"secret_access_key": {
+			Description: "The secret of the AWS access key used to upload the audit events.",
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+		},
+		"bucket_name": {
+			Description: "The name of the Amazon S3 bucket the audit events are uploaded to.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"aws_region": {
+			Description: "The AWS region of the Amazon S3 bucket, e.g. `us-east-1`.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+	}
+	for k, v := range extra {
+		s[k] = v
+	}
+	return s
+}
+
+func resourceGitlabGroupAuditEventStreamingAmazonS3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabGroupAuditEventStreamingCreate(ctx, d, meta, gitlabGroupAuditEventStreamingAmazonS3API, gitlabAuditEventStreamingAmazonS3Fields)
+}
+
+func resourceGitlabGroupAuditEventStreamingAmazonS3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabGroupAuditEventStreamingRead(ctx, d, meta, gitlabGroupAuditEventStreamingAmazonS3API, gitlabAuditEventStreamingAmazonS3Fields)
+}
+
+func resourceGitlabGroupAuditEventStreamingAmazonS3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabGroupAuditEventStreamingUpdate(ctx, d, meta, gitlabGroupAuditEventStreamingAmazonS3API, gitlabAuditEventStreamingAmazonS3Fields)
+}
+
+func resourceGitlabGroupAuditEventStreamingAmazonS3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabGroupAuditEventStreamingDelete(ctx, d, meta, gitlabGroupAuditEventStreamingAmazonS3API)
+}
@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// auditEventStreamingDestinationAPI describes the GraphQL API of HTTP audit event streaming destinations,
+// including their custom headers and event type filters.
+type auditEventStreamingDestinationAPI struct {
+	auditEventStreamingAPI
+
+	headerCreateMutation  string
+	headerUpdateMutation  string
+	headerDestroyMutation string
+	eventsAddMutation     string
+	eventsRemoveMutation  string
+}
+
+var gitlabGroupAuditEventStreamingDestinationAPI = auditEventStreamingDestinationAPI{
+	auditEventStreamingAPI: auditEventStreamingAPI{
+		gidType:         "AuditEvents::ExternalAuditEventDestination",
+		listField:       "externalAuditEventDestinations",
+		selection:       "name destinationUrl verificationToken eventTypeFilters headers { nodes { id key value } }",
+		createMutation:  "externalAuditEventDestinationCreate",
+		updateMutation:  "externalAuditEventDestinationUpdate",
+		destroyMutation: "externalAuditEventDestinationDestroy",
+		payloadField:    "externalAuditEventDestination",
+	},
+	headerCreateMutation:  "auditEventsStreamingHeadersCreate",
+	headerUpdateMutation:  "auditEventsStreamingHeadersUpdate",
+	headerDestroyMutation: "auditEventsStreamingHeadersDestroy",
+	eventsAddMutation:     "auditEventsStreamingDestinationEventsAdd",
+	eventsRemoveMutation:  "auditEventsStreamingDestinationEventsRemove",
+}
+
+var _ = registerResource("gitlab_group_audit_event_streaming_destination", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_audit_event_streaming_destination`" + ` resource allows to manage the lifecycle of an HTTP destination streaming the audit events of a top-level group.
+
+-> This resource requires GitLab Ultimate.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#mutationexternalauditeventdestinationcreate)`,
+
+		CreateContext: resourceGitlabGroupAuditEventStreamingDestinationCreate,
+		ReadContext:   resourceGitlabGroupAuditEventStreamingDestinationRead,
+		UpdateContext: resourceGitlabGroupAuditEventStreamingDestinationUpdate,
+		DeleteContext: resourceGitlabGroupAuditEventStreamingDestinationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: gitlabAuditEventStreamingDestinationSchema(map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the top-level group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		}),
+	}
+})
+
+// gitlabAuditEventStreamingDestinationSchema returns the schema of an HTTP audit event streaming destination,
+// extended by the given attributes.
+func gitlabAuditEventStreamingDestinationSchema(extra map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Description: "The name of the destination. Defaults to a name generated by GitLab.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"destination_url": {
+			Description:  "The URL the audit events are streamed to.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateURLFunc,
+		},
+		"verification_token": {
+			Description: "The token GitLab sends in the `X-Gitlab-Event-Streaming-Token` header, to verify the authenticity of the streamed audit events.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"headers": {
+			Description: "The custom HTTP headers sent with the streamed audit events.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Sensitive:   true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"event_type_filters": {
+			Description: "The audit event types to stream. All audit events are streamed if not set.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	for k, v := range extra {
+		s[k] = v
+	}
+	return s
+}
+
+type gitlabAuditEventStreamingDestination struct {
+	Name              string   `json:"name"`
+	DestinationURL    string   `json:"destinationUrl"`
+	VerificationToken string   `json:"verificationToken"`
+	EventTypeFilters  []string `json:"eventTypeFilters"`
+	Headers           struct {
+		Nodes []struct {
+			ID    string `json:"id"`
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"nodes"`
+	} `json:"headers"`
+}
+
+func resourceGitlabGroupAuditEventStreamingDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+
+	groupPath, err := auditEventStreamingGroupPath(ctx, client, group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] create audit event streaming destination for gitlab group %s", groupPath)
+	id, err := resourceGitlabAuditEventStreamingDestinationCreate(ctx, client, d, gitlabGroupAuditEventStreamingDestinationAPI, map[string]interface{}{"groupPath": groupPath})
+	if id != "" {
+		d.SetId(buildTwoPartID(&group, &id))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabGroupAuditEventStreamingDestinationRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupAuditEventStreamingDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, id, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read audit event streaming destination %s of gitlab group %s", id, group)
+
+	groupPath, err := auditEventStreamingGroupPath(ctx, client, group)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab group %s not found, removing audit event streaming destination from state", group)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("group", group)
+	return resourceGitlabAuditEventStreamingDestinationRead(ctx, client, d, gitlabGroupAuditEventStreamingDestinationAPI, groupPath, id)
+}
+
+func resourceGitlabGroupAuditEventStreamingDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, id, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupPath, err := auditEventStreamingGroupPath(ctx, client, group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] update audit event streaming destination %s", d.Id())
+	if err := resourceGitlabAuditEventStreamingDestinationUpdate(ctx, client, d, gitlabGroupAuditEventStreamingDestinationAPI, groupPath, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabGroupAuditEventStreamingDestinationRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupAuditEventStreamingDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabGroupAuditEventStreamingDelete(ctx, d, meta, gitlabGroupAuditEventStreamingDestinationAPI.auditEventStreamingAPI)
+}
+
+// resourceGitlabAuditEventStreamingDestinationCreate creates an HTTP audit event streaming destination
+// with its custom headers and event type filters, and returns its numeric ID.
+// The ID is returned even if configuring the headers or event type filters fails,
+// so that the destination can be tainted.
+func resourceGitlabAuditEventStreamingDestinationCreate(ctx context.Context, client *gitlab.Client, d *schema.ResourceData, api auditEventStreamingDestinationAPI, input map[string]interface{}) (string, error) {
+	input["destinationUrl"] = d.Get("destination_url").(string)
+	if v, ok := d.GetOk("name"); ok {
+		input["name"] = v.(string)
+	}
+
+	gid, err := auditEventStreamingMutate(ctx, client, api.createMutation, input, api.payloadField)
+	if err != nil {
+		return "", err
+	}
+	id := auditEventStreamingDestinationID(gid)
+
+	for key, value := range d.Get("headers").(map[string]interface{}) {
+		if _, err := auditEventStreamingMutate(ctx, client, api.headerCreateMutation, map[string]interface{}{
+			"destinationId": gid,
+			"key":           key,
+			"value":         value.(string),
+		}, "header"); err != nil {
+			return id, err
+		}
+	}
+
+	if filters := *stringSetToStringSlice(d.Get("event_type_filters").(*schema.Set)); len(filters) > 0 {
+		if _, err := auditEventStreamingMutate(ctx, client, api.eventsAddMutation, map[string]interface{}{
+			"destinationId":    gid,
+			"eventTypeFilters": filters,
+		}, ""); err != nil {
+			return id, err
+		}
+	}
+
+	return id, nil
+}
+
+func resourceGitlabAuditEventStreamingDestinationRead(ctx context.Context, client *gitlab.Client, d *schema.ResourceData, api auditEventStreamingDestinationAPI, groupPath string, id string) diag.Diagnostics {
+	node, err := auditEventStreamingFind(ctx, client, api.auditEventStreamingAPI, groupPath, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if node == nil {
+		log.Printf("[DEBUG] audit event streaming destination %s not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	var destination gitlabAuditEventStreamingDestination
+	if err := json.Unmarshal(node, &destination); err != nil {
+		return diag.FromErr(err)
+	}
+
+	headers := make(map[string]interface{}, len(destination.Headers.Nodes))
+	for _, header := range destination.Headers.Nodes {
+		headers[header.Key] = header.Value
+	}
+
+	d.Set("name", destination.Name)
+	d.Set("destination_url", destination.DestinationURL)
+	d.Set("verification_token", destination.VerificationToken)
+	d.Set("headers", headers)
+	d.Set("event_type_filters", destination.EventTypeFilters)
+	return nil
+}
+
+func resourceGitlabAuditEventStreamingDestinationUpdate(ctx context.Context, client *gitlab.Client, d *schema.ResourceData, api auditEventStreamingDestinationAPI, groupPath string, id string) error {
+	gid := api.gid(id)
+
+	if d.HasChanges("name", "destination_url") {
+		input := map[string]interface{}{"id": gid}
+		if d.HasChange("name") {
+			input["name"] = d.Get("name").(string)
+		}
+		if d.HasChange("destination_url") {
+			input["destinationUrl"] = d.Get("destination_url").(string)
+		}
+		if _, err := auditEventStreamingMutate(ctx, client, api.updateMutation, input, api.payloadField); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("headers") {
+		// The headers are identified by their global ID, thus we look them up by their key.
+		node, err := auditEventStreamingFind(ctx, client, api.auditEventStreamingAPI, groupPath, id)
+		if err != nil {
+			return err
+		}
+		var destination gitlabAuditEventStreamingDestination
+		if node != nil {
+			if err := json.Unmarshal(node, &destination); err != nil {
+				return err
+			}
+		}
+
+		headers := d.Get("headers").(map[string]interface{})
+		for _, header := range destination.Headers.Nodes {
+			value, ok := headers[header.Key]
+			if !ok {
+				if _, err := auditEventStreamingMutate(ctx, client, api.headerDestroyMutation, map[string]interface{}{"headerId": header.ID}, ""); err != nil {
+					return err
+				}
+				continue
+			}
+			delete(headers, header.Key)
+			if value.(string) != header.Value {
+				if _, err := auditEventStreamingMutate(ctx, client, api.headerUpdateMutation, map[string]interface{}{
+					"headerId": header.ID,
+					"key":      header.Key,
+					"value":    value.(string),
+				}, "header"); err != nil {
+					return err
+				}
+			}
+		}
+		for key, value := range headers {
+			if _, err := auditEventStreamingMutate(ctx, client, api.headerCreateMutation, map[string]interface{}{
+				"destinationId": gid,
+				"key":           key,
+				"value":         value.(string),
+			}, "header"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("event_type_filters") {
+		o, n := d.GetChange("event_type_filters")
+		if removed := *stringSetToStringSlice(o.(*schema.Set).Difference(n.(*schema.Set))); len(removed) > 0 {
+			if _, err := auditEventStreamingMutate(ctx, client, api.eventsRemoveMutation, map[string]interface{}{
+				"destinationId":    gid,
+				"eventTypeFilters": removed,
+			}, ""); err != nil {
+				return err
+			}
+		}
+		if added := *stringSetToStringSlice(n.(*schema.Set).Difference(o.(*schema.Set))); len(added) > 0 {
+			if _, err := auditEventStreamingMutate(ctx, client, api.eventsAddMutation, map[string]interface{}{
+				"destinationId":    gid,
+				"eventTypeFilters": added,
+			}, ""); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabGroupAuditEventStreamingDestination_basic(t *testing.T) {
+	testAccCheck(t)
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabAuditEventStreamingDestroy("gitlab_group_audit_event_streaming_destination", gitlabGroupAuditEventStreamingDestinationAPI.auditEventStreamingAPI),
+		Steps: []resource.TestStep{
+			// Create a destination with defaults
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_audit_event_streaming_destination" "this" {
+						group           = %d
+						destination_url = "https://siem.example.com/audit-events"
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_group_audit_event_streaming_destination.this", "name"),
+					resource.TestCheckResourceAttrSet("gitlab_group_audit_event_streaming_destination.this", "verification_token"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_group_audit_event_streaming_destination.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Add headers and event type filters
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_audit_event_streaming_destination" "this" {
+						group           = %d
+						name            = "SIEM"
+						destination_url = "https://siem.example.com/gitlab/audit-events"
+
+						headers = {
+							"Authorization" = "Bearer secret"
+							"X-Source"      = "gitlab"
+						}
+						event_type_filters = ["user_created", "project_created"]
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_audit_event_streaming_destination.this", "name", "SIEM"),
+					resource.TestCheckResourceAttr("gitlab_group_audit_event_streaming_destination.this", "headers.%", "2"),
+					resource.TestCheckResourceAttr("gitlab_group_audit_event_streaming_destination.this", "event_type_filters.#", "2"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_group_audit_event_streaming_destination.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and remove headers and event type filters
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_audit_event_streaming_destination" "this" {
+						group           = %d
+						name            = "SIEM"
+						destination_url = "https://siem.example.com/gitlab/audit-events"
+
+						headers = {
+							"Authorization" = "Bearer rotated"
+						}
+						event_type_filters = ["user_created"]
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_audit_event_streaming_destination.this", "headers.%", "1"),
+					resource.TestCheckResourceAttr("gitlab_group_audit_event_streaming_destination.this", "headers.Authorization", "Bearer rotated"),
+					resource.TestCheckResourceAttr("gitlab_group_audit_event_streaming_destination.this", "event_type_filters.#", "1"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_group_audit_event_streaming_destination.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabAuditEventStreamingDestroy(resourceType string, api auditEventStreamingAPI) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			var groupPath string
+			id := rs.Primary.ID
+			if group, ok := rs.Primary.Attributes["group"]; ok {
+				var err error
+				if _, id, err = parseTwoPartID(rs.Primary.ID); err != nil {
+					return err
+				}
+				if groupPath, err = auditEventStreamingGroupPath(context.Background(), testGitlabClient, group); err != nil {
+					if is404(err) {
+						continue
+					}
+					return err
+				}
+			}
+
+			node, err := auditEventStreamingFind(context.Background(), testGitlabClient, api, groupPath, id)
+			if err != nil {
+				return err
+			}
+			if node != nil {
+				return fmt.Errorf("audit event streaming destination %s still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitlabAuditEventStreamingGoogleCloudLoggingFields = []auditEventStreamingField{
+	{attribute: "name", field: "name"},
+	{attribute: "google_project_id_name", field: "googleProjectIdName"},
+	{attribute: "client_email", field: "clientEmail"},
+	{attribute: "private_key", field: "privateKey", secret: true},
+	{attribute: "log_id_name", field: "logIdName"},
+}
+
+var gitlabGroupAuditEventStreamingGoogleCloudLoggingAPI = auditEventStreamingAPI{
+	gidType:         "AuditEvents::GoogleCloudLoggingConfiguration",
+	listField:       "googleCloudLoggingConfigurations",
+	selection:       auditEventStreamingSelection(gitlabAuditEventStreamingGoogleCloudLoggingFields),
+	createMutation:  "googleCloudLoggingConfigurationCreate",
+	updateMutation:  "googleCloudLoggingConfigurationUpdate",
+	destroyMutation: "googleCloudLoggingConfigurationDestroy",
+	payloadField:    "googleCloudLoggingConfiguration",
+}
+
+var _ = registerResource("gitlab_group_audit_event_streaming_google_cloud_logging", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_audit_event_streaming_google_cloud_logging`" + ` resource allows to manage the lifecycle of a Google Cloud Logging destination streaming the audit events of a top-level group.
+
+-> This resource requires GitLab Ultimate.
+
+~> The ` + "`private_key`" + ` is a secret and not returned by the GitLab API, thus changes made to it outside of Terraform are not detected.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#mutationgooglecloudloggingconfigurationcreate)`,
+
+		CreateContext: resourceGitlabGroupAuditEventStreamingGoogleCloudLoggingCreate,
+		ReadContext:   resourceGitlabGroupAuditEventStreamingGoogleCloudLoggingRead,
+		UpdateContext: resourceGitlabGroupAuditEventStreamingGoogleCloudLoggingUpdate,
+		DeleteContext: resourceGitlabGroupAuditEventStreamingGoogleCloudLoggingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: gitlabAuditEventStreamingGoogleCloudLoggingSchema(map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the top-level group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		}),
+	}
+})
+
+// gitlabAuditEventStreamingGoogleCloudLoggingSchema returns the schema of a Google Cloud Logging
+// audit event streaming destination, extended by the given attributes.
+func gitlabAuditEventStreamingGoogleCloudLoggingSchema(extra map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"name": {
+			Description: "The name of the destination. Defaults to a name generated by GitLab.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"google_project_id_name": {
+			Description: "The ID of the Google Cloud project the audit events are logged to.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"client_email": {
+			Description: "The email of the Google Cloud service account used to log the audit events.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"private_key": {
+			Description: "The private key of the Google Cloud service account used to log the audit events.",
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+		},
+		"log_id_name": {
+			Description: "The ID of the log the audit events are written to. Defaults to `audit_events`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+	}
+	for k, v := range extra {
+		s[k] = v
+	}
+	return s
+}
+
+func resourceGitlabGroupAuditEventStreamingGoogleCloudLoggingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabGroupAuditEventStreamingCreate(ctx, d, meta, gitlabGroupAuditEventStreamingGoogleCloudLoggingAPI, gitlabAuditEventStreamingGoogleCloudLoggingFields)
+}
+
+func resourceGitlabGroupAuditEventStreamingGoogleCloudLoggingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabGroupAuditEventStreamingRead(ctx, d, meta, gitlabGroupAuditEventStreamingGoogleCloudLoggingAPI, gitlabAuditEventStreamingGoogleCloudLoggingFields)
+}
+
+func resourceGitlabGroupAuditEventStreamingGoogleCloudLoggingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabGroupAuditEventStreamingUpdate(ctx, d, meta, gitlabGroupAuditEventStreamingGoogleCloudLoggingAPI, gitlabAuditEventStreamingGoogleCloudLoggingFields)
+}
+
+func resourceGitlabGroupAuditEventStreamingGoogleCloudLoggingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabGroupAuditEventStreamingDelete(ctx, d, meta, gitlabGroupAuditEventStreamingGoogleCloudLoggingAPI)
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var validGroupDependencyProxyTTLs = []int{1, 7, 14, 30, 90}
+
+var _ = registerResource("gitlab_group_dependency_proxy", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_dependency_proxy`" + ` resource allows to manage the dependency proxy settings and the cache cleanup policy of a top-level group.
+
+~> Destroying this resource doesn't change the settings of the group, it only removes them from the Terraform state.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#mutationupdatedependencyproxysettings)`,
+
+		CreateContext: resourceGitlabGroupDependencyProxyCreate,
+		ReadContext:   resourceGitlabGroupDependencyProxyRead,
+		UpdateContext: resourceGitlabGroupDependencyProxyUpdate,
+		DeleteContext: resourceGitlabGroupDependencyProxyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the top-level group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Description: "Whether the dependency proxy is enabled for the group.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"ttl_policy_enabled": {
+				Description: "Whether the cache cleanup policy is enabled, which removes cached images not pulled within `ttl` days.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"ttl": {
+				Description:  "The number of days to keep cached images which haven't been pulled. Valid values are: `1`, `7`, `14`, `30`, `90`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      90,
+				ValidateFunc: validation.IntInSlice(validGroupDependencyProxyTTLs),
+			},
+			"purge_cache_triggers": {
+				Description: "Arbitrary map of values that, when changed, purges the dependency proxy cache of the group.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+})
+
+func resourceGitlabGroupDependencyProxyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("group").(string))
+	return resourceGitlabGroupDependencyProxyUpdate(ctx, d, meta)
+}
+
+func resourceGitlabGroupDependencyProxyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] read dependency proxy settings of gitlab group %s", d.Id())
+
+	group, _, err := client.Groups.GetGroup(d.Id(), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab group %s not found, removing dependency proxy settings from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var data struct {
+		Group *struct {
+			DependencyProxySetting *struct {
+				Enabled bool `json:"enabled"`
+			} `json:"dependencyProxySetting"`
+			DependencyProxyImageTtlPolicy *struct {
+				Enabled bool `json:"enabled"`
+				TTL     int  `json:"ttl"`
+			} `json:"dependencyProxyImageTtlPolicy"`
+		} `json:"group"`
+	}
+	query := `query($fullPath: ID!) {
+		group(fullPath: $fullPath) {
+			dependencyProxySetting { enabled }
+			dependencyProxyImageTtlPolicy { enabled ttl }
+		}
+	}`
+	if err := graphQLClientFor(client).query(ctx, query, map[string]interface{}{"fullPath": group.FullPath}, &data); err != nil {
+		return diag.FromErr(err)
+	}
+	if data.Group == nil {
+		return diag.Errorf("gitlab group %s not found in GraphQL API", group.FullPath)
+	}
+
+	d.Set("group", d.Id())
+	if data.Group.DependencyProxySetting != nil {
+		d.Set("enabled", data.Group.DependencyProxySetting.Enabled)
+	}
+	if data.Group.DependencyProxyImageTtlPolicy != nil {
+		d.Set("ttl_policy_enabled", data.Group.DependencyProxyImageTtlPolicy.Enabled)
+		d.Set("ttl", data.Group.DependencyProxyImageTtlPolicy.TTL)
+	}
+	return nil
+}
+
+func resourceGitlabGroupDependencyProxyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	group, _, err := client.Groups.GetGroup(d.Id(), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] update dependency proxy settings of gitlab group %s", group.FullPath)
+	graphQL := graphQLClientFor(client)
+
+	settingsMutation := `mutation($groupPath: ID!, $enabled: Boolean) {
+		updateDependencyProxySettings(input: { groupPath: $groupPath, enabled: $enabled }) { errors }
+	}`
+	if err := graphQL.mutate(ctx, settingsMutation, map[string]interface{}{
+		"groupPath": group.FullPath,
+		"enabled":   d.Get("enabled").(bool),
+	}, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyMutation := `mutation($groupPath: ID!, $enabled: Boolean, $ttl: Int) {
+		updateDependencyProxyImageTtlGroupPolicy(input: { groupPath: $groupPath, enabled: $enabled, ttl: $ttl }) { errors }
+	}`
+	if err := graphQL.mutate(ctx, policyMutation, map[string]interface{}{
+		"groupPath": group.FullPath,
+		"enabled":   d.Get("ttl_policy_enabled").(bool),
+		"ttl":       d.Get("ttl").(int),
+	}, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !d.IsNewResource() && d.HasChange("purge_cache_triggers") {
+		log.Printf("[DEBUG] purge dependency proxy cache of gitlab group %s", group.FullPath)
+		if err := resourceGitlabGroupDependencyProxyPurgeCache(ctx, client, group.ID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGitlabGroupDependencyProxyRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupDependencyProxyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] remove dependency proxy settings of gitlab group %s from state", d.Id())
+	return nil
+}
+
+// resourceGitlabGroupDependencyProxyPurgeCache schedules the removal of all cached blobs and manifests of a group.
+// NOTE: go-gitlab has no service for the dependency proxy API.
+func resourceGitlabGroupDependencyProxyPurgeCache(ctx context.Context, client *gitlab.Client, groupID int) error {
+	u := fmt.Sprintf("groups/%d/dependency_proxy/cache", groupID)
+	req, err := client.NewRequest(http.MethodDelete, u, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGitlabGroupDependencyProxy_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Configure the dependency proxy with defaults
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_dependency_proxy" "this" {
+						group = %d
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_dependency_proxy.this", "enabled", "true"),
+					resource.TestCheckResourceAttr("gitlab_group_dependency_proxy.this", "ttl_policy_enabled", "false"),
+					resource.TestCheckResourceAttr("gitlab_group_dependency_proxy.this", "ttl", "90"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_group_dependency_proxy.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Enable the cache cleanup policy and purge the cache
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_dependency_proxy" "this" {
+						group              = %d
+						ttl_policy_enabled = true
+						ttl                = 7
+
+						purge_cache_triggers = {
+							purged_at = "2024-01-01"
+						}
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_dependency_proxy.this", "ttl_policy_enabled", "true"),
+					resource.TestCheckResourceAttr("gitlab_group_dependency_proxy.this", "ttl", "7"),
+				),
+			},
+			// Disable the dependency proxy
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_dependency_proxy" "this" {
+						group   = %d
+						enabled = false
+					}
+				`, testGroup.ID),
+				Check: resource.TestCheckResourceAttr("gitlab_group_dependency_proxy.this", "enabled", "false"),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_group_dependency_proxy.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"purge_cache_triggers"},
+			},
+		},
+	})
+}
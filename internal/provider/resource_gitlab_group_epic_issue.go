@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_group_epic_issue", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_epic_issue`" + ` resource allows to assign an issue to an epic.
+
+-> This resource requires a GitLab Enterprise instance with a Premium license. An issue can only be assigned to one epic at a time, assigning it to another epic moves it.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/epic_issues.html)`,
+
+		CreateContext: resourceGitlabGroupEpicIssueCreate,
+		ReadContext:   resourceGitlabGroupEpicIssueRead,
+		DeleteContext: resourceGitlabGroupEpicIssueDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group owning the epic.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"epic_iid": {
+				Description: "The internal ID of the epic.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"issue_id": {
+				Description: "The global ID of the issue, e.g. the `issue_id` attribute of the `gitlab_project_issue` resource.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"epic_issue_id": {
+				Description: "The ID of the association between the epic and the issue.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabGroupEpicIssueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group := d.Get("group").(string)
+	epicIID := d.Get("epic_iid").(int)
+	issueID := d.Get("issue_id").(int)
+
+	log.Printf("[DEBUG] assign issue %d to epic %d of gitlab group %s", issueID, epicIID, group)
+
+	if _, _, err := client.EpicIssues.AssignEpicIssue(group, epicIID, issueID, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resourceGitlabGroupEpicIssueBuildID(group, epicIID, issueID))
+	return resourceGitlabGroupEpicIssueRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupEpicIssueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, epicIID, issueID, err := resourceGitlabGroupEpicIssueParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read assignment of issue %d to epic %d of gitlab group %s", issueID, epicIID, group)
+
+	issue, err := resourceGitlabGroupEpicIssueFind(ctx, client, group, epicIID, issueID)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] epic %d of gitlab group %s not found, removing epic issue from state", epicIID, group)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if issue == nil {
+		log.Printf("[DEBUG] issue %d is not assigned to epic %d of gitlab group %s, removing from state", issueID, epicIID, group)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group", group)
+	d.Set("epic_iid", epicIID)
+	d.Set("issue_id", issue.ID)
+	d.Set("epic_issue_id", issue.EpicIssueID)
+	return nil
+}
+
+func resourceGitlabGroupEpicIssueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, epicIID, issueID, err := resourceGitlabGroupEpicIssueParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] remove issue %d from epic %d of gitlab group %s", issueID, epicIID, group)
+
+	if _, _, err := client.EpicIssues.RemoveEpicIssue(group, epicIID, d.Get("epic_issue_id").(int), gitlab.WithContext(ctx)); err != nil {
+		if is404(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceGitlabGroupEpicIssueFind searches the issues of an epic
+// and returns nil if the issue isn't assigned to the epic.
+func resourceGitlabGroupEpicIssueFind(ctx context.Context, client *gitlab.Client, group string, epicIID, issueID int) (*gitlab.Issue, error) {
+	options := &gitlab.ListOptions{PerPage: 100, Page: 1}
+	for options.Page != 0 {
+		issues, resp, err := client.EpicIssues.ListEpicIssues(group, epicIID, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range issues {
+			if issue.ID == issueID {
+				return issue, nil
+			}
+		}
+		options.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
+
+func resourceGitlabGroupEpicIssueParseID(id string) (string, int, int, error) {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 {
+		return "", 0, 0, fmt.Errorf("Unexpected ID format (%q). Expected group:epic_iid:issue_id", id)
+	}
+
+	epicIID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("failed to parse epic IID %q: %w", parts[1], err)
+	}
+	issueID, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("failed to parse issue ID %q: %w", parts[2], err)
+	}
+	return parts[0], epicIID, issueID, nil
+}
+
+func resourceGitlabGroupEpicIssueBuildID(group string, epicIID, issueID int) string {
+	return fmt.Sprintf("%s:%d:%d", group, epicIID, issueID)
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupEpicIssue_basic(t *testing.T) {
+	testAccCheck(t)
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	testProject := testAccCreateProjectWithNamespace(t, testGroup.ID)
+	testIssues := testAccCreateProjectIssues(t, testProject.ID, 2)
+	testEpic, _, err := testGitlabClient.Epics.CreateEpic(testGroup.ID, &gitlab.CreateEpicOptions{
+		Title: gitlab.String("Test Epic"),
+	})
+	if err != nil {
+		t.Fatalf("could not create test epic: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupEpicIssueDestroy,
+		Steps: []resource.TestStep{
+			// Assign an issue to the epic
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_epic_issue" "this" {
+						group    = %d
+						epic_iid = %d
+						issue_id = %d
+					}
+				`, testGroup.ID, testEpic.IID, testIssues[0].ID),
+				Check: resource.TestCheckResourceAttrSet("gitlab_group_epic_issue.this", "epic_issue_id"),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_group_epic_issue.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Assign another issue to the epic
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_epic_issue" "this" {
+						group    = %d
+						epic_iid = %d
+						issue_id = %d
+					}
+				`, testGroup.ID, testEpic.IID, testIssues[1].ID),
+				Check: resource.TestCheckResourceAttr("gitlab_group_epic_issue.this", "issue_id", fmt.Sprintf("%d", testIssues[1].ID)),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_group_epic_issue.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupEpicIssueDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group_epic_issue" {
+			continue
+		}
+
+		group, epicIID, issueID, err := resourceGitlabGroupEpicIssueParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		issue, err := resourceGitlabGroupEpicIssueFind(context.Background(), testGitlabClient, group, epicIID, issueID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if issue != nil {
+			return fmt.Errorf("issue %d is still assigned to epic %d of group %s", issueID, epicIID, group)
+		}
+	}
+	return nil
+}
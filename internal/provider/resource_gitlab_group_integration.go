@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_group_integration", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_integration`" + ` resource allows to manage the lifecycle of an arbitrary group integration, identified by its slug. Group integrations are inherited by all projects and subgroups of the group, unless they override the settings.
+
+-> The settings of instance-level integrations are not exposed by the GitLab REST API and thus can't be managed with Terraform.
+
+~> The values of ` + "`sensitive_settings`" + ` are secrets and are never read back from the GitLab API, thus changes made to them outside of Terraform are not detected.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_integrations.html)`,
+
+		CreateContext: resourceGitlabGroupIntegrationCreate,
+		ReadContext:   resourceGitlabGroupIntegrationRead,
+		UpdateContext: resourceGitlabGroupIntegrationUpdate,
+		DeleteContext: resourceGitlabGroupIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: constructSchema(
+			map[string]*schema.Schema{
+				"group": {
+					Description: "ID or full path of the group you want to activate the integration on.",
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+				},
+			},
+			gitlabIntegrationSettingsSchema(),
+		),
+	}
+})
+
+func resourceGitlabGroupIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	group := d.Get("group").(string)
+	slug := d.Get("integration_slug").(string)
+	d.SetId(buildTwoPartID(&group, &slug))
+
+	return resourceGitlabGroupIntegrationUpdate(ctx, d, meta)
+}
+
+func resourceGitlabGroupIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, slug, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read gitlab %s integration for group %s", slug, group)
+
+	integration, err := gitlabIntegrationGet(ctx, client, resourceGitlabGroupIntegrationURL(group, slug))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab %s integration not found for group %s, removing from state", slug, group)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// NOTE: a deleted integration is just deactivated, but its settings are still returned.
+	if active, _ := integration["active"].(bool); !active {
+		log.Printf("[DEBUG] gitlab %s integration is not active for group %s, removing from state", slug, group)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group", group)
+	d.Set("integration_slug", slug)
+	d.Set("settings", flattenGitlabIntegrationSettings(integration, d.Get("settings").(map[string]interface{})))
+	d.Set("active", true)
+	return nil
+}
+
+func resourceGitlabGroupIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, slug, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] set gitlab %s integration for group %s", slug, group)
+
+	settings, err := gitlabIntegrationSettingsFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := gitlabIntegrationSet(ctx, client, resourceGitlabGroupIntegrationURL(group, slug), settings); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabGroupIntegrationRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	group, slug, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete gitlab %s integration for group %s", slug, group)
+
+	if err := gitlabIntegrationDelete(ctx, client, resourceGitlabGroupIntegrationURL(group, slug)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabGroupIntegrationURL(group, slug string) string {
+	return fmt.Sprintf("groups/%s/integrations/%s", gitlab.PathEscape(group), gitlab.PathEscape(slug))
+}
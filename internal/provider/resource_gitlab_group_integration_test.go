@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabGroupIntegration_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	integrationResourceName := "gitlab_group_integration.asana"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupIntegrationDestroy,
+		Steps: []resource.TestStep{
+			// Create an asana integration through the generic resource
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_integration" "asana" {
+						group            = %d
+						integration_slug = "asana"
+						sensitive_settings = {
+							api_key = "0123456789"
+						}
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(integrationResourceName, "active", "true"),
+					resource.TestCheckResourceAttr(integrationResourceName, "sensitive_settings.api_key", "0123456789"),
+				),
+			},
+			// Update the asana integration
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_integration" "asana" {
+						group            = %d
+						integration_slug = "asana"
+						settings = {
+							restrict_to_branch = "main"
+						}
+						sensitive_settings = {
+							api_key = "9876543210"
+						}
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(integrationResourceName, "sensitive_settings.api_key", "9876543210"),
+					resource.TestCheckResourceAttr(integrationResourceName, "settings.restrict_to_branch", "main"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            integrationResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings", "sensitive_settings"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupIntegrationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group_integration" {
+			continue
+		}
+
+		group, slug, err := parseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		integration, err := gitlabIntegrationGet(context.Background(), testGitlabClient, resourceGitlabGroupIntegrationURL(group, slug))
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if active, _ := integration["active"].(bool); active {
+			return fmt.Errorf("%s integration for group %s still exists", slug, group)
+		}
+	}
+	return nil
+}
@@ -110,7 +110,7 @@ func resourceGitlabGroupLabelUpdate(ctx context.Context, d *schema.ResourceData,
 
 	log.Printf("[DEBUG] update gitlab group label %s", d.Id())
 
-	_, _, err := client.GroupLabels.UpdateGroupLabel(group, options, gitlab.WithContext(ctx))
+	_, _, err := client.GroupLabels.UpdateGroupLabel(group, nil, options, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -126,7 +126,7 @@ func resourceGitlabGroupLabelDelete(ctx context.Context, d *schema.ResourceData,
 		Name: gitlab.String(d.Id()),
 	}
 
-	_, err := client.GroupLabels.DeleteGroupLabel(group, options, gitlab.WithContext(ctx))
+	_, err := client.GroupLabels.DeleteGroupLabel(group, nil, options, gitlab.WithContext(ctx))
 	return diag.FromErr(err)
 }
 
@@ -138,12 +138,12 @@ func resourceGitlabGroupLabelImporter(ctx context.Context, d *schema.ResourceDat
 	}
 
 	d.SetId(parts[1])
-	group, _, err := client.Groups.GetGroup(parts[0], nil, gitlab.WithContext(ctx))
+	groupID, err := gitlabGroupID(ctx, client, parts[0])
 	if err != nil {
 		return nil, err
 	}
 
-	if err := d.Set("group", strconv.Itoa(group.ID)); err != nil {
+	if err := d.Set("group", strconv.Itoa(groupID)); err != nil {
 		return nil, err
 	}
 
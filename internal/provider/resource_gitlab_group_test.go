@@ -224,6 +224,56 @@ func TestAccGitlabGroup_nested(t *testing.T) {
 	})
 }
 
+func TestAccGitlabGroup_transfer(t *testing.T) {
+	testAccCheck(t)
+
+	parents := testAccCreateGroups(t, 2)
+	rInt := acctest.RandInt()
+
+	var groupID string
+	config := func(parentID int) string {
+		return fmt.Sprintf(`
+resource "gitlab_group" "this" {
+  name      = "transfer-%[1]d"
+  path      = "transfer-%[1]d"
+  parent_id = %[2]d
+}
+`, rInt, parentID)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config(parents[0].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group.this", "full_path", fmt.Sprintf("%s/transfer-%d", parents[0].FullPath, rInt)),
+					func(s *terraform.State) error {
+						groupID = s.RootModule().Resources["gitlab_group.this"].Primary.ID
+						return nil
+					},
+				),
+			},
+			// Transfer the group to another parent group without re-creating it
+			{
+				Config: config(parents[1].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group.this", "parent_id", fmt.Sprintf("%d", parents[1].ID)),
+					resource.TestCheckResourceAttr("gitlab_group.this", "full_path", fmt.Sprintf("%s/transfer-%d", parents[1].FullPath, rInt)),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["gitlab_group.this"].Primary.ID; id != groupID {
+							return fmt.Errorf("expected group %s to be transferred, but it has been re-created as group %s", groupID, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccGitlabGroup_disappears(t *testing.T) {
 	var group gitlab.Group
 	rInt := acctest.RandInt()
@@ -245,6 +295,23 @@ func TestAccGitlabGroup_disappears(t *testing.T) {
 	})
 }
 
+func TestAccGitlabGroup_permanentlyRemoveOnDestroy(t *testing.T) {
+	var group gitlab.Group
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupPermanentlyRemovedOnDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabGroupConfigPermanentlyRemoveOnDestroy(rInt),
+				Check:  testAccCheckGitlabGroupExists("gitlab_group.foo", &group),
+			},
+		},
+	})
+}
+
 func TestAccGitlabGroup_PreventForkingOutsideGroup(t *testing.T) {
 	var group gitlab.Group
 	rInt := acctest.RandInt()
@@ -274,9 +341,90 @@ func TestAccGitlabGroup_PreventForkingOutsideGroup(t *testing.T) {
 	})
 }
 
+func TestAccGitlabGroup_ipRestrictionAndAllowedEmailDomains(t *testing.T) {
+	var group gitlab.Group
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				SkipFunc: isRunningInCE,
+				Config: fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name             = "foo-name-%d"
+  path             = "foo-path-%d"
+  visibility_level = "public"
+
+  # Don't lock out the acceptance tests
+  ip_restriction_ranges      = ["0.0.0.0/0", "::/0"]
+  allowed_email_domains_list = ["example.com", "example.org"]
+}
+`, rInt, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupExists("gitlab_group.foo", &group),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "ip_restriction_ranges.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "allowed_email_domains_list.#", "2"),
+				),
+			},
+			{
+				SkipFunc:          isRunningInCE,
+				ResourceName:      "gitlab_group.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				SkipFunc: isRunningInCE,
+				Config: fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name             = "foo-name-%d"
+  path             = "foo-path-%d"
+  visibility_level = "public"
+
+  ip_restriction_ranges      = ["0.0.0.0/0"]
+  allowed_email_domains_list = ["example.com"]
+}
+`, rInt, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupExists("gitlab_group.foo", &group),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "ip_restriction_ranges.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "allowed_email_domains_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr("gitlab_group.foo", "allowed_email_domains_list.*", "example.com"),
+				),
+			},
+			// Clear the restrictions
+			{
+				SkipFunc: isRunningInCE,
+				Config: fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name             = "foo-name-%d"
+  path             = "foo-path-%d"
+  visibility_level = "public"
+
+  ip_restriction_ranges = []
+}
+`, rInt, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupExists("gitlab_group.foo", &group),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "ip_restriction_ranges.#", "0"),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "allowed_email_domains_list.#", "0"),
+					func(s *terraform.State) error {
+						if group.IPRestrictionRanges != "" || group.AllowedEmailDomainsList != "" {
+							return fmt.Errorf("expected the restrictions of group %d to be removed, got %q and %q", group.ID, group.IPRestrictionRanges, group.AllowedEmailDomainsList)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabGroupDisappears(group *gitlab.Group) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		_, err := testGitlabClient.Groups.DeleteGroup(group.ID)
+		_, err := testGitlabClient.Groups.DeleteGroup(group.ID, nil)
 		if err != nil {
 			return err
 		}
@@ -436,6 +584,24 @@ func testAccCheckGitlabGroupDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccCheckGitlabGroupPermanentlyRemovedOnDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group" {
+			continue
+		}
+
+		_, _, err := testGitlabClient.Groups.GetGroup(rs.Primary.ID, nil)
+		if err == nil {
+			return fmt.Errorf("expected group to be permanently removed, but it still exists")
+		}
+		if !is404(err) {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
 func testAccGitlabGroupConfig(rInt int) string {
 	return fmt.Sprintf(`
 resource "gitlab_group" "foo" {
@@ -450,6 +616,21 @@ resource "gitlab_group" "foo" {
   `, rInt, rInt)
 }
 
+func testAccGitlabGroupConfigPermanentlyRemoveOnDestroy(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name = "foo-name-%d"
+  path = "foo-path-%d"
+  description = "Terraform acceptance tests"
+  permanently_remove_on_destroy = true
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+  `, rInt, rInt)
+}
+
 func testAccGitlabGroupUpdateConfig(rInt int, defaultBranchProtection int) string {
 	return fmt.Sprintf(`
 resource "gitlab_group" "foo" {
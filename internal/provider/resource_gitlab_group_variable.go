@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -15,6 +16,8 @@ var _ = registerResource("gitlab_group_variable", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`" + `gitlab_group_variable` + "`" + ` resource allows to manage the lifecycle of a CI/CD variable for a group.
 
+~> The value of a ` + "`hidden`" + ` variable is never returned by the GitLab API, thus changes made to it outside of Terraform are not detected.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_level_variables.html)`,
 
 		CreateContext: resourceGitlabGroupVariableCreate,
@@ -25,7 +28,8 @@ var _ = registerResource("gitlab_group_variable", func() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: gitlabGroupVariableGetSchema(),
+		Schema:        gitlabGroupVariableGetSchema(),
+		CustomizeDiff: validateMaskedVariableValueDiff,
 	}
 })
 
@@ -50,7 +54,12 @@ func resourceGitlabGroupVariableCreate(ctx context.Context, d *schema.ResourceDa
 	}
 	log.Printf("[DEBUG] create gitlab group variable %s/%s", group, key)
 
-	_, _, err := client.GroupVariables.CreateVariable(group, &options, gitlab.WithContext(ctx))
+	var err error
+	if d.Get("hidden").(bool) {
+		err = resourceGitlabGroupVariableCreateHidden(ctx, client, group, &options)
+	} else {
+		_, _, err = client.GroupVariables.CreateVariable(group, &options, gitlab.WithContext(ctx))
+	}
 	if err != nil {
 		return augmentVariableClientError(d, err)
 	}
@@ -81,6 +90,7 @@ func resourceGitlabGroupVariableRead(ctx context.Context, d *schema.ResourceData
 	v, _, err := client.GroupVariables.GetVariable(
 		group,
 		key,
+		nil,
 		gitlab.WithContext(ctx),
 		withEnvironmentScopeFilter(ctx, scope),
 	)
@@ -94,6 +104,11 @@ func resourceGitlabGroupVariableRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	stateMap := gitlabGroupVariableToStateMap(group, v)
+	// NOTE: the value of hidden variables is never returned by the API,
+	//       thus we keep the value from the state.
+	if v.Hidden {
+		stateMap["value"] = d.Get("value").(string)
+	}
 	if err = setStateMapInResourceData(stateMap, d); err != nil {
 		return diag.FromErr(err)
 	}
@@ -143,6 +158,7 @@ func resourceGitlabGroupVariableDelete(ctx context.Context, d *schema.ResourceDa
 	_, err := client.GroupVariables.RemoveVariable(
 		group,
 		key,
+		nil,
 		gitlab.WithContext(ctx),
 		withEnvironmentScopeFilter(ctx, environmentScope),
 	)
@@ -152,3 +168,26 @@ func resourceGitlabGroupVariableDelete(ctx context.Context, d *schema.ResourceDa
 
 	return nil
 }
+
+// gitlabCreateHiddenGroupVariableOptions represents the options to create a hidden group variable.
+type gitlabCreateHiddenGroupVariableOptions struct {
+	gitlab.CreateGroupVariableOptions
+	MaskedAndHidden *bool `json:"masked_and_hidden,omitempty"`
+}
+
+// resourceGitlabGroupVariableCreateHidden creates a masked and hidden group variable.
+// NOTE: go-gitlab sends the `masked_and_hidden` attribute as `hidden`, which the API ignores, thus we do the raw request.
+func resourceGitlabGroupVariableCreateHidden(ctx context.Context, client *gitlab.Client, group string, options *gitlab.CreateGroupVariableOptions) error {
+	u := fmt.Sprintf("groups/%s/variables", gitlab.PathEscape(group))
+	opt := &gitlabCreateHiddenGroupVariableOptions{
+		CreateGroupVariableOptions: *options,
+		MaskedAndHidden:            gitlab.Bool(true),
+	}
+	req, err := client.NewRequest(http.MethodPost, u, opt, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
@@ -214,7 +214,7 @@ func testAccCheckGitlabGroupVariableExists(n string, groupVariable *gitlab.Group
 		if key == "" {
 			return fmt.Errorf("No variable key is set")
 		}
-		gotVariable, _, err := testGitlabClient.GroupVariables.GetVariable(repoName, key, withEnvironmentScopeFilter(context.Background(), rs.Primary.Attributes["environment_scope"]))
+		gotVariable, _, err := testGitlabClient.GroupVariables.GetVariable(repoName, key, nil, withEnvironmentScopeFilter(context.Background(), rs.Primary.Attributes["environment_scope"]))
 		if err != nil {
 			return err
 		}
@@ -372,3 +372,91 @@ resource "gitlab_group_variable" "foo" {
 }
 	`, rString, rString, rString, rString)
 }
+
+func TestAccGitlabGroupVariable_hidden(t *testing.T) {
+	testAccCheck(t)
+
+	group := testAccCreateGroups(t, 1)[0]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			variables, _, err := testGitlabClient.GroupVariables.ListVariables(group.ID, nil)
+			if err != nil {
+				return err
+			}
+			if len(variables) > 0 {
+				return fmt.Errorf("expected no group variables but found %d", len(variables))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Hidden variables must be masked
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_group_variable" "this" {
+  group  = %d
+  key    = "my_key"
+  value  = "my-hidden-value"
+  hidden = true
+}
+`, group.ID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`hidden` variables must also be `masked`"),
+			},
+			// Create a hidden variable
+			{
+				SkipFunc: isGitLabVersionLessThan(context.Background(), testGitlabClient, "17.4"),
+				Config: fmt.Sprintf(`
+resource "gitlab_group_variable" "this" {
+  group  = %d
+  key    = "my_key"
+  value  = "my-hidden-value"
+  masked = true
+  hidden = true
+}
+`, group.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_variable.this", "hidden", "true"),
+					resource.TestCheckResourceAttr("gitlab_group_variable.this", "value", "my-hidden-value"),
+					func(s *terraform.State) error {
+						variable, _, err := testGitlabClient.GroupVariables.GetVariable(group.ID, "my_key", nil)
+						if err != nil {
+							return err
+						}
+						if !variable.Hidden {
+							return fmt.Errorf("expected group variable to be hidden")
+						}
+						return nil
+					},
+				),
+			},
+			// Update the value of the hidden variable
+			{
+				SkipFunc: isGitLabVersionLessThan(context.Background(), testGitlabClient, "17.4"),
+				Config: fmt.Sprintf(`
+resource "gitlab_group_variable" "this" {
+  group  = %d
+  key    = "my_key"
+  value  = "my-updated-hidden-value"
+  masked = true
+  hidden = true
+}
+`, group.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_variable.this", "hidden", "true"),
+					resource.TestCheckResourceAttr("gitlab_group_variable.this", "value", "my-updated-hidden-value"),
+				),
+			},
+			// Verify import, the value of hidden variables can't be imported
+			{
+				SkipFunc:                isGitLabVersionLessThan(context.Background(), testGitlabClient, "17.4"),
+				ResourceName:            "gitlab_group_variable.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitlabInstanceAuditEventStreamingAmazonS3API = auditEventStreamingAPI{
+	gidType:         "AuditEvents::Instance::AmazonS3Configuration",
+	listField:       "auditEventsInstanceAmazonS3Configurations",
+	selection:       auditEventStreamingSelection(gitlabAuditEventStreamingAmazonS3Fields),
+	createMutation:  "auditEventsInstanceAmazonS3ConfigurationCreate",
+	updateMutation:  "auditEventsInstanceAmazonS3ConfigurationUpdate",
+	destroyMutation: "auditEventsInstanceAmazonS3ConfigurationDelete",
+	payloadField:    "instanceAmazonS3Configuration",
+}
+
+var _ = registerResource("gitlab_instance_audit_event_streaming_amazon_s3", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_instance_audit_event_streaming_amazon_s3`" + ` resource allows to manage the lifecycle of an Amazon S3 destination streaming the audit events of the GitLab instance.
+
+-> This resource requires administration privileges and GitLab Ultimate.
+
+~> The ` + "`secret_access_key`" + ` is a secret and not returned by the GitLab API, thus changes made to it outside of Terraform are not detected.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#mutationauditeventsinstanceamazons3configurationcreate)`,
+
+		CreateContext: resourceGitlabInstanceAuditEventStreamingAmazonS3Create,
+		ReadContext:   resourceGitlabInstanceAuditEventStreamingAmazonS3Read,
+		UpdateContext: resourceGitlabInstanceAuditEventStreamingAmazonS3Update,
+		DeleteContext: resourceGitlabInstanceAuditEventStreamingAmazonS3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: gitlabAuditEventStreamingAmazonS3Schema(nil),
+	}
+})
+
+func resourceGitlabInstanceAuditEventStreamingAmazonS3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabInstanceAuditEventStreamingCreate(ctx, d, meta, gitlabInstanceAuditEventStreamingAmazonS3API, gitlabAuditEventStreamingAmazonS3Fields)
+}
+
+func resourceGitlabInstanceAuditEventStreamingAmazonS3Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabInstanceAuditEventStreamingRead(ctx, d, meta, gitlabInstanceAuditEventStreamingAmazonS3API, gitlabAuditEventStreamingAmazonS3Fields)
+}
+
+func resourceGitlabInstanceAuditEventStreamingAmazonS3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabInstanceAuditEventStreamingUpdate(ctx, d, meta, gitlabInstanceAuditEventStreamingAmazonS3API, gitlabAuditEventStreamingAmazonS3Fields)
+}
+
+func resourceGitlabInstanceAuditEventStreamingAmazonS3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabInstanceAuditEventStreamingDelete(ctx, d, meta, gitlabInstanceAuditEventStreamingAmazonS3API)
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var gitlabInstanceAuditEventStreamingDestinationAPI = auditEventStreamingDestinationAPI{
+	auditEventStreamingAPI: auditEventStreamingAPI{
+		gidType:         "AuditEvents::InstanceExternalAuditEventDestination",
+		listField:       "instanceExternalAuditEventDestinations",
+		selection:       "name destinationUrl verificationToken eventTypeFilters headers { nodes { id key value } }",
+		createMutation:  "instanceExternalAuditEventDestinationCreate",
+		updateMutation:  "instanceExternalAuditEventDestinationUpdate",
+		destroyMutation: "instanceExternalAuditEventDestinationDestroy",
+		payloadField:    "instanceExternalAuditEventDestination",
+	},
+	headerCreateMutation:  "auditEventsStreamingInstanceHeadersCreate",
+	headerUpdateMutation:  "auditEventsStreamingInstanceHeadersUpdate",
+	headerDestroyMutation: "auditEventsStreamingInstanceHeadersDestroy",
+	eventsAddMutation:     "auditEventsStreamingDestinationInstanceEventsAdd",
+	eventsRemoveMutation:  "auditEventsStreamingDestinationInstanceEventsRemove",
+}
+
+var _ = registerResource("gitlab_instance_audit_event_streaming_destination", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_instance_audit_event_streaming_destination`" + ` resource allows to manage the lifecycle of an HTTP destination streaming the audit events of the GitLab instance.
+
+-> This resource requires administration privileges and GitLab Ultimate.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#mutationinstanceexternalauditeventdestinationcreate)`,
+
+		CreateContext: resourceGitlabInstanceAuditEventStreamingDestinationCreate,
+		ReadContext:   resourceGitlabInstanceAuditEventStreamingDestinationRead,
+		UpdateContext: resourceGitlabInstanceAuditEventStreamingDestinationUpdate,
+		DeleteContext: resourceGitlabInstanceAuditEventStreamingDestinationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: gitlabAuditEventStreamingDestinationSchema(nil),
+	}
+})
+
+func resourceGitlabInstanceAuditEventStreamingDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] create audit event streaming destination for the gitlab instance")
+	id, err := resourceGitlabAuditEventStreamingDestinationCreate(ctx, client, d, gitlabInstanceAuditEventStreamingDestinationAPI, map[string]interface{}{})
+	if id != "" {
+		d.SetId(id)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabInstanceAuditEventStreamingDestinationRead(ctx, d, meta)
+}
+
+func resourceGitlabInstanceAuditEventStreamingDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] read audit event streaming destination %s of the gitlab instance", d.Id())
+	return resourceGitlabAuditEventStreamingDestinationRead(ctx, client, d, gitlabInstanceAuditEventStreamingDestinationAPI, "", d.Id())
+}
+
+func resourceGitlabInstanceAuditEventStreamingDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] update audit event streaming destination %s of the gitlab instance", d.Id())
+	if err := resourceGitlabAuditEventStreamingDestinationUpdate(ctx, client, d, gitlabInstanceAuditEventStreamingDestinationAPI, "", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabInstanceAuditEventStreamingDestinationRead(ctx, d, meta)
+}
+
+func resourceGitlabInstanceAuditEventStreamingDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabInstanceAuditEventStreamingDelete(ctx, d, meta, gitlabInstanceAuditEventStreamingDestinationAPI.auditEventStreamingAPI)
+}
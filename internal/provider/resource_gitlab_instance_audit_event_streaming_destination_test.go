@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGitlabInstanceAuditEventStreamingDestination_basic(t *testing.T) {
+	testAccCheck(t)
+	testAccCheckEE(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabAuditEventStreamingDestroy("gitlab_instance_audit_event_streaming_destination", gitlabInstanceAuditEventStreamingDestinationAPI.auditEventStreamingAPI),
+		Steps: []resource.TestStep{
+			// Create a destination with headers and event type filters
+			{
+				Config: `
+					resource "gitlab_instance_audit_event_streaming_destination" "this" {
+						name            = "SIEM"
+						destination_url = "https://siem.example.com/audit-events"
+
+						headers = {
+							"Authorization" = "Bearer secret"
+						}
+						event_type_filters = ["user_created"]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_instance_audit_event_streaming_destination.this", "verification_token"),
+					resource.TestCheckResourceAttr("gitlab_instance_audit_event_streaming_destination.this", "headers.%", "1"),
+					resource.TestCheckResourceAttr("gitlab_instance_audit_event_streaming_destination.this", "event_type_filters.#", "1"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_instance_audit_event_streaming_destination.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the destination and remove headers and event type filters
+			{
+				Config: `
+					resource "gitlab_instance_audit_event_streaming_destination" "this" {
+						name            = "SIEM (updated)"
+						destination_url = "https://siem.example.com/gitlab/audit-events"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_instance_audit_event_streaming_destination.this", "name", "SIEM (updated)"),
+					resource.TestCheckResourceAttr("gitlab_instance_audit_event_streaming_destination.this", "headers.%", "0"),
+					resource.TestCheckResourceAttr("gitlab_instance_audit_event_streaming_destination.this", "event_type_filters.#", "0"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_instance_audit_event_streaming_destination.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
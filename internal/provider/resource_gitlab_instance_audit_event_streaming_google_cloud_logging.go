@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitlabInstanceAuditEventStreamingGoogleCloudLoggingAPI = auditEventStreamingAPI{
+	gidType:         "AuditEvents::Instance::GoogleCloudLoggingConfiguration",
+	listField:       "instanceGoogleCloudLoggingConfigurations",
+	selection:       auditEventStreamingSelection(gitlabAuditEventStreamingGoogleCloudLoggingFields),
+	createMutation:  "instanceGoogleCloudLoggingConfigurationCreate",
+	updateMutation:  "instanceGoogleCloudLoggingConfigurationUpdate",
+	destroyMutation: "instanceGoogleCloudLoggingConfigurationDestroy",
+	payloadField:    "instanceGoogleCloudLoggingConfiguration",
+}
+
+var _ = registerResource("gitlab_instance_audit_event_streaming_google_cloud_logging", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_instance_audit_event_streaming_google_cloud_logging`" + ` resource allows to manage the lifecycle of a Google Cloud Logging destination streaming the audit events of the GitLab instance.
+
+-> This resource requires administration privileges and GitLab Ultimate.
+
+~> The ` + "`private_key`" + ` is a secret and not returned by the GitLab API, thus changes made to it outside of Terraform are not detected.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#mutationinstancegooglecloudloggingconfigurationcreate)`,
+
+		CreateContext: resourceGitlabInstanceAuditEventStreamingGoogleCloudLoggingCreate,
+		ReadContext:   resourceGitlabInstanceAuditEventStreamingGoogleCloudLoggingRead,
+		UpdateContext: resourceGitlabInstanceAuditEventStreamingGoogleCloudLoggingUpdate,
+		DeleteContext: resourceGitlabInstanceAuditEventStreamingGoogleCloudLoggingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: gitlabAuditEventStreamingGoogleCloudLoggingSchema(nil),
+	}
+})
+
+func resourceGitlabInstanceAuditEventStreamingGoogleCloudLoggingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabInstanceAuditEventStreamingCreate(ctx, d, meta, gitlabInstanceAuditEventStreamingGoogleCloudLoggingAPI, gitlabAuditEventStreamingGoogleCloudLoggingFields)
+}
+
+func resourceGitlabInstanceAuditEventStreamingGoogleCloudLoggingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabInstanceAuditEventStreamingRead(ctx, d, meta, gitlabInstanceAuditEventStreamingGoogleCloudLoggingAPI, gitlabAuditEventStreamingGoogleCloudLoggingFields)
+}
+
+func resourceGitlabInstanceAuditEventStreamingGoogleCloudLoggingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabInstanceAuditEventStreamingUpdate(ctx, d, meta, gitlabInstanceAuditEventStreamingGoogleCloudLoggingAPI, gitlabAuditEventStreamingGoogleCloudLoggingFields)
+}
+
+func resourceGitlabInstanceAuditEventStreamingGoogleCloudLoggingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabInstanceAuditEventStreamingDelete(ctx, d, meta, gitlabInstanceAuditEventStreamingGoogleCloudLoggingAPI)
+}
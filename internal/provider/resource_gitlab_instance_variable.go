@@ -13,6 +13,8 @@ var _ = registerResource("gitlab_instance_variable", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`" + `gitlab_instance_variable` + "`" + ` resource allows to manage the lifecycle of an instance-level CI/CD variable.
 
+-> Contrary to project and group variables, GitLab doesn't support hidden instance-level variables, thus there is no ` + "`hidden`" + ` attribute. Use ` + "`masked`" + ` instead.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/instance_level_ci_variables.html)`,
 
 		CreateContext: resourceGitlabInstanceVariableCreate,
@@ -23,6 +25,8 @@ var _ = registerResource("gitlab_instance_variable", func() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validateMaskedVariableValueDiff,
+
 		Schema: gitlabInstanceVariableGetSchema(),
 	}
 })
@@ -35,6 +39,8 @@ func resourceGitlabInstanceVariableCreate(ctx context.Context, d *schema.Resourc
 	variableType := stringToVariableType(d.Get("variable_type").(string))
 	protected := d.Get("protected").(bool)
 	masked := d.Get("masked").(bool)
+	raw := d.Get("raw").(bool)
+	description := d.Get("description").(string)
 
 	options := gitlab.CreateInstanceVariableOptions{
 		Key:          &key,
@@ -42,6 +48,8 @@ func resourceGitlabInstanceVariableCreate(ctx context.Context, d *schema.Resourc
 		VariableType: variableType,
 		Protected:    &protected,
 		Masked:       &masked,
+		Raw:          &raw,
+		Description:  &description,
 	}
 	log.Printf("[DEBUG] create gitlab instance level CI variable %s", key)
 
@@ -92,12 +100,16 @@ func resourceGitlabInstanceVariableUpdate(ctx context.Context, d *schema.Resourc
 	variableType := stringToVariableType(d.Get("variable_type").(string))
 	protected := d.Get("protected").(bool)
 	masked := d.Get("masked").(bool)
+	raw := d.Get("raw").(bool)
+	description := d.Get("description").(string)
 
 	options := &gitlab.UpdateInstanceVariableOptions{
 		Value:        &value,
 		Protected:    &protected,
 		VariableType: variableType,
 		Masked:       &masked,
+		Raw:          &raw,
+		Description:  &description,
 	}
 	log.Printf("[DEBUG] update gitlab instance level CI variable %s", key)
 
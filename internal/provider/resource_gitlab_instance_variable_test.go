@@ -99,6 +99,66 @@ func TestAccGitlabInstanceVariable_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabInstanceVariable_rawAndDescription(t *testing.T) {
+	rString := acctest.RandString(5)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabInstanceVariableDestroy,
+		Steps: []resource.TestStep{
+			// Masked values are validated during plan
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_instance_variable" "foo" {
+						key    = "key_%s"
+						value  = "$NOT_EXPANDED"
+						masked = true
+					}
+				`, rString),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta(maskedVariableRequirementsErrorMessage)),
+			},
+			// Create a raw and masked variable with a description
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_instance_variable" "foo" {
+						key         = "key_%s"
+						value       = "$NOT_EXPANDED"
+						masked      = true
+						raw         = true
+						description = "A raw variable"
+					}
+				`, rString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_instance_variable.foo", "raw", "true"),
+					resource.TestCheckResourceAttr("gitlab_instance_variable.foo", "description", "A raw variable"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_instance_variable.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the variable to be expanded again
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_instance_variable" "foo" {
+						key         = "key_%s"
+						value       = "value-%s"
+						description = "An expanded variable"
+					}
+				`, rString, rString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_instance_variable.foo", "raw", "false"),
+					resource.TestCheckResourceAttr("gitlab_instance_variable.foo", "description", "An expanded variable"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabInstanceVariableExists(n string, instanceVariable *gitlab.InstanceVariable) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_integration_custom_issue_tracker", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_integration_custom_issue_tracker`" + ` resource allows to manage the lifecycle of a project integration with a Custom Issue Tracker.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#custom-issue-tracker)`,
+
+		CreateContext: resourceGitlabIntegrationCustomIssueTrackerCreate,
+		ReadContext:   resourceGitlabIntegrationCustomIssueTrackerRead,
+		UpdateContext: resourceGitlabIntegrationCustomIssueTrackerCreate,
+		DeleteContext: resourceGitlabIntegrationCustomIssueTrackerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description:  "ID of the project you want to activate integration on.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"project_url": {
+				Description:  "The URL to the project in the external issue tracker.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"issues_url": {
+				Description:  "The URL to view an issue in the external issue tracker. Must contain `:id`, which is replaced with the issue number, e.g. `https://tracker.example.com/issues/:id`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.All(validation.IsURLWithHTTPorHTTPS, validation.StringMatch(regexpCustomIssueTrackerIssuesURL, "must contain `:id`")),
+			},
+			"title": {
+				Description: "Title of the integration.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "The ISO8601 date/time that this integration was activated at in UTC.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "The ISO8601 date/time that this integration was last updated at in UTC.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"slug": {
+				Description: "The name of the integration in lowercase, shortened to 63 bytes, and with everything except 0-9 and a-z replaced with -. No leading / trailing -. Use in URLs, host names and domain names.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"active": {
+				Description: "Whether the integration is active.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+var regexpCustomIssueTrackerIssuesURL = regexp.MustCompile(`:id`)
+
+func resourceGitlabIntegrationCustomIssueTrackerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	d.SetId(project)
+
+	options := &gitlab.SetCustomIssueTrackerServiceOptions{
+		ProjectURL: gitlab.String(d.Get("project_url").(string)),
+		IssuesURL:  gitlab.String(d.Get("issues_url").(string)),
+	}
+
+	log.Printf("[DEBUG] create gitlab custom issue tracker integration for project %s", project)
+
+	_, _, err := client.Services.SetCustomIssueTrackerService(project, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabIntegrationCustomIssueTrackerRead(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationCustomIssueTrackerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab custom issue tracker integration for project %s", project)
+
+	service, _, err := client.Services.GetCustomIssueTrackerService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab custom issue tracker integration not found for project %s", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("project_url", service.Properties.ProjectURL)
+	d.Set("issues_url", service.Properties.IssuesURL)
+	d.Set("active", service.Active)
+	d.Set("slug", service.Slug)
+	d.Set("title", service.Title)
+	if service.CreatedAt != nil {
+		d.Set("created_at", service.CreatedAt.Format(time.RFC3339))
+	}
+	if service.UpdatedAt != nil {
+		d.Set("updated_at", service.UpdatedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceGitlabIntegrationCustomIssueTrackerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab custom issue tracker integration for project %s", project)
+
+	_, err := client.Services.DeleteCustomIssueTrackerService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
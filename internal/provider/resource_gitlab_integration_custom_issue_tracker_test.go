@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabIntegrationCustomIssueTracker_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	customIssueTrackerResourceName := "gitlab_integration_custom_issue_tracker.this"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabIntegrationCustomIssueTrackerDestroy,
+		Steps: []resource.TestStep{
+			// Verify that the issues URL must contain the issue id placeholder
+			{
+				Config:      testAccGitlabIntegrationCustomIssueTrackerConfig(testProject.ID, "https://tracker.example.com/project", "https://tracker.example.com/issues"),
+				ExpectError: regexp.MustCompile("must contain `:id`"),
+			},
+			// Create a Custom Issue Tracker integration
+			{
+				Config: testAccGitlabIntegrationCustomIssueTrackerConfig(testProject.ID, "https://tracker.example.com/project", "https://tracker.example.com/issues/:id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(customIssueTrackerResourceName, "project_url", "https://tracker.example.com/project"),
+					resource.TestCheckResourceAttr(customIssueTrackerResourceName, "issues_url", "https://tracker.example.com/issues/:id"),
+					resource.TestCheckResourceAttr(customIssueTrackerResourceName, "active", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      customIssueTrackerResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the Custom Issue Tracker integration
+			{
+				Config: testAccGitlabIntegrationCustomIssueTrackerConfig(testProject.ID, "https://tracker.example.org/project", "https://tracker.example.org/browse/PRJ-:id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(customIssueTrackerResourceName, "project_url", "https://tracker.example.org/project"),
+					resource.TestCheckResourceAttr(customIssueTrackerResourceName, "issues_url", "https://tracker.example.org/browse/PRJ-:id"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      customIssueTrackerResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabIntegrationCustomIssueTrackerDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_integration_custom_issue_tracker" {
+			continue
+		}
+
+		service, _, err := testGitlabClient.Services.GetCustomIssueTrackerService(rs.Primary.ID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if service.Active {
+			return fmt.Errorf("custom issue tracker integration for project %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccGitlabIntegrationCustomIssueTrackerConfig(projectID int, projectURL string, issuesURL string) string {
+	return fmt.Sprintf(`
+resource "gitlab_integration_custom_issue_tracker" "this" {
+	project     = %[1]d
+	project_url = "%[2]s"
+	issues_url  = "%[3]s"
+}
+`, projectID, projectURL, issuesURL)
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_integration_datadog", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_integration_datadog`" + ` resource allows to manage the lifecycle of a project integration with Datadog.
+
+~> The ` + "`api_key`" + ` is a secret and not returned by the GitLab API, thus changes made to it outside of Terraform are not detected.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#datadog)`,
+
+		CreateContext: resourceGitlabIntegrationDatadogCreate,
+		ReadContext:   resourceGitlabIntegrationDatadogRead,
+		UpdateContext: resourceGitlabIntegrationDatadogUpdate,
+		DeleteContext: resourceGitlabIntegrationDatadogDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "ID of the project you want to activate integration on.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"api_key": {
+				Description: "API key used for authentication with Datadog.",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"api_url": {
+				Description:  "Full URL of your Datadog site. Only required if you do not use a standard Datadog site.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURLFunc,
+			},
+			"datadog_site": {
+				Description: "The Datadog site to send data to. To send data to the EU site, use `datadoghq.eu`. Defaults to `datadoghq.com`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"datadog_env": {
+				Description: "For self-managed deployments, set the `env` tag for all the data sent to Datadog.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"datadog_service": {
+				Description: "Tag all data from this GitLab instance in Datadog. Can be used when managing several self-managed deployments.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"datadog_tags": {
+				Description: "Custom tags in Datadog. Specify one tag per line in the format `key:value`, e.g. `key:value\\nkey2:value2`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"archive_trace_events": {
+				Description: "When enabled, job logs are collected by Datadog and displayed along with pipeline execution traces.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"active": {
+				Description: "Whether the integration is active.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabIntegrationDatadogCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	d.SetId(project)
+
+	log.Printf("[DEBUG] create gitlab datadog integration for project %s", project)
+
+	opts := &gitlab.SetDataDogServiceOptions{
+		APIKey:             gitlab.String(d.Get("api_key").(string)),
+		APIURL:             gitlab.String(d.Get("api_url").(string)),
+		DataDogEnv:         gitlab.String(d.Get("datadog_env").(string)),
+		DataDogService:     gitlab.String(d.Get("datadog_service").(string)),
+		DataDogTags:        gitlab.String(d.Get("datadog_tags").(string)),
+		ArchiveTraceEvents: gitlab.Bool(d.Get("archive_trace_events").(bool)),
+	}
+	if v, ok := d.GetOk("datadog_site"); ok {
+		opts.DataDogSite = gitlab.String(v.(string))
+	}
+
+	if _, _, err := client.Services.SetDataDogService(project, opts, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabIntegrationDatadogRead(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationDatadogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab datadog integration for project %s", project)
+
+	service, _, err := client.Services.GetDataDogService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab datadog integration not found %s", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("api_url", service.Properties.APIURL)
+	d.Set("datadog_site", service.Properties.DataDogSite)
+	d.Set("datadog_env", service.Properties.DataDogEnv)
+	d.Set("datadog_service", service.Properties.DataDogService)
+	d.Set("datadog_tags", service.Properties.DataDogTags)
+	d.Set("archive_trace_events", service.Properties.ArchiveTraceEvents)
+	d.Set("active", service.Active)
+
+	return nil
+}
+
+func resourceGitlabIntegrationDatadogUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabIntegrationDatadogCreate(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationDatadogDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab datadog integration for project %s", project)
+
+	if _, err := client.Services.DeleteDataDogService(project, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
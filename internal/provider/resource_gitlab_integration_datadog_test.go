@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabIntegrationDatadog_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	datadogResourceName := "gitlab_integration_datadog.datadog"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabIntegrationDatadogDestroy,
+		Steps: []resource.TestStep{
+			// Create a datadog integration with minimal settings
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_datadog" "datadog" {
+						project = %d
+						api_key = "0123456789abcdef0123456789abcdef"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datadogResourceName, "active", "true"),
+					resource.TestCheckResourceAttr(datadogResourceName, "datadog_site", "datadoghq.com"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            datadogResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+			// Update the datadog integration
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_datadog" "datadog" {
+						project              = %d
+						api_key              = "fedcba9876543210fedcba9876543210"
+						datadog_site         = "datadoghq.eu"
+						datadog_env          = "production"
+						datadog_service      = "gitlab"
+						datadog_tags         = "team:platform\ncost-center:42"
+						archive_trace_events = true
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datadogResourceName, "datadog_site", "datadoghq.eu"),
+					resource.TestCheckResourceAttr(datadogResourceName, "datadog_env", "production"),
+					resource.TestCheckResourceAttr(datadogResourceName, "datadog_service", "gitlab"),
+					resource.TestCheckResourceAttr(datadogResourceName, "datadog_tags", "team:platform\ncost-center:42"),
+					resource.TestCheckResourceAttr(datadogResourceName, "archive_trace_events", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            datadogResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabIntegrationDatadogDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_integration_datadog" {
+			continue
+		}
+
+		service, _, err := testGitlabClient.Services.GetDataDogService(rs.Primary.ID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if service.Active {
+			return fmt.Errorf("datadog integration for project %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabIntegrationDiscord_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	discordResourceName := "gitlab_integration_discord.discord"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabIntegrationDiscordDestroy,
+		Steps: []resource.TestStep{
+			// Create a discord integration with minimal settings
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_discord" "discord" {
+						project = %d
+						webhook = "https://discord.com/api/webhooks/1"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(discordResourceName, "active", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            discordResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"webhook"},
+			},
+			// Update the discord integration
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_discord" "discord" {
+						project                      = %d
+						webhook                      = "https://discord.com/api/webhooks/2"
+						notify_only_broken_pipelines = true
+						branches_to_be_notified      = "protected"
+						push_events                  = false
+						issues_events                = true
+						merge_requests_events        = true
+						pipeline_events              = true
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(discordResourceName, "notify_only_broken_pipelines", "true"),
+					resource.TestCheckResourceAttr(discordResourceName, "branches_to_be_notified", "protected"),
+					resource.TestCheckResourceAttr(discordResourceName, "push_events", "false"),
+					resource.TestCheckResourceAttr(discordResourceName, "issues_events", "true"),
+					resource.TestCheckResourceAttr(discordResourceName, "merge_requests_events", "true"),
+					resource.TestCheckResourceAttr(discordResourceName, "pipeline_events", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            discordResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"webhook"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabIntegrationDiscordDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_integration_discord" {
+			continue
+		}
+
+		service, _, err := testGitlabClient.Services.GetDiscordService(rs.Primary.ID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if service.Active {
+			return fmt.Errorf("discord integration for project %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_integration_emails_on_push", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_integration_emails_on_push`" + ` resource allows to manage the lifecycle of a project integration with Emails on Push Service.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#emails-on-push)`,
+
+		CreateContext: resourceGitlabIntegrationEmailsOnPushCreate,
+		ReadContext:   resourceGitlabIntegrationEmailsOnPushRead,
+		UpdateContext: resourceGitlabIntegrationEmailsOnPushCreate,
+		DeleteContext: resourceGitlabIntegrationEmailsOnPushDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "ID of the project you want to activate integration on.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"recipients": {
+				Description: "Email addresses where notifications are sent.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"disable_diffs": {
+				Description: "Disable code diffs in the notification emails.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"send_from_committer_email": {
+				Description: "Send notifications from the committer's email address if the domain matches a domain the GitLab instance is configured to serve.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"push_events": {
+				Description: "Enable notifications for push events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"tag_push_events": {
+				Description: "Enable notifications for tag push events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"branches_to_be_notified": {
+				Description:  fmt.Sprintf("Branches to send notifications for. Valid options are: %s. Default is `all`.", renderValueListForDocs(validBranchesToBeNotified)),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(validBranchesToBeNotified, false),
+				Default:      "all",
+			},
+			"active": {
+				Description: "Whether the integration is active.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabIntegrationEmailsOnPushCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	d.SetId(project)
+	options := &gitlab.SetEmailsOnPushServiceOptions{
+		// NOTE: the emails on push integration expects the recipients to be separated by whitespace.
+		Recipients:             gitlab.String(strings.Join(*stringSetToStringSlice(d.Get("recipients").(*schema.Set)), " ")),
+		DisableDiffs:           gitlab.Bool(d.Get("disable_diffs").(bool)),
+		SendFromCommitterEmail: gitlab.Bool(d.Get("send_from_committer_email").(bool)),
+		PushEvents:             gitlab.Bool(d.Get("push_events").(bool)),
+		TagPushEvents:          gitlab.Bool(d.Get("tag_push_events").(bool)),
+		BranchesToBeNotified:   gitlab.String(d.Get("branches_to_be_notified").(string)),
+	}
+
+	log.Printf("[DEBUG] create gitlab emails on push integration for project %s", project)
+
+	_, _, err := client.Services.SetEmailsOnPushService(project, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabIntegrationEmailsOnPushRead(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationEmailsOnPushRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab emails on push integration for project %s", project)
+
+	service, _, err := client.Services.GetEmailsOnPushService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab emails on push integration not found for project %s", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("recipients", strings.Fields(service.Properties.Recipients))
+	d.Set("disable_diffs", service.Properties.DisableDiffs)
+	d.Set("send_from_committer_email", service.Properties.SendFromCommitterEmail)
+	d.Set("push_events", service.PushEvents)
+	d.Set("tag_push_events", service.TagPushEvents)
+	d.Set("branches_to_be_notified", service.Properties.BranchesToBeNotified)
+	d.Set("active", service.Active)
+	return nil
+}
+
+func resourceGitlabIntegrationEmailsOnPushDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab emails on push integration for project %s", project)
+
+	_, err := client.Services.DeleteEmailsOnPushService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabIntegrationEmailsOnPush_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	emailsOnPushResourceName := "gitlab_integration_emails_on_push.email"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabIntegrationEmailsOnPushDestroy,
+		Steps: []resource.TestStep{
+			// Create an emails on push integration with minimal settings
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_emails_on_push" "email" {
+						project    = %d
+						recipients = ["test@example.com"]
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(emailsOnPushResourceName, "active", "true"),
+					resource.TestCheckResourceAttr(emailsOnPushResourceName, "recipients.#", "1"),
+					resource.TestCheckResourceAttr(emailsOnPushResourceName, "push_events", "true"),
+					resource.TestCheckResourceAttr(emailsOnPushResourceName, "tag_push_events", "true"),
+					resource.TestCheckResourceAttr(emailsOnPushResourceName, "branches_to_be_notified", "all"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      emailsOnPushResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the emails on push integration
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_emails_on_push" "email" {
+						project                   = %d
+						recipients                = ["test@example.com", "test2@example.com"]
+						disable_diffs             = true
+						send_from_committer_email = true
+						tag_push_events           = false
+						branches_to_be_notified   = "protected"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(emailsOnPushResourceName, "recipients.#", "2"),
+					resource.TestCheckResourceAttr(emailsOnPushResourceName, "disable_diffs", "true"),
+					resource.TestCheckResourceAttr(emailsOnPushResourceName, "send_from_committer_email", "true"),
+					resource.TestCheckResourceAttr(emailsOnPushResourceName, "tag_push_events", "false"),
+					resource.TestCheckResourceAttr(emailsOnPushResourceName, "branches_to_be_notified", "protected"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      emailsOnPushResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabIntegrationEmailsOnPushDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_integration_emails_on_push" {
+			continue
+		}
+
+		service, _, err := testGitlabClient.Services.GetEmailsOnPushService(rs.Primary.ID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if service.Active {
+			return fmt.Errorf("emails on push integration for project %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_integration_external_wiki", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_integration_external_wiki`" + ` resource allows to manage the lifecycle of a project integration with External Wiki Service.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#external-wiki)`,
+
+		CreateContext: resourceGitlabIntegrationExternalWikiCreate,
+		ReadContext:   resourceGitlabIntegrationExternalWikiRead,
+		UpdateContext: resourceGitlabIntegrationExternalWikiCreate,
+		DeleteContext: resourceGitlabIntegrationExternalWikiDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: gitlabIntegrationExternalWikiGetSchema(),
+	}
+})
+
+func resourceGitlabIntegrationExternalWikiCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	d.SetId(project)
+
+	options := &gitlab.SetExternalWikiServiceOptions{
+		ExternalWikiURL: gitlab.String(d.Get("external_wiki_url").(string)),
+	}
+
+	log.Printf("[DEBUG] create gitlab external wiki integration for project %s", project)
+
+	_, _, err := client.Services.SetExternalWikiService(project, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabIntegrationExternalWikiRead(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationExternalWikiRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab external wiki integration for project %s", project)
+
+	service, _, err := client.Services.GetExternalWikiService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab external wiki integration not found for project %s", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("external_wiki_url", service.Properties.ExternalWikiURL)
+	d.Set("active", service.Active)
+	d.Set("slug", service.Slug)
+	d.Set("title", service.Title)
+	d.Set("created_at", service.CreatedAt.Format(time.RFC3339))
+	if service.UpdatedAt != nil {
+		d.Set("updated_at", service.UpdatedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceGitlabIntegrationExternalWikiDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab external wiki integration for project %s", project)
+
+	_, err := client.Services.DeleteExternalWikiService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
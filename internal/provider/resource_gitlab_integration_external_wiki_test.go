@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabIntegrationExternalWiki_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+
+	var externalWikiService gitlab.ExternalWikiService
+	externalWikiResourceName := "gitlab_integration_external_wiki.this"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabIntegrationExternalWikiDestroy,
+		Steps: []resource.TestStep{
+			// Create an External Wiki integration
+			{
+				Config: testAccGitlabIntegrationExternalWikiConfig(testProject.ID, "http://mynumberonewiki.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabServiceExternalWikiExists(externalWikiResourceName, &externalWikiService),
+					resource.TestCheckResourceAttr(externalWikiResourceName, "external_wiki_url", "http://mynumberonewiki.com"),
+					resource.TestCheckResourceAttr(externalWikiResourceName, "active", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      externalWikiResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the External Wiki integration
+			{
+				Config: testAccGitlabIntegrationExternalWikiConfig(testProject.ID, "http://mynumbertwowiki.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabServiceExternalWikiExists(externalWikiResourceName, &externalWikiService),
+					resource.TestCheckResourceAttr(externalWikiResourceName, "external_wiki_url", "http://mynumbertwowiki.com"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      externalWikiResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabIntegrationExternalWikiDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_integration_external_wiki" {
+			continue
+		}
+
+		service, _, err := testGitlabClient.Services.GetExternalWikiService(rs.Primary.ID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if service.Active {
+			return fmt.Errorf("external wiki integration for project %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccGitlabIntegrationExternalWikiConfig(projectID int, externalWikiURL string) string {
+	return fmt.Sprintf(`
+resource "gitlab_integration_external_wiki" "this" {
+	project           = %[1]d
+	external_wiki_url = "%[2]s"
+}
+`, projectID, externalWikiURL)
+}
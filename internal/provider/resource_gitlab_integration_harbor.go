@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_integration_harbor", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_integration_harbor`" + ` resource allows to manage the lifecycle of a project integration with a Harbor container registry.
+
+~> The ` + "`password`" + ` is a secret and not returned by the GitLab API, thus changes made to it outside of Terraform are not detected.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#harbor)`,
+
+		CreateContext: resourceGitlabIntegrationHarborCreate,
+		ReadContext:   resourceGitlabIntegrationHarborRead,
+		UpdateContext: resourceGitlabIntegrationHarborUpdate,
+		DeleteContext: resourceGitlabIntegrationHarborDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "ID of the project you want to activate integration on.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"url": {
+				Description:  "The base URL to the Harbor instance linked to the GitLab project, e.g. `https://demo.goharbor.io`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateURLFunc,
+			},
+			"project_name": {
+				Description: "The name of the project on the Harbor instance, e.g. `testproject`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"username": {
+				Description: "The username created in the Harbor interface.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"password": {
+				Description: "The password of the user.",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"use_inherited_settings": {
+				Description: "Indicates whether or not to inherit default settings.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"active": {
+				Description: "Whether the integration is active.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabIntegrationHarborCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	d.SetId(project)
+
+	log.Printf("[DEBUG] create gitlab harbor integration for project %s", project)
+
+	opts := &gitlab.SetHarborServiceOptions{
+		URL:                  gitlab.String(d.Get("url").(string)),
+		ProjectName:          gitlab.String(d.Get("project_name").(string)),
+		Username:             gitlab.String(d.Get("username").(string)),
+		Password:             gitlab.String(d.Get("password").(string)),
+		UseInheritedSettings: gitlab.Bool(d.Get("use_inherited_settings").(bool)),
+	}
+
+	if _, _, err := client.Services.SetHarborService(project, opts, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabIntegrationHarborRead(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationHarborRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab harbor integration for project %s", project)
+
+	service, _, err := client.Services.GetHarborService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab harbor integration not found %s", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("url", service.Properties.URL)
+	d.Set("project_name", service.Properties.ProjectName)
+	d.Set("username", service.Properties.Username)
+	d.Set("use_inherited_settings", service.Properties.UseInheritedSettings)
+	d.Set("active", service.Active)
+
+	return nil
+}
+
+func resourceGitlabIntegrationHarborUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabIntegrationHarborCreate(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationHarborDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab harbor integration for project %s", project)
+
+	if _, err := client.Services.DeleteHarborService(project, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
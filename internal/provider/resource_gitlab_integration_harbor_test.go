@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabIntegrationHarbor_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	harborResourceName := "gitlab_integration_harbor.harbor"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabIntegrationHarborDestroy,
+		Steps: []resource.TestStep{
+			// Create a harbor integration
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_harbor" "harbor" {
+						project      = %d
+						url          = "https://harbor.example.com"
+						project_name = "testproject"
+						username     = "harbor_user"
+						password     = "harbor_password"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(harborResourceName, "active", "true"),
+					resource.TestCheckResourceAttr(harborResourceName, "url", "https://harbor.example.com"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            harborResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			// Update the harbor integration
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_harbor" "harbor" {
+						project      = %d
+						url          = "https://harbor.example.org"
+						project_name = "otherproject"
+						username     = "other_harbor_user"
+						password     = "other_harbor_password"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(harborResourceName, "url", "https://harbor.example.org"),
+					resource.TestCheckResourceAttr(harborResourceName, "project_name", "otherproject"),
+					resource.TestCheckResourceAttr(harborResourceName, "username", "other_harbor_user"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            harborResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabIntegrationHarborDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_integration_harbor" {
+			continue
+		}
+
+		service, _, err := testGitlabClient.Services.GetHarborService(rs.Primary.ID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if service.Active {
+			return fmt.Errorf("harbor integration for project %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_integration_jenkins", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_integration_jenkins`" + ` resource allows to manage the lifecycle of a project integration with Jenkins.
+
+~> The ` + "`password`" + ` is a secret and not returned by the GitLab API, thus changes made to it outside of Terraform are not detected.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#jenkins)`,
+
+		CreateContext: resourceGitlabIntegrationJenkinsCreate,
+		ReadContext:   resourceGitlabIntegrationJenkinsRead,
+		UpdateContext: resourceGitlabIntegrationJenkinsUpdate,
+		DeleteContext: resourceGitlabIntegrationJenkinsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "ID of the project you want to activate integration on.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"jenkins_url": {
+				Description:  "Jenkins URL like `http://jenkins.example.com`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateURLFunc,
+			},
+			"project_name": {
+				Description: "The URL-friendly project name. Example: `my_project_name`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"username": {
+				Description: "Username for authentication with the Jenkins server, if authentication is required by the server.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"password": {
+				Description: "Password for authentication with the Jenkins server, if authentication is required by the server.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"enable_ssl_verification": {
+				Description: "Enable SSL verification.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"push_events": {
+				Description: "Enable notifications for push events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"merge_requests_events": {
+				Description: "Enable notifications for merge requests events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag_push_events": {
+				Description: "Enable notifications for tag push events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"active": {
+				Description: "Whether the integration is active.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabIntegrationJenkinsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	d.SetId(project)
+
+	log.Printf("[DEBUG] create gitlab jenkins integration for project %s", project)
+
+	opts := &gitlab.SetJenkinsCIServiceOptions{
+		URL:                   gitlab.String(d.Get("jenkins_url").(string)),
+		ProjectName:           gitlab.String(d.Get("project_name").(string)),
+		Username:              gitlab.String(d.Get("username").(string)),
+		Password:              gitlab.String(d.Get("password").(string)),
+		EnableSSLVerification: gitlab.Bool(d.Get("enable_ssl_verification").(bool)),
+		PushEvents:            gitlab.Bool(d.Get("push_events").(bool)),
+		MergeRequestsEvents:   gitlab.Bool(d.Get("merge_requests_events").(bool)),
+		TagPushEvents:         gitlab.Bool(d.Get("tag_push_events").(bool)),
+	}
+
+	if _, _, err := client.Services.SetJenkinsCIService(project, opts, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabIntegrationJenkinsRead(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationJenkinsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab jenkins integration for project %s", project)
+
+	service, _, err := client.Services.GetJenkinsCIService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab jenkins integration not found %s", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("jenkins_url", service.Properties.URL)
+	d.Set("project_name", service.Properties.ProjectName)
+	d.Set("username", service.Properties.Username)
+	d.Set("enable_ssl_verification", service.Properties.EnableSSLVerification)
+	d.Set("push_events", service.PushEvents)
+	d.Set("merge_requests_events", service.MergeRequestsEvents)
+	d.Set("tag_push_events", service.TagPushEvents)
+	d.Set("active", service.Active)
+
+	return nil
+}
+
+func resourceGitlabIntegrationJenkinsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabIntegrationJenkinsCreate(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationJenkinsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab jenkins integration for project %s", project)
+
+	if _, err := client.Services.DeleteJenkinsCIService(project, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
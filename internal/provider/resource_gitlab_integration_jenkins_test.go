@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabIntegrationJenkins_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	jenkinsResourceName := "gitlab_integration_jenkins.jenkins"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabIntegrationJenkinsDestroy,
+		Steps: []resource.TestStep{
+			// Create a jenkins integration with minimal settings
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_jenkins" "jenkins" {
+						project      = %d
+						jenkins_url  = "https://jenkins.example.com"
+						project_name = "my_project"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(jenkinsResourceName, "active", "true"),
+					resource.TestCheckResourceAttr(jenkinsResourceName, "enable_ssl_verification", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            jenkinsResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			// Update the jenkins integration with credentials
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_jenkins" "jenkins" {
+						project                 = %d
+						jenkins_url             = "https://jenkins.example.org"
+						project_name            = "my_other_project"
+						username                = "gitlab"
+						password                = "secret"
+						enable_ssl_verification = false
+						push_events             = false
+						merge_requests_events   = true
+						tag_push_events         = true
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(jenkinsResourceName, "jenkins_url", "https://jenkins.example.org"),
+					resource.TestCheckResourceAttr(jenkinsResourceName, "project_name", "my_other_project"),
+					resource.TestCheckResourceAttr(jenkinsResourceName, "username", "gitlab"),
+					resource.TestCheckResourceAttr(jenkinsResourceName, "enable_ssl_verification", "false"),
+					resource.TestCheckResourceAttr(jenkinsResourceName, "push_events", "false"),
+					resource.TestCheckResourceAttr(jenkinsResourceName, "merge_requests_events", "true"),
+					resource.TestCheckResourceAttr(jenkinsResourceName, "tag_push_events", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            jenkinsResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabIntegrationJenkinsDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_integration_jenkins" {
+			continue
+		}
+
+		service, _, err := testGitlabClient.Services.GetJenkinsCIService(rs.Primary.ID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if service.Active {
+			return fmt.Errorf("jenkins integration for project %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_integration_jira", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_integration_jira`" + ` resource allows to manage the lifecycle of a project integration with Jira.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/services.html#jira)`,
+
+		CreateContext: resourceGitlabIntegrationJiraCreate,
+		ReadContext:   resourceGitlabIntegrationJiraRead,
+		UpdateContext: resourceGitlabIntegrationJiraUpdate,
+		DeleteContext: resourceGitlabIntegrationJiraDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGitlabIntegrationJiraImportState,
+		},
+
+		Schema: gitlabIntegrationJiraGetSchema(),
+	}
+})
+
+func resourceGitlabIntegrationJiraCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	project := d.Get("project").(string)
+
+	jiraOptions, err := expandJiraOptions(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Create Gitlab Jira integration")
+
+	if _, _, err := client.Services.SetJiraService(project, jiraOptions, gitlab.WithContext(ctx)); err != nil {
+		return diag.Errorf("couldn't create Gitlab Jira integration: %v", err)
+	}
+
+	d.SetId(project)
+
+	return resourceGitlabIntegrationJiraRead(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationJiraRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	_, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] Removing Gitlab Jira integration %s because project %s not found", d.Id(), project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Read Gitlab Jira integration %s", d.Id())
+
+	jiraService, _, err := client.Services.GetJiraService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab jira integration not found %s", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if v := jiraService.Properties.URL; v != "" {
+		d.Set("url", v)
+	}
+	if v := jiraService.Properties.APIURL; v != "" {
+		d.Set("api_url", v)
+	}
+	if v := jiraService.Properties.Username; v != "" {
+		d.Set("username", v)
+	}
+	if v := jiraService.Properties.ProjectKey; v != "" {
+		d.Set("project_key", v)
+	}
+	if v := jiraService.Properties.JiraIssueTransitionID; v != "" {
+		d.Set("jira_issue_transition_id", v)
+	}
+
+	d.Set("jira_auth_type", jiraService.Properties.JiraAuthType)
+	d.Set("jira_issue_transition_automatic", jiraService.Properties.JiraIssueTransitionAutomatic)
+	d.Set("jira_issue_prefix", jiraService.Properties.JiraIssuePrefix)
+	d.Set("jira_issue_regex", jiraService.Properties.JiraIssueRegex)
+
+	d.Set("title", jiraService.Title)
+	d.Set("created_at", jiraService.CreatedAt.String())
+	d.Set("updated_at", jiraService.UpdatedAt.String())
+	d.Set("active", jiraService.Active)
+	d.Set("push_events", jiraService.PushEvents)
+	d.Set("issues_events", jiraService.IssuesEvents)
+	d.Set("commit_events", jiraService.CommitEvents)
+	d.Set("merge_requests_events", jiraService.MergeRequestsEvents)
+	d.Set("comment_on_event_enabled", jiraService.CommentOnEventEnabled)
+	d.Set("tag_push_events", jiraService.TagPushEvents)
+	d.Set("note_events", jiraService.NoteEvents)
+	d.Set("pipeline_events", jiraService.PipelineEvents)
+	d.Set("job_events", jiraService.JobEvents)
+
+	return nil
+}
+
+func resourceGitlabIntegrationJiraUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabIntegrationJiraCreate(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationJiraDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	project := d.Get("project").(string)
+
+	log.Printf("[DEBUG] Delete Gitlab Jira integration %s", d.Id())
+
+	_, err := client.Services.DeleteJiraService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandJiraOptions(d *schema.ResourceData) (*gitlab.SetJiraServiceOptions, error) {
+	setJiraServiceOptions := gitlab.SetJiraServiceOptions{}
+
+	// Set required properties
+	setJiraServiceOptions.URL = gitlab.String(d.Get("url").(string))
+	setJiraServiceOptions.ProjectKey = gitlab.String(d.Get("project_key").(string))
+	setJiraServiceOptions.Username = gitlab.String(d.Get("username").(string))
+	setJiraServiceOptions.Password = gitlab.String(d.Get("password").(string))
+	setJiraServiceOptions.CommitEvents = gitlab.Bool(d.Get("commit_events").(bool))
+	setJiraServiceOptions.MergeRequestsEvents = gitlab.Bool(d.Get("merge_requests_events").(bool))
+	setJiraServiceOptions.CommentOnEventEnabled = gitlab.Bool(d.Get("comment_on_event_enabled").(bool))
+	setJiraServiceOptions.JiraIssuePrefix = gitlab.String(d.Get("jira_issue_prefix").(string))
+	setJiraServiceOptions.JiraIssueRegex = gitlab.String(d.Get("jira_issue_regex").(string))
+
+	// Set optional properties
+	if val, ok := d.GetOk("api_url"); ok {
+		setJiraServiceOptions.APIURL = gitlab.String(val.(string))
+	}
+	if val, ok := d.GetOk("jira_issue_transition_id"); ok {
+		setJiraServiceOptions.JiraIssueTransitionID = gitlab.String(val.(string))
+	}
+	// NOTE: these attributes are computed, thus they are only sent if they changed,
+	//       so that the values set outside of Terraform are kept if they are not configured.
+	if d.HasChange("jira_auth_type") {
+		setJiraServiceOptions.JiraAuthType = gitlab.Int(d.Get("jira_auth_type").(int))
+	}
+	if d.HasChange("jira_issue_transition_automatic") {
+		setJiraServiceOptions.JiraIssueTransitionAutomatic = gitlab.Bool(d.Get("jira_issue_transition_automatic").(bool))
+	}
+
+	return &setJiraServiceOptions, nil
+}
+
+func resourceGitlabIntegrationJiraImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("project", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestResourceGitlabIntegrationJiraRead_projectNotFound(t *testing.T) {
+	testResourceReadNotFound(t, "gitlab_integration_jira", map[string]interface{}{"project": "foo/bar"}, "foo/bar")
+}
+
+func TestAccGitlabIntegrationJira_basic(t *testing.T) {
+	var jiraService gitlab.JiraService
+	rInt := acctest.RandInt()
+	jiraResourceName := "gitlab_integration_jira.jira"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabServiceJiraDestroy,
+		Steps: []resource.TestStep{
+			// Create a project and a jira integration with basic authentication
+			{
+				Config: testAccGitlabIntegrationJiraConfig(rInt, `
+					url           = "https://test.atlassian.net"
+					username      = "user@example.com"
+					password      = "api-token"
+					commit_events = true
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabServiceJiraExists(jiraResourceName, &jiraService),
+					resource.TestCheckResourceAttr(jiraResourceName, "url", "https://test.atlassian.net"),
+					resource.TestCheckResourceAttr(jiraResourceName, "jira_auth_type", "0"),
+					resource.TestCheckResourceAttr(jiraResourceName, "username", "user@example.com"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      jiraResourceName,
+				ImportStateIdFunc: getJiraProjectID(jiraResourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+			},
+			// Update the jira integration to use a personal access token
+			{
+				Config: testAccGitlabIntegrationJiraConfig(rInt, `
+					url                      = "https://jira.example.com"
+					api_url                  = "https://jira.example.com/rest"
+					jira_auth_type           = 1
+					password                 = "personal-access-token"
+					jira_issue_transition_id = "11,21"
+					jira_issue_prefix        = "PROJ-"
+					jira_issue_regex         = "[A-Z]+-\\d+"
+					merge_requests_events    = true
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabServiceJiraExists(jiraResourceName, &jiraService),
+					resource.TestCheckResourceAttr(jiraResourceName, "url", "https://jira.example.com"),
+					resource.TestCheckResourceAttr(jiraResourceName, "jira_auth_type", "1"),
+					resource.TestCheckResourceAttr(jiraResourceName, "jira_issue_transition_id", "11,21"),
+					resource.TestCheckResourceAttr(jiraResourceName, "jira_issue_prefix", "PROJ-"),
+					resource.TestCheckResourceAttr(jiraResourceName, "jira_issue_regex", "[A-Z]+-\\d+"),
+					resource.TestCheckResourceAttr(jiraResourceName, "merge_requests_events", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      jiraResourceName,
+				ImportStateIdFunc: getJiraProjectID(jiraResourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+			},
+		},
+	})
+}
+
+func testAccGitlabIntegrationJiraConfig(rInt int, settings string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name        = "foo-%d"
+  description = "Terraform acceptance tests"
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_integration_jira" "jira" {
+  project = gitlab_project.foo.id
+  %s
+}
+`, rInt, settings)
+}
@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_integration_mattermost", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_integration_mattermost`" + ` resource allows to manage the lifecycle of a project integration with Mattermost notifications.
+
+~> The ` + "`webhook`" + ` is a secret and not returned by newer GitLab versions, thus changes made to it outside of Terraform are not detected.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#mattermost-notifications)`,
+
+		CreateContext: resourceGitlabIntegrationMattermostCreate,
+		ReadContext:   resourceGitlabIntegrationMattermostRead,
+		UpdateContext: resourceGitlabIntegrationMattermostUpdate,
+		DeleteContext: resourceGitlabIntegrationMattermostDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "ID of the project you want to activate integration on.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"webhook": {
+				Description:  "Webhook URL (ex.: https://mattermost.example.com/hooks/...)",
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validateURLFunc,
+			},
+			"username": {
+				Description: "Username to use.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"channel": {
+				Description: "Default channel to use if no other channel is configured.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"notify_only_broken_pipelines": {
+				Description: "Send notifications for broken pipelines.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"branches_to_be_notified": {
+				Description:  fmt.Sprintf("Branches to send notifications for. Valid options are: %s.", renderValueListForDocs(validBranchesToBeNotified)),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(validBranchesToBeNotified, false),
+			},
+			"confidential_issue_channel": {
+				Description: "The name of the channel to receive confidential issue events notifications.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"confidential_issues_events": {
+				Description: "Enable notifications for confidential issues events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"confidential_note_channel": {
+				Description: "The name of the channel to receive confidential note events notifications.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"confidential_note_events": {
+				Description: "Enable notifications for confidential note events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"issue_channel": {
+				Description: "The name of the channel to receive issue events notifications.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"issues_events": {
+				Description: "Enable notifications for issues events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"merge_request_channel": {
+				Description: "The name of the channel to receive merge request events notifications.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"merge_requests_events": {
+				Description: "Enable notifications for merge requests events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"note_channel": {
+				Description: "The name of the channel to receive note events notifications.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"note_events": {
+				Description: "Enable notifications for note events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"pipeline_channel": {
+				Description: "The name of the channel to receive pipeline events notifications.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"pipeline_events": {
+				Description: "Enable notifications for pipeline events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"push_channel": {
+				Description: "The name of the channel to receive push events notifications.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"push_events": {
+				Description: "Enable notifications for push events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag_push_channel": {
+				Description: "The name of the channel to receive tag push events notifications.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"tag_push_events": {
+				Description: "Enable notifications for tag push events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"wiki_page_channel": {
+				Description: "The name of the channel to receive wiki page events notifications.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"wiki_page_events": {
+				Description: "Enable notifications for wiki page events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabIntegrationMattermostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	d.SetId(project)
+
+	log.Printf("[DEBUG] create gitlab mattermost integration for project %s", project)
+
+	opts := &gitlab.SetMattermostServiceOptions{
+		WebHook:                   gitlab.String(d.Get("webhook").(string)),
+		Username:                  gitlab.String(d.Get("username").(string)),
+		Channel:                   gitlab.String(d.Get("channel").(string)),
+		NotifyOnlyBrokenPipelines: gitlab.Bool(d.Get("notify_only_broken_pipelines").(bool)),
+		BranchesToBeNotified:      gitlab.String(d.Get("branches_to_be_notified").(string)),
+		ConfidentialIssueChannel:  gitlab.String(d.Get("confidential_issue_channel").(string)),
+		ConfidentialIssuesEvents:  gitlab.Bool(d.Get("confidential_issues_events").(bool)),
+		ConfidentialNoteChannel:   gitlab.String(d.Get("confidential_note_channel").(string)),
+		ConfidentialNoteEvents:    gitlab.Bool(d.Get("confidential_note_events").(bool)),
+		IssueChannel:              gitlab.String(d.Get("issue_channel").(string)),
+		IssuesEvents:              gitlab.Bool(d.Get("issues_events").(bool)),
+		MergeRequestChannel:       gitlab.String(d.Get("merge_request_channel").(string)),
+		MergeRequestsEvents:       gitlab.Bool(d.Get("merge_requests_events").(bool)),
+		NoteChannel:               gitlab.String(d.Get("note_channel").(string)),
+		NoteEvents:                gitlab.Bool(d.Get("note_events").(bool)),
+		PipelineChannel:           gitlab.String(d.Get("pipeline_channel").(string)),
+		PipelineEvents:            gitlab.Bool(d.Get("pipeline_events").(bool)),
+		PushChannel:               gitlab.String(d.Get("push_channel").(string)),
+		PushEvents:                gitlab.Bool(d.Get("push_events").(bool)),
+		TagPushChannel:            gitlab.String(d.Get("tag_push_channel").(string)),
+		TagPushEvents:             gitlab.Bool(d.Get("tag_push_events").(bool)),
+		WikiPageChannel:           gitlab.String(d.Get("wiki_page_channel").(string)),
+		WikiPageEvents:            gitlab.Bool(d.Get("wiki_page_events").(bool)),
+	}
+
+	if _, _, err := client.Services.SetMattermostService(project, opts, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabIntegrationMattermostRead(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationMattermostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab mattermost integration for project %s", project)
+
+	service, _, err := client.Services.GetMattermostService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab mattermost integration not found %s", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	// NOTE: newer GitLab versions don't return the webhook, because it's a secret.
+	//       In that case, we keep the webhook from the state.
+	if service.Properties.WebHook != "" {
+		d.Set("webhook", service.Properties.WebHook)
+	}
+	d.Set("username", service.Properties.Username)
+	d.Set("channel", service.Properties.Channel)
+	d.Set("notify_only_broken_pipelines", bool(service.Properties.NotifyOnlyBrokenPipelines))
+	d.Set("branches_to_be_notified", service.Properties.BranchesToBeNotified)
+	d.Set("confidential_issue_channel", service.Properties.ConfidentialIssueChannel)
+	d.Set("confidential_issues_events", service.ConfidentialIssuesEvents)
+	d.Set("confidential_note_channel", service.Properties.ConfidentialNoteChannel)
+	d.Set("confidential_note_events", service.ConfidentialNoteEvents)
+	d.Set("issue_channel", service.Properties.IssueChannel)
+	d.Set("issues_events", service.IssuesEvents)
+	d.Set("merge_request_channel", service.Properties.MergeRequestChannel)
+	d.Set("merge_requests_events", service.MergeRequestsEvents)
+	d.Set("note_channel", service.Properties.NoteChannel)
+	d.Set("note_events", service.NoteEvents)
+	d.Set("pipeline_channel", service.Properties.PipelineChannel)
+	d.Set("pipeline_events", service.PipelineEvents)
+	d.Set("push_channel", service.Properties.PushChannel)
+	d.Set("push_events", service.PushEvents)
+	d.Set("tag_push_channel", service.Properties.TagPushChannel)
+	d.Set("tag_push_events", service.TagPushEvents)
+	d.Set("wiki_page_channel", service.Properties.WikiPageChannel)
+	d.Set("wiki_page_events", service.WikiPageEvents)
+
+	return nil
+}
+
+func resourceGitlabIntegrationMattermostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabIntegrationMattermostCreate(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationMattermostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab mattermost integration for project %s", project)
+
+	if _, err := client.Services.DeleteMattermostService(project, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabIntegrationMattermost_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	mattermostResourceName := "gitlab_integration_mattermost.mattermost"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabIntegrationMattermostDestroy,
+		Steps: []resource.TestStep{
+			// Create a mattermost integration with minimal settings
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_mattermost" "mattermost" {
+						project = %d
+						webhook = "https://mattermost.example.com/hooks/1"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(mattermostResourceName, "webhook", "https://mattermost.example.com/hooks/1"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            mattermostResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"webhook"},
+			},
+			// Update the mattermost integration with channel overrides
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_mattermost" "mattermost" {
+						project                      = %d
+						webhook                      = "https://mattermost.example.com/hooks/2"
+						username                     = "gitlab"
+						channel                      = "town-square"
+						push_events                  = true
+						push_channel                 = "push"
+						merge_requests_events        = true
+						merge_request_channel        = "merge-requests"
+						pipeline_events              = true
+						pipeline_channel             = "pipelines"
+						notify_only_broken_pipelines = true
+						branches_to_be_notified      = "protected"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(mattermostResourceName, "webhook", "https://mattermost.example.com/hooks/2"),
+					resource.TestCheckResourceAttr(mattermostResourceName, "username", "gitlab"),
+					resource.TestCheckResourceAttr(mattermostResourceName, "channel", "town-square"),
+					resource.TestCheckResourceAttr(mattermostResourceName, "push_events", "true"),
+					resource.TestCheckResourceAttr(mattermostResourceName, "push_channel", "push"),
+					resource.TestCheckResourceAttr(mattermostResourceName, "merge_request_channel", "merge-requests"),
+					resource.TestCheckResourceAttr(mattermostResourceName, "pipeline_channel", "pipelines"),
+					resource.TestCheckResourceAttr(mattermostResourceName, "notify_only_broken_pipelines", "true"),
+					resource.TestCheckResourceAttr(mattermostResourceName, "branches_to_be_notified", "protected"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            mattermostResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"webhook"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabIntegrationMattermostDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_integration_mattermost" {
+			continue
+		}
+
+		service, _, err := testGitlabClient.Services.GetMattermostService(rs.Primary.ID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if service.Active {
+			return fmt.Errorf("mattermost integration for project %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
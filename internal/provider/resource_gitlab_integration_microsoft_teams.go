@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_integration_microsoft_teams", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_integration_microsoft_teams`" + ` resource allows to manage the lifecycle of a project integration with Microsoft Teams.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#microsoft-teams)`,
+
+		CreateContext: resourceGitlabIntegrationMicrosoftTeamsCreate,
+		ReadContext:   resourceGitlabIntegrationMicrosoftTeamsRead,
+		UpdateContext: resourceGitlabIntegrationMicrosoftTeamsUpdate,
+		DeleteContext: resourceGitlabIntegrationMicrosoftTeamsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: gitlabIntegrationMicrosoftTeamsGetSchema(),
+	}
+})
+
+func resourceGitlabIntegrationMicrosoftTeamsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	d.SetId(project)
+
+	options := &gitlab.SetMicrosoftTeamsServiceOptions{
+		WebHook:                   gitlab.String(d.Get("webhook").(string)),
+		NotifyOnlyBrokenPipelines: gitlab.Bool(d.Get("notify_only_broken_pipelines").(bool)),
+		BranchesToBeNotified:      gitlab.String(d.Get("branches_to_be_notified").(string)),
+		PushEvents:                gitlab.Bool(d.Get("push_events").(bool)),
+		IssuesEvents:              gitlab.Bool(d.Get("issues_events").(bool)),
+		ConfidentialIssuesEvents:  gitlab.Bool(d.Get("confidential_issues_events").(bool)),
+		MergeRequestsEvents:       gitlab.Bool(d.Get("merge_requests_events").(bool)),
+		TagPushEvents:             gitlab.Bool(d.Get("tag_push_events").(bool)),
+		NoteEvents:                gitlab.Bool(d.Get("note_events").(bool)),
+		ConfidentialNoteEvents:    gitlab.Bool(d.Get("confidential_note_events").(bool)),
+		PipelineEvents:            gitlab.Bool(d.Get("pipeline_events").(bool)),
+		WikiPageEvents:            gitlab.Bool(d.Get("wiki_page_events").(bool)),
+	}
+
+	log.Printf("[DEBUG] Create Gitlab Microsoft Teams integration")
+
+	if _, _, err := client.Services.SetMicrosoftTeamsService(project, options, gitlab.WithContext(ctx)); err != nil {
+		return diag.Errorf("couldn't create Gitlab Microsoft Teams integration: %v", err)
+	}
+
+	return resourceGitlabIntegrationMicrosoftTeamsRead(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationMicrosoftTeamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	_, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] Removing Gitlab Microsoft Teams integration %s because project %s not found", d.Id(), project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Read Gitlab Microsoft Teams integration for project %s", d.Id())
+
+	teamsService, _, err := client.Services.GetMicrosoftTeamsService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab microsoft teams integration not found %s", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("created_at", teamsService.CreatedAt.String())
+	d.Set("updated_at", teamsService.UpdatedAt.String())
+	d.Set("active", teamsService.Active)
+	// NOTE: newer GitLab versions don't return the webhook, because it's a secret.
+	//       In that case, we keep the webhook from the state.
+	if teamsService.Properties.WebHook != "" {
+		d.Set("webhook", teamsService.Properties.WebHook)
+	}
+	d.Set("notify_only_broken_pipelines", teamsService.Properties.NotifyOnlyBrokenPipelines)
+	d.Set("branches_to_be_notified", teamsService.Properties.BranchesToBeNotified)
+	d.Set("push_events", teamsService.PushEvents)
+	d.Set("issues_events", teamsService.IssuesEvents)
+	d.Set("confidential_issues_events", teamsService.ConfidentialIssuesEvents)
+	d.Set("merge_requests_events", teamsService.MergeRequestsEvents)
+	d.Set("tag_push_events", teamsService.TagPushEvents)
+	d.Set("note_events", teamsService.NoteEvents)
+	d.Set("confidential_note_events", teamsService.ConfidentialNoteEvents)
+	d.Set("pipeline_events", teamsService.PipelineEvents)
+	d.Set("wiki_page_events", teamsService.WikiPageEvents)
+
+	return nil
+}
+
+func resourceGitlabIntegrationMicrosoftTeamsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabIntegrationMicrosoftTeamsCreate(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationMicrosoftTeamsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] Delete Gitlab Microsoft Teams integration for project %s", d.Id())
+
+	_, err := client.Services.DeleteMicrosoftTeamsService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(err)
+}
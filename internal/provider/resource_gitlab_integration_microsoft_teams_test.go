@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestResourceGitlabIntegrationMicrosoftTeamsRead_projectNotFound(t *testing.T) {
+	testResourceReadNotFound(t, "gitlab_integration_microsoft_teams", map[string]interface{}{"project": "foo/bar"}, "foo/bar")
+}
+
+func TestAccGitlabIntegrationMicrosoftTeams_basic(t *testing.T) {
+	var teamsService gitlab.MicrosoftTeamsService
+	rInt := acctest.RandInt()
+	teamsResourceName := "gitlab_integration_microsoft_teams.teams"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabServiceMicrosoftTeamsDestroy,
+		Steps: []resource.TestStep{
+			// Create a project and a teams integration
+			{
+				Config: testAccGitlabIntegrationMicrosoftTeamsConfig(rInt, `
+					webhook                 = "https://test.com/?token=4"
+					branches_to_be_notified = "all"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabServiceMicrosoftTeamsExists(teamsResourceName, &teamsService),
+					resource.TestCheckResourceAttr(teamsResourceName, "webhook", "https://test.com/?token=4"),
+					resource.TestCheckResourceAttr(teamsResourceName, "branches_to_be_notified", "all"),
+					resource.TestCheckResourceAttr(teamsResourceName, "push_events", "false"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            teamsResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"webhook"},
+			},
+			// Update the teams integration
+			{
+				Config: testAccGitlabIntegrationMicrosoftTeamsConfig(rInt, `
+					webhook                      = "https://testurl.com/?token=5"
+					notify_only_broken_pipelines = true
+					branches_to_be_notified      = "default_and_protected"
+					push_events                  = true
+					pipeline_events              = true
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabServiceMicrosoftTeamsExists(teamsResourceName, &teamsService),
+					resource.TestCheckResourceAttr(teamsResourceName, "webhook", "https://testurl.com/?token=5"),
+					resource.TestCheckResourceAttr(teamsResourceName, "notify_only_broken_pipelines", "true"),
+					resource.TestCheckResourceAttr(teamsResourceName, "branches_to_be_notified", "default_and_protected"),
+					resource.TestCheckResourceAttr(teamsResourceName, "push_events", "true"),
+					resource.TestCheckResourceAttr(teamsResourceName, "pipeline_events", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            teamsResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"webhook"},
+			},
+		},
+	})
+}
+
+func testAccGitlabIntegrationMicrosoftTeamsConfig(rInt int, settings string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name        = "foo-%d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_integration_microsoft_teams" "teams" {
+  project = gitlab_project.foo.id
+  %s
+}
+`, rInt, settings)
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_integration_pipelines_email", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_integration_pipelines_email`" + ` resource allows to manage the lifecycle of a project integration with Pipeline Emails Service.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#pipeline-emails)`,
+
+		CreateContext: resourceGitlabIntegrationPipelinesEmailCreate,
+		ReadContext:   resourceGitlabIntegrationPipelinesEmailRead,
+		UpdateContext: resourceGitlabIntegrationPipelinesEmailCreate,
+		DeleteContext: resourceGitlabIntegrationPipelinesEmailDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: gitlabIntegrationPipelinesEmailGetSchema(),
+	}
+})
+
+func resourceGitlabIntegrationPipelinesEmailSetToState(d *schema.ResourceData, service *gitlab.PipelinesEmailService) {
+	d.Set("recipients", strings.Split(service.Properties.Recipients, ",")) // lintignore: XR004 // TODO: Resolve this tfproviderlint issue
+	d.Set("notify_only_broken_pipelines", service.Properties.NotifyOnlyBrokenPipelines)
+	d.Set("branches_to_be_notified", service.Properties.BranchesToBeNotified)
+}
+
+func resourceGitlabIntegrationPipelinesEmailCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	d.SetId(project)
+	options := &gitlab.SetPipelinesEmailServiceOptions{
+		Recipients:                gitlab.String(strings.Join(*stringSetToStringSlice(d.Get("recipients").(*schema.Set)), ",")),
+		NotifyOnlyBrokenPipelines: gitlab.Bool(d.Get("notify_only_broken_pipelines").(bool)),
+		BranchesToBeNotified:      gitlab.String(d.Get("branches_to_be_notified").(string)),
+	}
+
+	log.Printf("[DEBUG] create gitlab pipelines emails integration for project %s", project)
+
+	_, _, err := client.Services.SetPipelinesEmailService(project, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabIntegrationPipelinesEmailRead(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationPipelinesEmailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab pipelines emails integration for project %s", project)
+
+	service, _, err := client.Services.GetPipelinesEmailService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab pipelines emails integration not found for project %s", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	resourceGitlabIntegrationPipelinesEmailSetToState(d, service)
+	return nil
+}
+
+func resourceGitlabIntegrationPipelinesEmailDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab pipelines email integration for project %s", project)
+
+	_, err := client.Services.DeletePipelinesEmailService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabIntegrationPipelinesEmail_basic(t *testing.T) {
+	var pipelinesEmailService gitlab.PipelinesEmailService
+	rInt := acctest.RandInt()
+	pipelinesEmailResourceName := "gitlab_integration_pipelines_email.email"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabServicePipelinesEmailDestroy,
+		Steps: []resource.TestStep{
+			// Create a project and a pipelines email integration
+			{
+				Config: testAccGitlabIntegrationPipelinesEmailConfig(rInt, `
+					recipients = ["test@example.com"]
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabServicePipelinesEmailExists(pipelinesEmailResourceName, &pipelinesEmailService),
+					testRecipients(&pipelinesEmailService, []string{"test@example.com"}),
+					resource.TestCheckResourceAttr(pipelinesEmailResourceName, "notify_only_broken_pipelines", "true"),
+					resource.TestCheckResourceAttr(pipelinesEmailResourceName, "branches_to_be_notified", "default"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      pipelinesEmailResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the pipelines email integration
+			{
+				Config: testAccGitlabIntegrationPipelinesEmailConfig(rInt, `
+					recipients                   = ["test@example.com", "test2@example.com"]
+					notify_only_broken_pipelines = false
+					branches_to_be_notified      = "all"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabServicePipelinesEmailExists(pipelinesEmailResourceName, &pipelinesEmailService),
+					testRecipients(&pipelinesEmailService, []string{"test@example.com", "test2@example.com"}),
+					resource.TestCheckResourceAttr(pipelinesEmailResourceName, "notify_only_broken_pipelines", "false"),
+					resource.TestCheckResourceAttr(pipelinesEmailResourceName, "branches_to_be_notified", "all"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      pipelinesEmailResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGitlabIntegrationPipelinesEmailConfig(rInt int, settings string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name        = "foo-%d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_integration_pipelines_email" "email" {
+  project = gitlab_project.foo.id
+  %s
+}
+`, rInt, settings)
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_integration_prometheus", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_integration_prometheus`" + ` resource allows to manage the lifecycle of a project integration with an external Prometheus instance.
+
+~> The ` + "`google_iap_service_account_json`" + ` is a secret and not returned by the GitLab API, thus changes made to it outside of Terraform are not detected.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#prometheus)`,
+
+		CreateContext: resourceGitlabIntegrationPrometheusCreate,
+		ReadContext:   resourceGitlabIntegrationPrometheusRead,
+		UpdateContext: resourceGitlabIntegrationPrometheusUpdate,
+		DeleteContext: resourceGitlabIntegrationPrometheusDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "ID of the project you want to activate integration on.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"api_url": {
+				Description:  "The Prometheus API base URL, e.g. `https://prometheus.example.com`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateURLFunc,
+			},
+			"google_iap_audience_client_id": {
+				Description:  "The client ID of the IAP secured resource (looks like `IAP_CLIENT_ID.apps.googleusercontent.com`). Required if the Prometheus instance is protected with Google Identity-Aware Proxy.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"google_iap_service_account_json"},
+			},
+			"google_iap_service_account_json": {
+				Description:  "The contents of the credentials.json file of your Google service account. Required if the Prometheus instance is protected with Google Identity-Aware Proxy.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"google_iap_audience_client_id"},
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"active": {
+				Description: "Whether the integration is active.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabIntegrationPrometheusCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	d.SetId(project)
+
+	log.Printf("[DEBUG] create gitlab prometheus integration for project %s", project)
+
+	opts := &gitlab.SetPrometheusServiceOptions{
+		APIURL:                      gitlab.String(d.Get("api_url").(string)),
+		GoogleIAPAudienceClientID:   gitlab.String(d.Get("google_iap_audience_client_id").(string)),
+		GoogleIAPServiceAccountJSON: gitlab.String(d.Get("google_iap_service_account_json").(string)),
+	}
+
+	if _, _, err := client.Services.SetPrometheusService(project, opts, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabIntegrationPrometheusRead(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationPrometheusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab prometheus integration for project %s", project)
+
+	service, _, err := client.Services.GetPrometheusService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab prometheus integration not found %s", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("api_url", service.Properties.APIURL)
+	d.Set("google_iap_audience_client_id", service.Properties.GoogleIAPAudienceClientID)
+	d.Set("active", service.Active)
+
+	return nil
+}
+
+func resourceGitlabIntegrationPrometheusUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabIntegrationPrometheusCreate(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationPrometheusDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab prometheus integration for project %s", project)
+
+	if _, err := client.Services.DeletePrometheusService(project, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabIntegrationPrometheus_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	prometheusResourceName := "gitlab_integration_prometheus.prometheus"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabIntegrationPrometheusDestroy,
+		Steps: []resource.TestStep{
+			// Create a prometheus integration with minimal settings
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_prometheus" "prometheus" {
+						project = %d
+						api_url = "https://prometheus.example.com"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(prometheusResourceName, "active", "true"),
+					resource.TestCheckResourceAttr(prometheusResourceName, "api_url", "https://prometheus.example.com"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      prometheusResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the prometheus integration to use Google IAP
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_prometheus" "prometheus" {
+						project                         = %d
+						api_url                         = "https://prometheus.example.org"
+						google_iap_audience_client_id   = "IAP_CLIENT_ID.apps.googleusercontent.com"
+						google_iap_service_account_json = jsonencode({ type = "service_account" })
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(prometheusResourceName, "api_url", "https://prometheus.example.org"),
+					resource.TestCheckResourceAttr(prometheusResourceName, "google_iap_audience_client_id", "IAP_CLIENT_ID.apps.googleusercontent.com"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            prometheusResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"google_iap_service_account_json"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabIntegrationPrometheusDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_integration_prometheus" {
+			continue
+		}
+
+		service, _, err := testGitlabClient.Services.GetPrometheusService(rs.Primary.ID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if service.Active {
+			return fmt.Errorf("prometheus integration for project %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
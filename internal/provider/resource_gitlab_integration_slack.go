@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_integration_slack", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_integration_slack`" + ` resource allows to manage the lifecycle of a project integration with Slack notifications.
+
+~> The ` + "`webhook`" + ` is a secret and not returned by newer GitLab versions, thus changes made to it outside of Terraform are not detected.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#slack-notifications)`,
+
+		CreateContext: resourceGitlabIntegrationSlackCreate,
+		ReadContext:   resourceGitlabIntegrationSlackRead,
+		UpdateContext: resourceGitlabIntegrationSlackUpdate,
+		DeleteContext: resourceGitlabIntegrationSlackDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: gitlabIntegrationSlackGetSchema(),
+	}
+})
+
+func resourceGitlabIntegrationSlackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	d.SetId(project)
+
+	log.Printf("[DEBUG] create gitlab slack integration for project %s", project)
+
+	opts := &gitlab.SetSlackServiceOptions{
+		WebHook: gitlab.String(d.Get("webhook").(string)),
+	}
+
+	opts.Username = gitlab.String(d.Get("username").(string))
+	opts.Channel = gitlab.String(d.Get("channel").(string))
+	opts.NotifyOnlyBrokenPipelines = gitlab.Bool(d.Get("notify_only_broken_pipelines").(bool))
+	opts.NotifyOnlyDefaultBranch = gitlab.Bool(d.Get("notify_only_default_branch").(bool))
+	opts.BranchesToBeNotified = gitlab.String(d.Get("branches_to_be_notified").(string))
+	opts.AlertChannel = gitlab.String(d.Get("alert_channel").(string))
+	opts.AlertEvents = gitlab.Bool(d.Get("alert_events").(bool))
+	opts.ConfidentialIssueChannel = gitlab.String(d.Get("confidential_issue_channel").(string))
+	opts.ConfidentialIssuesEvents = gitlab.Bool(d.Get("confidential_issues_events").(bool))
+	opts.ConfidentialNoteChannel = gitlab.String(d.Get("confidential_note_channel").(string))
+	opts.ConfidentialNoteEvents = gitlab.Bool(d.Get("confidential_note_events").(bool))
+	opts.DeploymentChannel = gitlab.String(d.Get("deployment_channel").(string))
+	opts.DeploymentEvents = gitlab.Bool(d.Get("deployment_events").(bool))
+	opts.IssueChannel = gitlab.String(d.Get("issue_channel").(string))
+	opts.IssuesEvents = gitlab.Bool(d.Get("issues_events").(bool))
+	opts.MergeRequestChannel = gitlab.String(d.Get("merge_request_channel").(string))
+	opts.MergeRequestsEvents = gitlab.Bool(d.Get("merge_requests_events").(bool))
+	opts.NoteChannel = gitlab.String(d.Get("note_channel").(string))
+	opts.NoteEvents = gitlab.Bool(d.Get("note_events").(bool))
+	opts.PipelineChannel = gitlab.String(d.Get("pipeline_channel").(string))
+	opts.PipelineEvents = gitlab.Bool(d.Get("pipeline_events").(bool))
+	opts.PushChannel = gitlab.String(d.Get("push_channel").(string))
+	opts.PushEvents = gitlab.Bool(d.Get("push_events").(bool))
+	opts.TagPushChannel = gitlab.String(d.Get("tag_push_channel").(string))
+	opts.TagPushEvents = gitlab.Bool(d.Get("tag_push_events").(bool))
+	opts.WikiPageChannel = gitlab.String(d.Get("wiki_page_channel").(string))
+	opts.WikiPageEvents = gitlab.Bool(d.Get("wiki_page_events").(bool))
+
+	_, _, err := client.Services.SetSlackService(project, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabIntegrationSlackRead(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationSlackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	id := d.Id()
+	project := d.Get("project").(string)
+	if id != project && project != "" {
+		d.SetId(project)
+		log.Printf("[WARN] changed gitlab slack integration ID from %s to its project ID %s", id, project)
+	} else {
+		project = id
+	}
+
+	log.Printf("[DEBUG] read gitlab slack integration for project %s", project)
+
+	service, _, err := client.Services.GetSlackService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab slack integration not found %s", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	// NOTE: newer GitLab versions don't return the webhook, because it's a secret.
+	//       In that case, we keep the webhook from the state.
+	if service.Properties.WebHook != "" {
+		d.Set("webhook", service.Properties.WebHook)
+	}
+	d.Set("username", service.Properties.Username)
+	d.Set("channel", service.Properties.Channel)
+	d.Set("notify_only_broken_pipelines", bool(service.Properties.NotifyOnlyBrokenPipelines))
+	d.Set("notify_only_default_branch", bool(service.Properties.NotifyOnlyDefaultBranch))
+	d.Set("branches_to_be_notified", service.Properties.BranchesToBeNotified)
+	d.Set("alert_channel", service.Properties.AlertChannel)
+	d.Set("alert_events", service.AlertEvents)
+	d.Set("confidential_issue_channel", service.Properties.ConfidentialIssueChannel)
+	d.Set("confidential_issues_events", service.ConfidentialIssuesEvents)
+	d.Set("confidential_note_channel", service.Properties.ConfidentialNoteChannel)
+	d.Set("confidential_note_events", service.ConfidentialNoteEvents)
+	d.Set("deployment_channel", service.Properties.DeploymentChannel)
+	d.Set("deployment_events", service.DeploymentEvents)
+	d.Set("issue_channel", service.Properties.IssueChannel)
+	d.Set("issues_events", service.IssuesEvents)
+	d.Set("job_events", service.JobEvents)
+	d.Set("merge_request_channel", service.Properties.MergeRequestChannel)
+	d.Set("merge_requests_events", service.MergeRequestsEvents)
+	d.Set("note_channel", service.Properties.NoteChannel)
+	d.Set("note_events", service.NoteEvents)
+	d.Set("pipeline_channel", service.Properties.PipelineChannel)
+	d.Set("pipeline_events", service.PipelineEvents)
+	d.Set("push_channel", service.Properties.PushChannel)
+	d.Set("push_events", service.PushEvents)
+	d.Set("tag_push_channel", service.Properties.TagPushChannel)
+	d.Set("tag_push_events", service.TagPushEvents)
+	d.Set("wiki_page_channel", service.Properties.WikiPageChannel)
+	d.Set("wiki_page_events", service.WikiPageEvents)
+
+	return nil
+}
+
+func resourceGitlabIntegrationSlackUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabIntegrationSlackCreate(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationSlackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab slack integration for project %s", project)
+
+	_, err := client.Services.DeleteSlackService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabIntegrationSlack_basic(t *testing.T) {
+	var slackService gitlab.SlackService
+	rInt := acctest.RandInt()
+	slackResourceName := "gitlab_integration_slack.slack"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabServiceSlackDestroy,
+		Steps: []resource.TestStep{
+			// Create a project and a slack integration with minimal settings
+			{
+				Config: testAccGitlabIntegrationSlackConfig(rInt, `
+					webhook = "https://test.com"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabServiceExists(slackResourceName, &slackService),
+					resource.TestCheckResourceAttr(slackResourceName, "webhook", "https://test.com"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      slackResourceName,
+				ImportStateIdFunc: getSlackProjectID(slackResourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"webhook",
+					"notify_only_broken_pipelines",
+					"notify_only_default_branch",
+				},
+			},
+			// Update the slack integration with more settings
+			{
+				Config: testAccGitlabIntegrationSlackConfig(rInt, `
+					webhook                      = "https://testwebhook.com"
+					username                     = "test"
+					channel                      = "default"
+					push_events                  = true
+					push_channel                 = "push"
+					issues_events                = true
+					issue_channel                = "issues"
+					merge_requests_events        = true
+					merge_request_channel        = "merge-requests"
+					pipeline_events              = true
+					pipeline_channel             = "pipelines"
+					alert_events                 = true
+					alert_channel                = "alerts"
+					deployment_events            = true
+					deployment_channel           = "deployments"
+					confidential_note_events     = true
+					confidential_note_channel    = "confidential-notes"
+					notify_only_broken_pipelines = true
+					branches_to_be_notified      = "default_and_protected"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabServiceExists(slackResourceName, &slackService),
+					resource.TestCheckResourceAttr(slackResourceName, "webhook", "https://testwebhook.com"),
+					resource.TestCheckResourceAttr(slackResourceName, "channel", "default"),
+					resource.TestCheckResourceAttr(slackResourceName, "push_channel", "push"),
+					resource.TestCheckResourceAttr(slackResourceName, "alert_events", "true"),
+					resource.TestCheckResourceAttr(slackResourceName, "alert_channel", "alerts"),
+					resource.TestCheckResourceAttr(slackResourceName, "deployment_events", "true"),
+					resource.TestCheckResourceAttr(slackResourceName, "deployment_channel", "deployments"),
+					resource.TestCheckResourceAttr(slackResourceName, "confidential_note_channel", "confidential-notes"),
+					resource.TestCheckResourceAttr(slackResourceName, "notify_only_broken_pipelines", "true"),
+					resource.TestCheckResourceAttr(slackResourceName, "branches_to_be_notified", "default_and_protected"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      slackResourceName,
+				ImportStateIdFunc: getSlackProjectID(slackResourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"webhook",
+					"notify_only_broken_pipelines",
+					"notify_only_default_branch",
+				},
+			},
+		},
+	})
+}
+
+func testAccGitlabIntegrationSlackConfig(rInt int, settings string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name        = "foo-%d"
+  description = "Terraform acceptance tests"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_integration_slack" "slack" {
+  project = gitlab_project.foo.id
+  %s
+}
+`, rInt, settings)
+}
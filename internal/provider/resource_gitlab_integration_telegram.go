@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_integration_telegram", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_integration_telegram`" + ` resource allows to manage the lifecycle of a project integration with Telegram.
+
+~> The ` + "`token`" + ` is a secret and not returned by the GitLab API, thus changes made to it outside of Terraform are not detected.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#telegram)`,
+
+		CreateContext: resourceGitlabIntegrationTelegramCreate,
+		ReadContext:   resourceGitlabIntegrationTelegramRead,
+		UpdateContext: resourceGitlabIntegrationTelegramUpdate,
+		DeleteContext: resourceGitlabIntegrationTelegramDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "ID of the project you want to activate integration on.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"token": {
+				Description: "The Telegram bot token, e.g. `123456:ABC-DEF1234ghIkl-zyx57W2v1u123ew11`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"room": {
+				Description: "Unique identifier for the target chat or the username of the target channel, in the format `@channelusername`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"notify_only_broken_pipelines": {
+				Description: "Send notifications for broken pipelines.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"branches_to_be_notified": {
+				Description:  fmt.Sprintf("Branches to send notifications for. Valid options are: %s.", renderValueListForDocs(validBranchesToBeNotified)),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(validBranchesToBeNotified, false),
+			},
+			"confidential_issues_events": {
+				Description: "Enable notifications for confidential issues events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"confidential_note_events": {
+				Description: "Enable notifications for confidential note events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"issues_events": {
+				Description: "Enable notifications for issues events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"merge_requests_events": {
+				Description: "Enable notifications for merge requests events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"note_events": {
+				Description: "Enable notifications for note events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"pipeline_events": {
+				Description: "Enable notifications for pipeline events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"push_events": {
+				Description: "Enable notifications for push events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag_push_events": {
+				Description: "Enable notifications for tag push events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"wiki_page_events": {
+				Description: "Enable notifications for wiki page events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"active": {
+				Description: "Whether the integration is active.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabIntegrationTelegramCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	d.SetId(project)
+
+	log.Printf("[DEBUG] create gitlab telegram integration for project %s", project)
+
+	opts := &gitlab.SetTelegramServiceOptions{
+		Token:                     gitlab.String(d.Get("token").(string)),
+		Room:                      gitlab.String(d.Get("room").(string)),
+		NotifyOnlyBrokenPipelines: gitlab.Bool(d.Get("notify_only_broken_pipelines").(bool)),
+		BranchesToBeNotified:      gitlab.String(d.Get("branches_to_be_notified").(string)),
+		ConfidentialIssuesEvents:  gitlab.Bool(d.Get("confidential_issues_events").(bool)),
+		ConfidentialNoteEvents:    gitlab.Bool(d.Get("confidential_note_events").(bool)),
+		IssuesEvents:              gitlab.Bool(d.Get("issues_events").(bool)),
+		MergeRequestsEvents:       gitlab.Bool(d.Get("merge_requests_events").(bool)),
+		NoteEvents:                gitlab.Bool(d.Get("note_events").(bool)),
+		PipelineEvents:            gitlab.Bool(d.Get("pipeline_events").(bool)),
+		PushEvents:                gitlab.Bool(d.Get("push_events").(bool)),
+		TagPushEvents:             gitlab.Bool(d.Get("tag_push_events").(bool)),
+		WikiPageEvents:            gitlab.Bool(d.Get("wiki_page_events").(bool)),
+	}
+
+	if _, _, err := client.Services.SetTelegramService(project, opts, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabIntegrationTelegramRead(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationTelegramRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab telegram integration for project %s", project)
+
+	service, _, err := client.Services.GetTelegramService(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab telegram integration not found %s", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("room", service.Properties.Room)
+	d.Set("notify_only_broken_pipelines", service.Properties.NotifyOnlyBrokenPipelines)
+	d.Set("branches_to_be_notified", service.Properties.BranchesToBeNotified)
+	d.Set("confidential_issues_events", service.ConfidentialIssuesEvents)
+	d.Set("confidential_note_events", service.ConfidentialNoteEvents)
+	d.Set("issues_events", service.IssuesEvents)
+	d.Set("merge_requests_events", service.MergeRequestsEvents)
+	d.Set("note_events", service.NoteEvents)
+	d.Set("pipeline_events", service.PipelineEvents)
+	d.Set("push_events", service.PushEvents)
+	d.Set("tag_push_events", service.TagPushEvents)
+	d.Set("wiki_page_events", service.WikiPageEvents)
+	d.Set("active", service.Active)
+
+	return nil
+}
+
+func resourceGitlabIntegrationTelegramUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceGitlabIntegrationTelegramCreate(ctx, d, meta)
+}
+
+func resourceGitlabIntegrationTelegramDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] delete gitlab telegram integration for project %s", project)
+
+	if _, err := client.Services.DeleteTelegramService(project, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabIntegrationTelegram_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	telegramResourceName := "gitlab_integration_telegram.telegram"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabIntegrationTelegramDestroy,
+		Steps: []resource.TestStep{
+			// Create a telegram integration with minimal settings
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_telegram" "telegram" {
+						project = %d
+						token   = "123456:ABC-DEF1234ghIkl-zyx57W2v1u123ew11"
+						room    = "@gitlab"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(telegramResourceName, "active", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            telegramResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Update the telegram integration
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_integration_telegram" "telegram" {
+						project                      = %d
+						token                        = "123456:ABC-DEF1234ghIkl-zyx57W2v1u123ew22"
+						room                         = "-1001234567890"
+						notify_only_broken_pipelines = true
+						branches_to_be_notified      = "protected"
+						push_events                  = false
+						issues_events                = true
+						merge_requests_events        = true
+						pipeline_events              = true
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(telegramResourceName, "room", "-1001234567890"),
+					resource.TestCheckResourceAttr(telegramResourceName, "notify_only_broken_pipelines", "true"),
+					resource.TestCheckResourceAttr(telegramResourceName, "branches_to_be_notified", "protected"),
+					resource.TestCheckResourceAttr(telegramResourceName, "push_events", "false"),
+					resource.TestCheckResourceAttr(telegramResourceName, "issues_events", "true"),
+					resource.TestCheckResourceAttr(telegramResourceName, "merge_requests_events", "true"),
+					resource.TestCheckResourceAttr(telegramResourceName, "pipeline_events", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            telegramResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabIntegrationTelegramDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_integration_telegram" {
+			continue
+		}
+
+		service, _, err := testGitlabClient.Services.GetTelegramService(rs.Primary.ID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if service.Active {
+			return fmt.Errorf("telegram integration for project %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
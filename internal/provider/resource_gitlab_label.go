@@ -115,7 +115,7 @@ func resourceGitlabLabelUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	log.Printf("[DEBUG] update gitlab label %s", d.Id())
 
-	_, _, err := client.Labels.UpdateLabel(project, options, gitlab.WithContext(ctx))
+	_, _, err := client.Labels.UpdateLabel(project, nil, options, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -131,7 +131,7 @@ func resourceGitlabLabelDelete(ctx context.Context, d *schema.ResourceData, meta
 		Name: gitlab.String(d.Id()),
 	}
 
-	_, err := client.Labels.DeleteLabel(project, options, gitlab.WithContext(ctx))
+	_, err := client.Labels.DeleteLabel(project, nil, options, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -147,12 +147,12 @@ func resourceGitlabLabelImporter(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	d.SetId(parts[1])
-	project, _, err := client.Projects.GetProject(parts[0], nil, gitlab.WithContext(ctx))
+	projectID, err := gitlabProjectID(ctx, client, parts[0])
 	if err != nil {
 		return nil, err
 	}
 
-	if err := d.Set("project", strconv.Itoa(project.ID)); err != nil {
+	if err := d.Set("project", strconv.Itoa(projectID)); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_license", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_license`" + ` resource allows to manage the lifecycle of a license for GitLab Enterprise Edition.
+
+-> This resource requires administration privileges.
+
+~> Destroying this resource deletes the license from the GitLab instance. If it's the current license, GitLab falls back to the previously uploaded license, if any.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/license.html)`,
+
+		CreateContext: resourceGitlabLicenseCreate,
+		ReadContext:   resourceGitlabLicenseRead,
+		DeleteContext: resourceGitlabLicenseDelete,
+
+		Schema: map[string]*schema.Schema{
+			"license": {
+				Description: "The license key to upload.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.TrimSpace(old) == strings.TrimSpace(new)
+				},
+			},
+			"plan": {
+				Description: "The plan of the license, e.g. `premium` or `ultimate`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "The time when the license was uploaded.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"starts_at": {
+				Description: "The date when the license starts, in `YYYY-MM-DD` format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"expires_at": {
+				Description: "The date when the license expires, in `YYYY-MM-DD` format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"expired": {
+				Description: "Whether the license is expired.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"historical_max": {
+				Description: "The maximum number of simultaneous users during the license period.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"maximum_user_count": {
+				Description: "The maximum number of users of the license.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"user_limit": {
+				Description: "The number of users the license is valid for.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"active_users": {
+				Description: "The number of currently active users.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"overage": {
+				Description: "The number of users exceeding the user limit.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"licensee_name": {
+				Description: "The name of the licensee.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"licensee_email": {
+				Description: "The email of the licensee.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"licensee_company": {
+				Description: "The company of the licensee.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabLicenseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	options := &gitlab.AddLicenseOptions{
+		License: gitlab.String(strings.TrimSpace(d.Get("license").(string))),
+	}
+
+	log.Printf("[DEBUG] create gitlab license")
+
+	license, _, err := client.License.AddLicense(options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", license.ID))
+	return resourceGitlabLicenseRead(ctx, d, meta)
+}
+
+func resourceGitlabLicenseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	licenseID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] read gitlab license %d", licenseID)
+
+	license, err := resourceGitlabLicenseGet(ctx, client, licenseID)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab license %d not found, removing from state", licenseID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("plan", license.Plan)
+	if license.CreatedAt != nil {
+		d.Set("created_at", license.CreatedAt.Format(time.RFC3339))
+	}
+	if license.StartsAt != nil {
+		d.Set("starts_at", license.StartsAt.String())
+	}
+	if license.ExpiresAt != nil {
+		d.Set("expires_at", license.ExpiresAt.String())
+	}
+	d.Set("expired", license.Expired)
+	d.Set("historical_max", license.HistoricalMax)
+	d.Set("maximum_user_count", license.MaximumUserCount)
+	d.Set("user_limit", license.UserLimit)
+	d.Set("active_users", license.ActiveUsers)
+	d.Set("overage", license.Overage)
+	d.Set("licensee_name", license.Licensee.Name)
+	d.Set("licensee_email", license.Licensee.Email)
+	d.Set("licensee_company", license.Licensee.Company)
+	return nil
+}
+
+func resourceGitlabLicenseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	licenseID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] delete gitlab license %d", licenseID)
+
+	if _, err := client.License.DeleteLicense(licenseID, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceGitlabLicenseGet gets a single license by its id.
+// NOTE: the GetLicense method of go-gitlab only returns the current license, not the license of a given ID.
+func resourceGitlabLicenseGet(ctx context.Context, client *gitlab.Client, licenseID int) (*gitlab.License, error) {
+	u := fmt.Sprintf("license/%d", licenseID)
+	req, err := client.NewRequest(http.MethodGet, u, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	license := new(gitlab.License)
+	if _, err := client.Do(req, license); err != nil {
+		return nil, err
+	}
+	return license, nil
+}
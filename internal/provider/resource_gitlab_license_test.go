@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabLicense_basic(t *testing.T) {
+	testAccCheck(t)
+	testAccCheckEE(t)
+
+	// NOTE: the license used for the EE acceptance tests is uploaded again.
+	license, err := os.ReadFile("../../Gitlab-license.txt")
+	if err != nil {
+		t.Skipf("Test is skipped, because the license file is not available: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabLicenseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_license" "this" {
+						license = %q
+					}
+				`, string(license)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_license.this", "plan"),
+					resource.TestCheckResourceAttrSet("gitlab_license.this", "created_at"),
+					resource.TestCheckResourceAttrSet("gitlab_license.this", "expires_at"),
+					resource.TestCheckResourceAttr("gitlab_license.this", "expired", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabLicenseDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_license" {
+			continue
+		}
+
+		licenseID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = resourceGitlabLicenseGet(context.Background(), testGitlabClient, licenseID)
+		if err == nil {
+			return fmt.Errorf("license %d still exists", licenseID)
+		}
+		if !is404(err) {
+			return err
+		}
+	}
+	return nil
+}
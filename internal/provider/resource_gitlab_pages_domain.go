@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_pages_domain", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_pages_domain`" + ` resource allows to manage the lifecycle of a custom domain for GitLab Pages of a project.
+
+-> To verify the domain, create a DNS ` + "`TXT`" + ` record with the ` + "`verification_code`" + `. While the domain is not verified, each refresh triggers a new verification attempt.
+
+~> The ` + "`key`" + ` is a secret and not returned by the GitLab API, thus changes made to it outside of Terraform are not detected.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/pages_domains.html)`,
+
+		CreateContext: resourceGitlabPagesDomainCreate,
+		ReadContext:   resourceGitlabPagesDomainRead,
+		UpdateContext: resourceGitlabPagesDomainUpdate,
+		DeleteContext: resourceGitlabPagesDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project owning the Pages domain.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"domain": {
+				Description: "The custom domain, e.g. `pages.example.com`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"auto_ssl_enabled": {
+				Description: "Enables automatic generation of SSL certificates issued by Let's Encrypt for the domain.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"certificate": {
+				Description:  "The certificate in PEM format with intermediates following in most specific to least specific order. Must not be set when `auto_ssl_enabled` is `true`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"key"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.TrimSpace(old) == strings.TrimSpace(new)
+				},
+			},
+			"key": {
+				Description:  "The certificate key in PEM format.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"certificate"},
+			},
+			"url": {
+				Description: "The URL of the domain.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"verified": {
+				Description: "Whether the domain is verified.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"verification_code": {
+				Description: "The verification code to add as DNS `TXT` record to verify the domain.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"enabled_until": {
+				Description: "The time until which the domain stays enabled without being verified.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"certificate_expired": {
+				Description: "Whether the certificate of the domain is expired.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"certificate_expiration": {
+				Description: "The expiration time of the certificate of the domain.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabPagesDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	domain := d.Get("domain").(string)
+
+	options := &gitlab.CreatePagesDomainOptions{
+		Domain:         gitlab.String(domain),
+		AutoSslEnabled: gitlab.Bool(d.Get("auto_ssl_enabled").(bool)),
+	}
+	if v, ok := d.GetOk("certificate"); ok {
+		options.Certificate = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("key"); ok {
+		options.Key = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create gitlab pages domain %s for project %s", domain, project)
+
+	if _, _, err := client.PagesDomains.CreatePagesDomain(project, options, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildTwoPartID(&project, &domain))
+	return resourceGitlabPagesDomainRead(ctx, d, meta)
+}
+
+func resourceGitlabPagesDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, domain, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read gitlab pages domain %s of project %s", domain, project)
+
+	pagesDomain, _, err := client.PagesDomains.GetPagesDomain(project, domain, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab pages domain %s of project %s not found, removing from state", domain, project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if !pagesDomain.Verified {
+		log.Printf("[DEBUG] gitlab pages domain %s of project %s is not verified, trying to verify it", domain, project)
+		verifiedDomain, err := resourceGitlabPagesDomainVerify(ctx, client, project, domain)
+		if err != nil {
+			// NOTE: a failed verification is not an error, the DNS record may not be propagated yet.
+			log.Printf("[WARN] failed to verify gitlab pages domain %s of project %s: %v", domain, project, err)
+		} else {
+			pagesDomain = verifiedDomain
+		}
+	}
+
+	d.Set("project", project)
+	d.Set("domain", pagesDomain.Domain)
+	d.Set("auto_ssl_enabled", pagesDomain.AutoSslEnabled)
+	// NOTE: with auto SSL the certificate is managed by GitLab, thus we only read user-provided certificates.
+	if !pagesDomain.AutoSslEnabled {
+		d.Set("certificate", pagesDomain.Certificate.Certificate)
+	}
+	d.Set("url", pagesDomain.URL)
+	d.Set("verified", pagesDomain.Verified)
+	d.Set("verification_code", pagesDomain.VerificationCode)
+	if pagesDomain.EnabledUntil != nil {
+		d.Set("enabled_until", pagesDomain.EnabledUntil.Format(time.RFC3339))
+	} else {
+		d.Set("enabled_until", "")
+	}
+	d.Set("certificate_expired", pagesDomain.Certificate.Expired)
+	if pagesDomain.Certificate.Expiration != nil {
+		d.Set("certificate_expiration", pagesDomain.Certificate.Expiration.Format(time.RFC3339))
+	} else {
+		d.Set("certificate_expiration", "")
+	}
+	return nil
+}
+
+func resourceGitlabPagesDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, domain, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &gitlab.UpdatePagesDomainOptions{}
+	if d.HasChange("auto_ssl_enabled") {
+		options.AutoSslEnabled = gitlab.Bool(d.Get("auto_ssl_enabled").(bool))
+	}
+	if d.HasChanges("certificate", "key") {
+		options.Certificate = gitlab.String(d.Get("certificate").(string))
+		options.Key = gitlab.String(d.Get("key").(string))
+	}
+
+	log.Printf("[DEBUG] update gitlab pages domain %s of project %s", domain, project)
+
+	if _, _, err := client.PagesDomains.UpdatePagesDomain(project, domain, options, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabPagesDomainRead(ctx, d, meta)
+}
+
+func resourceGitlabPagesDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, domain, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete gitlab pages domain %s of project %s", domain, project)
+
+	if _, err := client.PagesDomains.DeletePagesDomain(project, domain, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceGitlabPagesDomainVerify triggers the verification of a pages domain.
+// NOTE: the PagesDomainsService of go-gitlab lacks the verify endpoint.
+func resourceGitlabPagesDomainVerify(ctx context.Context, client *gitlab.Client, project, domain string) (*gitlab.PagesDomain, error) {
+	u := fmt.Sprintf("projects/%s/pages/domains/%s/verify", gitlab.PathEscape(project), domain)
+	req, err := client.NewRequest(http.MethodPut, u, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	pagesDomain := new(gitlab.PagesDomain)
+	if _, err := client.Do(req, pagesDomain); err != nil {
+		return nil, err
+	}
+	return pagesDomain, nil
+}
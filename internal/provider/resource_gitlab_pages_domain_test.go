@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabPagesDomain_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	domain := fmt.Sprintf("%s.example.com", acctest.RandomWithPrefix("acctest"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabPagesDomainDestroy,
+		Steps: []resource.TestStep{
+			// Create a pages domain
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_pages_domain" "this" {
+						project = %d
+						domain  = "%s"
+					}
+				`, testProject.ID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_pages_domain.this", "auto_ssl_enabled", "false"),
+					resource.TestCheckResourceAttr("gitlab_pages_domain.this", "verified", "false"),
+					resource.TestCheckResourceAttrSet("gitlab_pages_domain.this", "verification_code"),
+					resource.TestCheckResourceAttrSet("gitlab_pages_domain.this", "url"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_pages_domain.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Enable auto SSL
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_pages_domain" "this" {
+						project          = %d
+						domain           = "%s"
+						auto_ssl_enabled = true
+					}
+				`, testProject.ID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_pages_domain.this", "auto_ssl_enabled", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_pages_domain.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabPagesDomainDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_pages_domain" {
+			continue
+		}
+
+		project, domain, err := parseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = testGitlabClient.PagesDomains.GetPagesDomain(project, domain)
+		if err == nil {
+			return fmt.Errorf("pages domain %s of project %s still exists", domain, project)
+		}
+		if !is404(err) {
+			return err
+		}
+	}
+	return nil
+}
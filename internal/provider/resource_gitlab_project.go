@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
+	"os"
 	"strconv"
 	"time"
 
@@ -59,7 +62,7 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		Computed:    true,
 	},
 	"namespace_id": {
-		Description: "The namespace (group or user) of the project. Defaults to your user.",
+		Description: "The namespace (group or user) of the project. Defaults to your user. Changing it transfers the project to the new namespace, GitLab then redirects the previous path of the project until it's used by another project or group. A warning about the redirect is only shown when the transfer is applied, not during plan.",
 		Type:        schema.TypeInt,
 		Optional:    true,
 		Computed:    true,
@@ -75,12 +78,42 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Computed:    true,
 	},
+	"default_branch_rename": {
+		Description: "Set to `true` to rename the previous default branch when `default_branch` changes, instead of only changing the setting. The new branch is created from the previous default branch, the branch protection is moved to it, open merge requests targeting the previous default branch are retargeted, and the previous default branch is deleted.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	},
 	"import_url": {
-		Description: "Git URL to a repository to be imported.",
+		Description: "Git URL to a repository to be imported. The project creation waits until the import has finished.",
 		Type:        schema.TypeString,
 		Optional:    true,
 		ForceNew:    true,
 	},
+	"import_archive_path": {
+		Description:   "Path to a local project export archive to create the project from, e.g. to copy a project with its issues, merge requests and wiki. Requires `path` to be set. The project creation waits until the import has finished.",
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		RequiredWith:  []string{"path"},
+		ConflictsWith: []string{"import_url", "import_archive_url", "template_name", "template_project_id", "use_custom_template", "initialize_with_readme"},
+	},
+	"import_archive_url": {
+		Description:   "URL of a project export archive to create the project from, e.g. a pre-signed URL of an object storage. Requires `path` to be set and GitLab 13.12 or newer. The project creation waits until the import has finished.",
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		Sensitive:     true,
+		RequiredWith:  []string{"path"},
+		ConflictsWith: []string{"import_url", "import_archive_path", "template_name", "template_project_id", "use_custom_template", "initialize_with_readme"},
+		ValidateFunc:  validateURLFunc,
+	},
+	"import_url_strip_credentials": {
+		Description:   "Remove the credentials from the `import_url` stored in GitLab once the import has finished. Can't be used for pull mirrors, because they need the credentials to update the mirror. Defaults to `false`.",
+		Type:          schema.TypeBool,
+		Optional:      true,
+		RequiredWith:  []string{"import_url"},
+		ConflictsWith: []string{"mirror"},
+	},
 	"request_access_enabled": {
 		Description: "Allow users to request member access.",
 		Type:        schema.TypeBool,
@@ -320,14 +353,35 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		ForceNew:      true,
 	},
 	"use_custom_template": {
-		Description: "Use either custom instance or group (with group_with_project_templates_id) project template (enterprise edition).",
+		Description: "Use either custom instance or group (with group_with_project_templates_id) project template (enterprise edition). The project creation waits until the template has been imported.",
 		Type:        schema.TypeBool,
 		Optional:    true,
 	},
 	"group_with_project_templates_id": {
-		Description: "For group-level custom templates, specifies ID of group from which all the custom project templates are sourced. Leave empty for instance-level templates. Requires use_custom_template to be true (enterprise edition).",
-		Type:        schema.TypeInt,
+		Description:  "For group-level custom templates, specifies ID of group from which all the custom project templates are sourced. Leave empty for instance-level templates. Requires use_custom_template to be true (enterprise edition).",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		RequiredWith: []string{"use_custom_template"},
+	},
+	"forked_from_project_id": {
+		Description:   "The id of the project to fork. The project is created as a fork of this project and all other settings are applied to the fork afterwards. The project which has been forked is also read for existing forks, thus it doesn't need to be configured for imported forks.",
+		Type:          schema.TypeInt,
+		Optional:      true,
+		Computed:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"import_url", "import_archive_path", "import_archive_url", "template_name", "template_project_id", "use_custom_template", "initialize_with_readme"},
+	},
+	"remove_fork_relationship": {
+		Description:  "Remove the fork relationship to the `forked_from_project_id` project once the fork has been created, e.g. to provision an independent copy of a project. Setting it back to `false` creates the fork relationship again. Defaults to `false`.",
+		Type:         schema.TypeBool,
+		Optional:     true,
+		RequiredWith: []string{"forked_from_project_id"},
+	},
+	"mr_default_target_self": {
+		Description: "For forked projects, target merge requests to this project. If `false`, the target will be the upstream project.",
+		Type:        schema.TypeBool,
 		Optional:    true,
+		Computed:    true,
 	},
 	"pages_access_level": {
 		Description:  "Enable pages access control",
@@ -387,10 +441,16 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		Optional:    true,
 	},
 	"archive_on_destroy": {
-		Description: "Set to `true` to archive the project instead of deleting on destroy. If set to `true` it will entire omit the `DELETE` operation.",
+		Description: "Set to `true` to archive the project instead of deleting on destroy. If set to `true` it will entire omit the `DELETE` operation. The archived project keeps its path, thus a replacement of the project fails until the archived project is renamed or deleted.",
 		Type:        schema.TypeBool,
 		Optional:    true,
 	},
+	"permanently_remove_on_destroy": {
+		Description:   "Set to `true` to immediately remove the project on destroy, if the GitLab instance has delayed deletion enabled and only marks the project for deletion. Otherwise the project keeps its path until the deletion delay has passed, thus re-creating a project with the same path fails.",
+		Type:          schema.TypeBool,
+		Optional:      true,
+		ConflictsWith: []string{"archive_on_destroy"},
+	},
 	"ci_forward_deployment_enabled": {
 		Description: "When a new deployment job starts, skip older deployment jobs that are still pending.",
 		Type:        schema.TypeBool,
@@ -651,6 +711,8 @@ In the ` + "`gitlab_project`" + ` resource, define a ` + "`local-exec`" + ` prov
 the ` + "`/projects/:id/protected_branches/:name`" + ` API via curl to delete the branch protection on the default 
 branch using a ` + "`DELETE`" + ` request. Then define the desired branch protection using the ` + "`gitlab_branch_protection`" + ` resource.
 
+-> **Timeouts** Default timeout for *Create* and *Delete* is ten minutes and can be configured in the ` + "`timeouts`" + ` block. It limits how long the creation waits for an import, e.g. from an ` + "`import_url`" + ` or a template, to finish, respectively how long the deletion waits for the project to be deleted.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ce/api/projects.html)`,
 
 		CreateContext: resourceGitlabProjectCreate,
@@ -660,6 +722,10 @@ branch using a ` + "`DELETE`" + ` request. Then define the desired branch protec
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: resourceGitLabProjectSchema,
 		CustomizeDiff: customdiff.All(
 			customdiff.ComputedIf("path_with_namespace", namespaceOrPathChanged),
@@ -752,6 +818,12 @@ func resourceGitlabProjectSetToState(ctx context.Context, client *gitlab.Client,
 	d.Set("wiki_access_level", string(project.WikiAccessLevel))
 	d.Set("squash_commit_template", project.SquashCommitTemplate)
 	d.Set("merge_commit_template", project.MergeCommitTemplate)
+	d.Set("mr_default_target_self", project.MergeRequestDefaultTargetSelf)
+	// NOTE: the fork relationship may have been removed on purpose with `remove_fork_relationship`,
+	//       thus we keep the project which has been forked in the state in that case.
+	if project.ForkedFromProject != nil {
+		d.Set("forked_from_project_id", project.ForkedFromProject.ID)
+	}
 	return nil
 }
 
@@ -953,9 +1025,33 @@ func resourceGitlabProjectCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	log.Printf("[DEBUG] create gitlab project %q", *options.Name)
+	var (
+		project *gitlab.Project
+		err     error
+	)
+	forkedFromProjectID, isFork := d.GetOk("forked_from_project_id")
+	_, isArchivePathImport := d.GetOk("import_archive_path")
+	_, isArchiveURLImport := d.GetOk("import_archive_url")
+	switch {
+	case isFork:
+		log.Printf("[DEBUG] create gitlab project %q as fork of project %d", *options.Name, forkedFromProjectID.(int))
+
+		project, _, err = client.Projects.ForkProject(forkedFromProjectID.(int), &gitlab.ForkProjectOptions{
+			Name:        options.Name,
+			Path:        options.Path,
+			NamespaceID: options.NamespaceID,
+			Description: options.Description,
+			Visibility:  options.Visibility,
+		}, gitlab.WithContext(ctx))
+	case isArchivePathImport || isArchiveURLImport:
+		log.Printf("[DEBUG] create gitlab project %q from a project export archive", *options.Name)
 
-	project, _, err := client.Projects.CreateProject(options, gitlab.WithContext(ctx))
+		project, err = resourceGitlabProjectImportArchive(ctx, client, d, options)
+	default:
+		log.Printf("[DEBUG] create gitlab project %q", *options.Name)
+
+		project, _, err = client.Projects.CreateProject(options, gitlab.WithContext(ctx))
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -963,27 +1059,31 @@ func resourceGitlabProjectCreate(ctx context.Context, d *schema.ResourceData, me
 	// from this point onwards no matter how we return, resource creation
 	// is committed to state since we set its ID
 	d.SetId(fmt.Sprintf("%d", project.ID))
+	invalidateGitlabLookupCache(client)
 
-	// An import can be triggered by import_url or by creating the project from a template.
+	// An import can be triggered by import_url, by creating the project from a template or an export archive or by forking a project.
 	if project.ImportStatus != "none" {
 		log.Printf("[DEBUG] waiting for project %q import to finish", *options.Name)
 
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"scheduled", "started"},
-			Target:  []string{"finished"},
-			Timeout: 10 * time.Minute,
-			Refresh: func() (interface{}, string, error) {
-				status, _, err := client.ProjectImportExport.ImportStatus(d.Id(), gitlab.WithContext(ctx))
-				if err != nil {
-					return nil, "", err
-				}
-
-				return status, status.ImportStatus, nil
-			},
+		if err := resourceGitlabProjectWaitForImport(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("error while waiting for project %q import to finish: %s", *options.Name, err)
 		}
 
-		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-			return diag.Errorf("error while waiting for project %q import to finish: %s", *options.Name, err)
+		if d.Get("import_url_strip_credentials").(bool) {
+			importURL, err := url.Parse(d.Get("import_url").(string))
+			if err != nil {
+				return diag.Errorf("failed to parse import_url of project %q: %s", d.Id(), err)
+			}
+			if importURL.User != nil {
+				importURL.User = nil
+
+				log.Printf("[DEBUG] remove credentials from import url of project %q", d.Id())
+				if _, _, err := client.Projects.EditProject(d.Id(), &gitlab.EditProjectOptions{
+					ImportURL: gitlab.String(importURL.String()),
+				}, gitlab.WithContext(ctx)); err != nil {
+					return diag.Errorf("failed to remove credentials from import url of project %q: %s", d.Id(), err)
+				}
+			}
 		}
 
 		// Read the project again, so that we can detect the default branch.
@@ -993,6 +1093,20 @@ func resourceGitlabProjectCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if isFork {
+		// The fork API only supports a few of the project settings, thus the remaining ones are applied to the fork afterwards.
+		if err := resourceGitlabProjectEditFork(ctx, client, d, project.ID); err != nil {
+			return diag.Errorf("Could not update forked project %q: %s", d.Id(), err)
+		}
+
+		if d.Get("remove_fork_relationship").(bool) {
+			log.Printf("[DEBUG] remove fork relationship of project %q", d.Id())
+			if _, err := client.Projects.DeleteProjectForkRelation(project.ID, gitlab.WithContext(ctx)); err != nil {
+				return diag.Errorf("Failed to remove fork relationship of project %q: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.Get("archived").(bool) {
 		// strange as it may seem, this project is created in archived state...
 		if _, _, err := client.Projects.ArchiveProject(d.Id(), gitlab.WithContext(ctx)); err != nil {
@@ -1132,6 +1246,12 @@ func resourceGitlabProjectCreate(ctx context.Context, d *schema.ResourceData, me
 		editProjectOptions.MergeTrainsEnabled = gitlab.Bool(v.(bool))
 	}
 
+	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
+	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
+	if v, ok := d.GetOkExists("mr_default_target_self"); ok {
+		editProjectOptions.MergeRequestDefaultTargetSelf = gitlab.Bool(v.(bool))
+	}
+
 	if (editProjectOptions != gitlab.EditProjectOptions{}) {
 		if _, _, err := client.Projects.EditProject(d.Id(), &editProjectOptions, gitlab.WithContext(ctx)); err != nil {
 			return diag.Errorf("Could not update project %q: %s", d.Id(), err)
@@ -1201,8 +1321,14 @@ func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		options.Description = gitlab.String(d.Get("description").(string))
 	}
 
+	var previousDefaultBranch string
 	if d.HasChange("default_branch") {
 		options.DefaultBranch = gitlab.String(d.Get("default_branch").(string))
+
+		if d.Get("default_branch_rename").(bool) {
+			oldDefaultBranch, _ := d.GetChange("default_branch")
+			previousDefaultBranch = oldDefaultBranch.(string)
+		}
 	}
 
 	if d.HasChange("visibility_level") {
@@ -1447,20 +1573,44 @@ func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		options.MergeCommitTemplate = gitlab.String(d.Get("merge_commit_template").(string))
 	}
 
+	if d.HasChange("mr_default_target_self") {
+		options.MergeRequestDefaultTargetSelf = gitlab.Bool(d.Get("mr_default_target_self").(bool))
+	}
+
+	if previousDefaultBranch != "" {
+		if err := resourceGitlabProjectMoveDefaultBranch(ctx, client, d.Id(), previousDefaultBranch, *options.DefaultBranch); err != nil {
+			return diag.Errorf("failed to rename default branch %q to %q of project %q: %s", previousDefaultBranch, *options.DefaultBranch, d.Id(), err)
+		}
+	}
+
 	if *options != (gitlab.EditProjectOptions{}) {
 		log.Printf("[DEBUG] update gitlab project %s", d.Id())
 		_, _, err := client.Projects.EditProject(d.Id(), options, gitlab.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		if options.Path != nil {
+			invalidateGitlabLookupCache(client)
+		}
 	}
 
+	if previousDefaultBranch != "" {
+		if err := resourceGitlabProjectRemovePreviousDefaultBranch(ctx, client, d.Id(), previousDefaultBranch); err != nil {
+			return diag.Errorf("failed to delete previous default branch %q of project %q: %s", previousDefaultBranch, d.Id(), err)
+		}
+	}
+
+	var diags diag.Diagnostics
 	if *transferOptions != (gitlab.TransferProjectOptions{}) {
 		log.Printf("[DEBUG] transferring project %s to namespace %d", d.Id(), transferOptions.Namespace)
 		_, _, err := client.Projects.TransferProject(d.Id(), transferOptions, gitlab.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		invalidateGitlabLookupCache(client)
+
+		oldPathWithNamespace, _ := d.GetChange("path_with_namespace")
+		diags = append(diags, transferRedirectWarning("project", oldPathWithNamespace.(string)))
 	}
 
 	if d.HasChange("archived") {
@@ -1486,61 +1636,225 @@ func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	return resourceGitlabProjectRead(ctx, d, meta)
+	if d.HasChange("remove_fork_relationship") {
+		if d.Get("remove_fork_relationship").(bool) {
+			log.Printf("[DEBUG] remove fork relationship of project %q", d.Id())
+			if _, err := client.Projects.DeleteProjectForkRelation(d.Id(), gitlab.WithContext(ctx)); err != nil {
+				return diag.Errorf("Failed to remove fork relationship of project %q: %s", d.Id(), err)
+			}
+		} else {
+			forkedFromProjectID := d.Get("forked_from_project_id").(int)
+			log.Printf("[DEBUG] create fork relationship of project %q to project %d", d.Id(), forkedFromProjectID)
+			if _, _, err := client.Projects.CreateProjectForkRelation(d.Id(), forkedFromProjectID, gitlab.WithContext(ctx)); err != nil {
+				return diag.Errorf("Failed to create fork relationship of project %q to project %d: %s", d.Id(), forkedFromProjectID, err)
+			}
+		}
+	}
+
+	return append(diags, resourceGitlabProjectRead(ctx, d, meta)...)
 }
 
 func resourceGitlabProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*gitlab.Client)
 
-	if !d.Get("archive_on_destroy").(bool) {
-		log.Printf("[DEBUG] Delete gitlab project %s", d.Id())
-		_, err := client.Projects.DeleteProject(d.Id(), gitlab.WithContext(ctx))
+	if d.Get("archive_on_destroy").(bool) {
+		log.Printf("[DEBUG] Archive gitlab project %s", d.Id())
+		project, _, err := client.Projects.ArchiveProject(d.Id(), gitlab.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		// Wait for the project to be deleted.
-		// Deleting a project in gitlab is async.
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"Deleting"},
-			Target:  []string{"Deleted"},
-			Refresh: func() (interface{}, string, error) {
-				out, _, err := client.Projects.GetProject(d.Id(), nil, gitlab.WithContext(ctx))
-				if err != nil {
-					if is404(err) {
-						return out, "Deleted", nil
-					}
-					log.Printf("[ERROR] Received error: %#v", err)
-					return out, "Error", err
-				}
-				if out.MarkedForDeletionAt != nil {
-					// Represents a Gitlab EE soft-delete
-					return out, "Deleted", nil
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "The project has been archived instead of deleted",
+			Detail:   fmt.Sprintf("The project %q has been archived, because `archive_on_destroy` is set. It keeps its path until it's renamed or deleted in GitLab.", project.PathWithNamespace),
+		}}
+	}
+
+	log.Printf("[DEBUG] Delete gitlab project %s", d.Id())
+	_, err := client.Projects.DeleteProject(d.Id(), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	invalidateGitlabLookupCache(client)
+
+	project, err := resourceGitlabProjectWaitForDeletion(ctx, client, d.Id(), false, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error waiting for project (%s) to become deleted: %s", d.Id(), err)
+	}
+
+	// NOTE: on instances with delayed deletion the project is only marked for deletion,
+	//       which requires a second request with the (possibly renamed) full path to remove it immediately.
+	if project != nil && d.Get("permanently_remove_on_destroy").(bool) {
+		log.Printf("[DEBUG] Permanently remove gitlab project %s marked for deletion", d.Id())
+		options := &gitlab.DeleteProjectOptions{
+			FullPath:          gitlab.String(project.PathWithNamespace),
+			PermanentlyRemove: gitlab.Bool(true),
+		}
+		if _, err := client.Projects.DeleteProject(d.Id(), options, gitlab.WithContext(ctx)); err != nil {
+			return diag.Errorf("error permanently removing project (%s): %s", d.Id(), err)
+		}
+
+		if _, err := resourceGitlabProjectWaitForDeletion(ctx, client, d.Id(), true, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.Errorf("error waiting for project (%s) to become permanently removed: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// resourceGitlabProjectWaitForDeletion waits for the project to be deleted.
+// Deleting a project in gitlab is async.
+// Unless untilGone is set, a project marked for deletion counts as deleted and is returned.
+func resourceGitlabProjectWaitForDeletion(ctx context.Context, client *gitlab.Client, projectID string, untilGone bool, timeout time.Duration) (*gitlab.Project, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Target:  []string{"Deleted"},
+		Refresh: func() (interface{}, string, error) {
+			out, _, err := client.Projects.GetProject(projectID, nil, gitlab.WithContext(ctx))
+			if err != nil {
+				if is404(err) {
+					return (*gitlab.Project)(nil), "Deleted", nil
 				}
-				return out, "Deleting", nil
-			},
+				log.Printf("[ERROR] Received error: %#v", err)
+				return out, "Error", err
+			}
+			if out.MarkedForDeletionAt != nil && !untilGone {
+				// Represents a Gitlab EE soft-delete
+				return out, "Deleted", nil
+			}
+			return out, "Deleting", nil
+		},
+
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	out, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return out.(*gitlab.Project), nil
+}
 
-			Timeout:    10 * time.Minute,
-			MinTimeout: 3 * time.Second,
-			Delay:      5 * time.Second,
+// resourceGitlabProjectMoveDefaultBranch prepares the rename of the default branch
+// before the default branch setting of the project is changed.
+// It creates the new branch from the previous one, copies its branch protection
+// and retargets the open merge requests to the new branch.
+func resourceGitlabProjectMoveDefaultBranch(ctx context.Context, client *gitlab.Client, projectID string, previousBranch string, newBranch string) error {
+	if _, _, err := client.Branches.GetBranch(projectID, newBranch, gitlab.WithContext(ctx)); err != nil {
+		if !is404(err) {
+			return err
 		}
 
-		_, err = stateConf.WaitForStateContext(ctx)
-		if err != nil {
-			return diag.Errorf("error waiting for project (%s) to become deleted: %s", d.Id(), err)
+		log.Printf("[DEBUG] create branch %q from %q in project %q", newBranch, previousBranch, projectID)
+		if _, _, err := client.Branches.CreateBranch(projectID, &gitlab.CreateBranchOptions{
+			Branch: gitlab.String(newBranch),
+			Ref:    gitlab.String(previousBranch),
+		}, gitlab.WithContext(ctx)); err != nil {
+			return err
 		}
+	}
 
-	} else {
-		log.Printf("[DEBUG] Archive gitlab project %s", d.Id())
-		_, _, err := client.Projects.ArchiveProject(d.Id(), gitlab.WithContext(ctx))
+	protectedBranch, _, err := client.ProtectedBranches.GetProtectedBranch(projectID, previousBranch, gitlab.WithContext(ctx))
+	if err != nil && !is404(err) {
+		return err
+	}
+	if protectedBranch != nil {
+		if _, _, err := client.ProtectedBranches.GetProtectedBranch(projectID, newBranch, gitlab.WithContext(ctx)); err != nil {
+			if !is404(err) {
+				return err
+			}
+
+			log.Printf("[DEBUG] copy branch protection of %q to %q in project %q", previousBranch, newBranch, projectID)
+			options := &gitlab.ProtectRepositoryBranchesOptions{
+				Name:                      gitlab.String(newBranch),
+				AllowForcePush:            gitlab.Bool(protectedBranch.AllowForcePush),
+				CodeOwnerApprovalRequired: gitlab.Bool(protectedBranch.CodeOwnerApprovalRequired),
+			}
+			if pushAccessLevel, err := firstValidAccessLevel(protectedBranch.PushAccessLevels); err == nil {
+				options.PushAccessLevel = pushAccessLevel
+			}
+			if mergeAccessLevel, err := firstValidAccessLevel(protectedBranch.MergeAccessLevels); err == nil {
+				options.MergeAccessLevel = mergeAccessLevel
+			}
+			if unprotectAccessLevel, err := firstValidAccessLevel(protectedBranch.UnprotectAccessLevels); err == nil {
+				options.UnprotectAccessLevel = unprotectAccessLevel
+			}
+			if allowedToPush := nonZeroBranchPermissionOptions(protectedBranch.PushAccessLevels); len(allowedToPush) > 0 {
+				options.AllowedToPush = &allowedToPush
+			}
+			if allowedToMerge := nonZeroBranchPermissionOptions(protectedBranch.MergeAccessLevels); len(allowedToMerge) > 0 {
+				options.AllowedToMerge = &allowedToMerge
+			}
+			if allowedToUnprotect := nonZeroBranchPermissionOptions(protectedBranch.UnprotectAccessLevels); len(allowedToUnprotect) > 0 {
+				options.AllowedToUnprotect = &allowedToUnprotect
+			}
+
+			if _, _, err := client.ProtectedBranches.ProtectRepositoryBranches(projectID, options, gitlab.WithContext(ctx)); err != nil {
+				return err
+			}
+		}
+	}
+
+	listOptions := &gitlab.ListProjectMergeRequestsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		State:        gitlab.String("opened"),
+		TargetBranch: gitlab.String(previousBranch),
+	}
+	var mergeRequests []*gitlab.MergeRequest
+	for listOptions.Page != 0 {
+		page, resp, err := client.MergeRequests.ListProjectMergeRequests(projectID, listOptions, gitlab.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return err
+		}
+		mergeRequests = append(mergeRequests, page...)
+		listOptions.Page = resp.NextPage
+	}
+
+	for _, mergeRequest := range mergeRequests {
+		log.Printf("[DEBUG] retarget merge request !%d of project %q to %q", mergeRequest.IID, projectID, newBranch)
+		if _, _, err := client.MergeRequests.UpdateMergeRequest(projectID, mergeRequest.IID, &gitlab.UpdateMergeRequestOptions{
+			TargetBranch: gitlab.String(newBranch),
+		}, gitlab.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to retarget merge request !%d: %w", mergeRequest.IID, err)
 		}
 	}
 
 	return nil
 }
 
+// resourceGitlabProjectRemovePreviousDefaultBranch finishes the rename of the default branch
+// after the default branch setting of the project has been changed.
+func resourceGitlabProjectRemovePreviousDefaultBranch(ctx context.Context, client *gitlab.Client, projectID string, previousBranch string) error {
+	log.Printf("[DEBUG] delete previous default branch %q of project %q", previousBranch, projectID)
+	if _, err := client.ProtectedBranches.UnprotectRepositoryBranches(projectID, previousBranch, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+		return err
+	}
+	if _, err := client.Branches.DeleteBranch(projectID, previousBranch, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+		return err
+	}
+	return nil
+}
+
+// nonZeroBranchPermissionOptions converts the user and group specific branch access descriptions
+// into branch permission options.
+func nonZeroBranchPermissionOptions(descriptions []*gitlab.BranchAccessDescription) []*gitlab.BranchPermissionOptions {
+	var result []*gitlab.BranchPermissionOptions
+	for _, description := range descriptions {
+		if description.UserID != 0 {
+			result = append(result, &gitlab.BranchPermissionOptions{UserID: gitlab.Int(description.UserID)})
+		} else if description.GroupID != 0 {
+			result = append(result, &gitlab.BranchPermissionOptions{GroupID: gitlab.Int(description.GroupID)})
+		}
+	}
+	return result
+}
+
 func editOrAddPushRules(ctx context.Context, client *gitlab.Client, projectID string, d *schema.ResourceData) error {
 	log.Printf("[DEBUG] Editing push rules for project %q", projectID)
 
@@ -1765,3 +2079,245 @@ func expectDefaultBranchProtection(ctx context.Context, client *gitlab.Client, p
 	// thus, we always expect a default branch protection.
 	return true, nil
 }
+
+// resourceGitlabProjectEditFork applies the project settings which aren't supported by the fork API to a forked project.
+func resourceGitlabProjectEditFork(ctx context.Context, client *gitlab.Client, d *schema.ResourceData, projectID int) error {
+	// NOTE: the name, path, namespace, description and visibility are already set by the fork API
+	//       and the default branch may not exist in the fork, it's taken care of after the project creation.
+	options := &gitlab.EditProjectOptions{
+		RequestAccessEnabled:             gitlab.Bool(d.Get("request_access_enabled").(bool)),
+		IssuesEnabled:                    gitlab.Bool(d.Get("issues_enabled").(bool)),
+		MergeRequestsEnabled:             gitlab.Bool(d.Get("merge_requests_enabled").(bool)),
+		JobsEnabled:                      gitlab.Bool(d.Get("pipelines_enabled").(bool)),
+		ApprovalsBeforeMerge:             gitlab.Int(d.Get("approvals_before_merge").(int)),
+		WikiEnabled:                      gitlab.Bool(d.Get("wiki_enabled").(bool)),
+		SnippetsEnabled:                  gitlab.Bool(d.Get("snippets_enabled").(bool)),
+		ContainerRegistryEnabled:         gitlab.Bool(d.Get("container_registry_enabled").(bool)),
+		LFSEnabled:                       gitlab.Bool(d.Get("lfs_enabled").(bool)),
+		MergeMethod:                      stringToMergeMethod(d.Get("merge_method").(string)),
+		OnlyAllowMergeIfPipelineSucceeds: gitlab.Bool(d.Get("only_allow_merge_if_pipeline_succeeds").(bool)),
+		OnlyAllowMergeIfAllDiscussionsAreResolved: gitlab.Bool(d.Get("only_allow_merge_if_all_discussions_are_resolved").(bool)),
+		AllowMergeOnSkippedPipeline:               gitlab.Bool(d.Get("allow_merge_on_skipped_pipeline").(bool)),
+		SharedRunnersEnabled:                      gitlab.Bool(d.Get("shared_runners_enabled").(bool)),
+		RemoveSourceBranchAfterMerge:              gitlab.Bool(d.Get("remove_source_branch_after_merge").(bool)),
+		PackagesEnabled:                           gitlab.Bool(d.Get("packages_enabled").(bool)),
+		PrintingMergeRequestLinkEnabled:           gitlab.Bool(d.Get("printing_merge_request_link_enabled").(bool)),
+		CIForwardDeploymentEnabled:                gitlab.Bool(d.Get("ci_forward_deployment_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		options.TagList = stringSetToStringSlice(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("build_coverage_regex"); ok {
+		options.BuildCoverageRegex = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ci_config_path"); ok {
+		options.CIConfigPath = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pages_access_level"); ok {
+		options.PagesAccessLevel = stringToAccessControlValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("resolve_outdated_diff_discussions"); ok {
+		options.ResolveOutdatedDiffDiscussions = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("analytics_access_level"); ok {
+		options.AnalyticsAccessLevel = stringToAccessControlValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("auto_cancel_pending_pipelines"); ok {
+		options.AutoCancelPendingPipelines = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("auto_devops_deploy_strategy"); ok {
+		options.AutoDevopsDeployStrategy = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("auto_devops_enabled"); ok {
+		options.AutoDevopsEnabled = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("autoclose_referenced_issues"); ok {
+		options.AutocloseReferencedIssues = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("build_git_strategy"); ok {
+		options.BuildGitStrategy = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("build_timeout"); ok {
+		options.BuildTimeout = gitlab.Int(v.(int))
+	}
+
+	if v, ok := d.GetOk("builds_access_level"); ok {
+		options.BuildsAccessLevel = stringToAccessControlValue(v.(string))
+	}
+
+	if _, ok := d.GetOk("container_expiration_policy"); ok {
+		options.ContainerExpirationPolicyAttributes = expandContainerExpirationPolicyAttributes(d)
+	}
+
+	if v, ok := d.GetOk("container_registry_access_level"); ok {
+		options.ContainerRegistryAccessLevel = stringToAccessControlValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("emails_disabled"); ok {
+		options.EmailsDisabled = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("external_authorization_classification_label"); ok {
+		options.ExternalAuthorizationClassificationLabel = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("forking_access_level"); ok {
+		options.ForkingAccessLevel = stringToAccessControlValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("issues_access_level"); ok {
+		options.IssuesAccessLevel = stringToAccessControlValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("merge_requests_access_level"); ok {
+		options.MergeRequestsAccessLevel = stringToAccessControlValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("operations_access_level"); ok {
+		options.OperationsAccessLevel = stringToAccessControlValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("public_builds"); ok {
+		options.PublicBuilds = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("repository_access_level"); ok {
+		options.RepositoryAccessLevel = stringToAccessControlValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("repository_storage"); ok {
+		options.RepositoryStorage = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("requirements_access_level"); ok {
+		options.RequirementsAccessLevel = stringToAccessControlValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("security_and_compliance_access_level"); ok {
+		options.SecurityAndComplianceAccessLevel = stringToAccessControlValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("snippets_access_level"); ok {
+		options.SnippetsAccessLevel = stringToAccessControlValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("topics"); ok {
+		options.Topics = stringSetToStringSlice(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("wiki_access_level"); ok {
+		options.WikiAccessLevel = stringToAccessControlValue(v.(string))
+	}
+
+	if v, ok := d.GetOk("squash_commit_template"); ok {
+		options.SquashCommitTemplate = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("merge_commit_template"); ok {
+		options.MergeCommitTemplate = gitlab.String(v.(string))
+	}
+
+	if supportsSquashOption, err := isGitLabVersionAtLeast(ctx, client, "14.1")(); err != nil {
+		return err
+	} else if supportsSquashOption {
+		if v, ok := d.GetOk("squash_option"); ok {
+			options.SquashOption = stringToSquashOptionValue(v.(string))
+		}
+	}
+
+	log.Printf("[DEBUG] update gitlab project %d after forking", projectID)
+	_, _, err := client.Projects.EditProject(projectID, options, gitlab.WithContext(ctx))
+	return err
+}
+
+// resourceGitlabProjectWaitForImport waits until the import of the project has finished,
+// e.g. after the project has been created from a template or an import URL.
+func resourceGitlabProjectWaitForImport(ctx context.Context, client *gitlab.Client, projectID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"scheduled", "started"},
+		Target:  []string{"finished"},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			status, _, err := client.ProjectImportExport.ImportStatus(projectID, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, "", err
+			}
+
+			if status.ImportStatus == "failed" {
+				return nil, "", fmt.Errorf("import failed: %s", status.ImportError)
+			}
+
+			return status, status.ImportStatus, nil
+		},
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// gitlabProjectRemoteImportOptions represents the options to import a project from a remote export archive.
+type gitlabProjectRemoteImportOptions struct {
+	URL            *string                      `json:"url"`
+	Namespace      *string                      `json:"namespace,omitempty"`
+	Name           *string                      `json:"name,omitempty"`
+	Path           *string                      `json:"path,omitempty"`
+	OverrideParams *gitlab.CreateProjectOptions `json:"override_params,omitempty"`
+}
+
+// resourceGitlabProjectImportArchive creates a project from a project export archive, given either as local file or URL.
+// The project settings are passed as override parameters, so that they are applied on top of the settings of the export.
+func resourceGitlabProjectImportArchive(ctx context.Context, client *gitlab.Client, d *schema.ResourceData, options *gitlab.CreateProjectOptions) (*gitlab.Project, error) {
+	var namespace *string
+	if options.NamespaceID != nil {
+		namespace = gitlab.String(strconv.Itoa(*options.NamespaceID))
+	}
+
+	status := new(gitlab.ImportStatus)
+	if v, ok := d.GetOk("import_archive_path"); ok {
+		archive, err := os.Open(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("failed to open project export archive: %w", err)
+		}
+		defer archive.Close()
+
+		status, _, err = client.ProjectImportExport.ImportFromFile(archive, &gitlab.ImportFileOptions{
+			Namespace:      namespace,
+			Name:           options.Name,
+			Path:           options.Path,
+			OverrideParams: options,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// NOTE: the ImportService of go-gitlab only imports from GitHub and Bitbucket, not from a remote archive.
+		req, err := client.NewRequest(http.MethodPost, "projects/remote-import", &gitlabProjectRemoteImportOptions{
+			URL:            gitlab.String(d.Get("import_archive_url").(string)),
+			Namespace:      namespace,
+			Name:           options.Name,
+			Path:           options.Path,
+			OverrideParams: options,
+		}, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := client.Do(req, status); err != nil {
+			return nil, err
+		}
+	}
+
+	project, _, err := client.Projects.GetProject(status.ID, nil, gitlab.WithContext(ctx))
+	return project, err
+}
@@ -231,7 +231,7 @@ func testAccCheckGitlabProjectApprovalRuleExists(n string, projectApprovalRule *
 			return err
 		}
 
-		rules, _, err := testGitlabClient.Projects.GetProjectApprovalRules(projectID)
+		rules, _, err := testGitlabClient.Projects.GetProjectApprovalRules(projectID, nil)
 		if err != nil {
 			return err
 		}
@@ -250,7 +250,7 @@ func testAccCheckGitlabProjectApprovalRuleExists(n string, projectApprovalRule *
 func testAccCheckGitlabProjectApprovalRuleDestroy(pid interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		return InterceptGomegaFailure(func() {
-			rules, _, err := testGitlabClient.Projects.GetProjectApprovalRules(pid)
+			rules, _, err := testGitlabClient.Projects.GetProjectApprovalRules(pid, nil)
 			Expect(err).To(BeNil())
 			Expect(rules).To(BeEmpty())
 		})
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_project_container_expiration_policy", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_container_expiration_policy`" + ` resource allows to manage the container registry cleanup policy of a project.
+
+~> Do not use this resource together with the ` + "`container_expiration_policy`" + ` attribute of the ` + "`gitlab_project`" + ` resource for the same project, otherwise they will fight over the policy.
+
+-> Destroying this resource disables the cleanup policy of the project.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/projects.html#edit-project)`,
+
+		CreateContext: resourceGitlabProjectContainerExpirationPolicyCreate,
+		ReadContext:   resourceGitlabProjectContainerExpirationPolicyRead,
+		UpdateContext: resourceGitlabProjectContainerExpirationPolicyUpdate,
+		DeleteContext: resourceGitlabProjectContainerExpirationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Description: "Whether the cleanup policy is enabled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"cadence": {
+				Description:  fmt.Sprintf("How often the cleanup policy runs. Valid values are: %s.", renderValueListForDocs(validContainerExpirationPolicyAttributesCadenceValues)),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(validContainerExpirationPolicyAttributesCadenceValues, false),
+			},
+			"keep_n": {
+				Description:  "The number of tags to keep per image name, e.g. `10`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"older_than": {
+				Description: "Remove tags older than this, e.g. `7d`, `14d`, `30d` or `90d`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"name_regex_delete": {
+				Description: "The regular expression to match tag names to remove, e.g. `.*`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"name_regex_keep": {
+				Description: "The regular expression to match tag names to keep. Takes precedence over `name_regex_delete`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"next_run_at": {
+				Description: "The next time the policy will run.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabProjectContainerExpirationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	project := d.Get("project").(string)
+	d.SetId(project)
+
+	log.Printf("[DEBUG] create container expiration policy for gitlab project %s", project)
+
+	if err := resourceGitlabProjectContainerExpirationPolicyEdit(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabProjectContainerExpirationPolicyRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectContainerExpirationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read container expiration policy of gitlab project %s", project)
+
+	p, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab project %s not found, removing container expiration policy from state", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if p.ContainerExpirationPolicy == nil {
+		log.Printf("[DEBUG] gitlab project %s has no container expiration policy, removing from state", project)
+		d.SetId("")
+		return nil
+	}
+
+	policy := p.ContainerExpirationPolicy
+	d.Set("project", project)
+	d.Set("enabled", policy.Enabled)
+	d.Set("cadence", policy.Cadence)
+	d.Set("keep_n", policy.KeepN)
+	d.Set("older_than", policy.OlderThan)
+	d.Set("name_regex_delete", policy.NameRegexDelete)
+	d.Set("name_regex_keep", policy.NameRegexKeep)
+	if policy.NextRunAt != nil {
+		d.Set("next_run_at", policy.NextRunAt.Format(time.RFC3339))
+	} else {
+		d.Set("next_run_at", "")
+	}
+	return nil
+}
+
+func resourceGitlabProjectContainerExpirationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] update container expiration policy of gitlab project %s", d.Id())
+
+	if err := resourceGitlabProjectContainerExpirationPolicyEdit(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabProjectContainerExpirationPolicyRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectContainerExpirationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] disable container expiration policy of gitlab project %s", project)
+
+	options := &gitlab.EditProjectOptions{
+		ContainerExpirationPolicyAttributes: &gitlab.ContainerExpirationPolicyAttributes{
+			Enabled: gitlab.Bool(false),
+		},
+	}
+	if _, _, err := client.Projects.EditProject(project, options, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabProjectContainerExpirationPolicyEdit(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*gitlab.Client)
+
+	policy := &gitlab.ContainerExpirationPolicyAttributes{
+		Enabled: gitlab.Bool(d.Get("enabled").(bool)),
+	}
+	if v, ok := d.GetOk("cadence"); ok {
+		policy.Cadence = gitlab.String(v.(string))
+	}
+	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
+	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
+	if v, ok := d.GetOkExists("keep_n"); ok {
+		policy.KeepN = gitlab.Int(v.(int))
+	}
+	if v, ok := d.GetOk("older_than"); ok {
+		policy.OlderThan = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("name_regex_delete"); ok {
+		policy.NameRegexDelete = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("name_regex_keep"); ok {
+		policy.NameRegexKeep = gitlab.String(v.(string))
+	}
+
+	options := &gitlab.EditProjectOptions{
+		ContainerExpirationPolicyAttributes: policy,
+	}
+	_, _, err := client.Projects.EditProject(d.Id(), options, gitlab.WithContext(ctx))
+	return err
+}
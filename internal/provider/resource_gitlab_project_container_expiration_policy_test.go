@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabProjectContainerExpirationPolicy_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectContainerExpirationPolicyDestroy,
+		Steps: []resource.TestStep{
+			// Create a cleanup policy
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_container_expiration_policy" "this" {
+						project           = %d
+						cadence           = "1d"
+						keep_n            = 10
+						older_than        = "14d"
+						name_regex_delete = ".*"
+						name_regex_keep   = "^v.*"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_container_expiration_policy.this", "enabled", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_container_expiration_policy.this", "cadence", "1d"),
+					resource.TestCheckResourceAttr("gitlab_project_container_expiration_policy.this", "keep_n", "10"),
+					resource.TestCheckResourceAttrSet("gitlab_project_container_expiration_policy.this", "next_run_at"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_container_expiration_policy.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the cleanup policy
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_container_expiration_policy" "this" {
+						project           = %d
+						cadence           = "7d"
+						keep_n            = 5
+						older_than        = "30d"
+						name_regex_delete = ".*"
+						name_regex_keep   = "^release-.*"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_container_expiration_policy.this", "cadence", "7d"),
+					resource.TestCheckResourceAttr("gitlab_project_container_expiration_policy.this", "keep_n", "5"),
+					resource.TestCheckResourceAttr("gitlab_project_container_expiration_policy.this", "older_than", "30d"),
+					resource.TestCheckResourceAttr("gitlab_project_container_expiration_policy.this", "name_regex_keep", "^release-.*"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_container_expiration_policy.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectContainerExpirationPolicyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_container_expiration_policy" {
+			continue
+		}
+
+		project, _, err := testGitlabClient.Projects.GetProject(rs.Primary.ID, nil)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if project.ContainerExpirationPolicy != nil && project.ContainerExpirationPolicy.Enabled {
+			return fmt.Errorf("container expiration policy of project %s is still enabled", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var validProtectionRuleMinimumAccessLevels = []string{"maintainer", "owner", "admin"}
+
+var _ = registerResource("gitlab_project_container_registry_protection_rule", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_container_registry_protection_rule`" + ` resource allows to manage the lifecycle of a protection rule for container repositories of a project.
+
+-> This resource requires GitLab 17.6 or newer.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/container_repository_protection_rules.html)`,
+
+		CreateContext: resourceGitlabProjectContainerRegistryProtectionRuleCreate,
+		ReadContext:   resourceGitlabProjectContainerRegistryProtectionRuleRead,
+		UpdateContext: resourceGitlabProjectContainerRegistryProtectionRuleUpdate,
+		DeleteContext: resourceGitlabProjectContainerRegistryProtectionRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"repository_path_pattern": {
+				Description: "The container repository path pattern protected by the rule, e.g. `my-group/my-project/production*`. Wildcards (`*`) are allowed.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"minimum_access_level_for_push": {
+				Description:  fmt.Sprintf("The minimum access level required to push container images to the protected repositories. Valid values are: %s.", renderValueListForDocs(validProtectionRuleMinimumAccessLevels)),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(validProtectionRuleMinimumAccessLevels, false),
+				AtLeastOneOf: []string{"minimum_access_level_for_push", "minimum_access_level_for_delete"},
+			},
+			"minimum_access_level_for_delete": {
+				Description:  fmt.Sprintf("The minimum access level required to delete container images in the protected repositories. Valid values are: %s.", renderValueListForDocs(validProtectionRuleMinimumAccessLevels)),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(validProtectionRuleMinimumAccessLevels, false),
+				AtLeastOneOf: []string{"minimum_access_level_for_push", "minimum_access_level_for_delete"},
+			},
+			"rule_id": {
+				Description: "The ID of the protection rule.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+// gitlabContainerRegistryProtectionRule represents a container registry protection rule.
+// NOTE: go-gitlab doesn't yet implement the container registry protection rules API.
+type gitlabContainerRegistryProtectionRule struct {
+	ID                          int     `json:"id"`
+	ProjectID                   int     `json:"project_id"`
+	RepositoryPathPattern       string  `json:"repository_path_pattern"`
+	MinimumAccessLevelForPush   *string `json:"minimum_access_level_for_push"`
+	MinimumAccessLevelForDelete *string `json:"minimum_access_level_for_delete"`
+}
+
+func resourceGitlabProjectContainerRegistryProtectionRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := map[string]interface{}{
+		"repository_path_pattern": d.Get("repository_path_pattern").(string),
+	}
+	if v, ok := d.GetOk("minimum_access_level_for_push"); ok {
+		options["minimum_access_level_for_push"] = v.(string)
+	}
+	if v, ok := d.GetOk("minimum_access_level_for_delete"); ok {
+		options["minimum_access_level_for_delete"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] create container registry protection rule for gitlab project %s", project)
+
+	u := fmt.Sprintf("projects/%s/registry/protection/repository/rules", gitlab.PathEscape(project))
+	rule, err := resourceGitlabProjectContainerRegistryProtectionRuleDo(ctx, client, http.MethodPost, u, options)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ruleID := strconv.Itoa(rule.ID)
+	d.SetId(buildTwoPartID(&project, &ruleID))
+	return resourceGitlabProjectContainerRegistryProtectionRuleRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectContainerRegistryProtectionRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, ruleID, err := resourceGitlabProjectContainerRegistryProtectionRuleParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read container registry protection rule %d of gitlab project %s", ruleID, project)
+
+	rule, err := resourceGitlabProjectContainerRegistryProtectionRuleGet(ctx, client, project, ruleID)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab project %s not found, removing container registry protection rule from state", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if rule == nil {
+		log.Printf("[DEBUG] container registry protection rule %d of gitlab project %s not found, removing from state", ruleID, project)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", project)
+	d.Set("repository_path_pattern", rule.RepositoryPathPattern)
+	d.Set("minimum_access_level_for_push", rule.MinimumAccessLevelForPush)
+	d.Set("minimum_access_level_for_delete", rule.MinimumAccessLevelForDelete)
+	d.Set("rule_id", rule.ID)
+	return nil
+}
+
+func resourceGitlabProjectContainerRegistryProtectionRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, ruleID, err := resourceGitlabProjectContainerRegistryProtectionRuleParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// NOTE: unset access levels must be sent as `null` to remove them from the rule.
+	options := map[string]interface{}{}
+	if d.HasChange("repository_path_pattern") {
+		options["repository_path_pattern"] = d.Get("repository_path_pattern").(string)
+	}
+	for _, key := range []string{"minimum_access_level_for_push", "minimum_access_level_for_delete"} {
+		if d.HasChange(key) {
+			if v, ok := d.GetOk(key); ok {
+				options[key] = v.(string)
+			} else {
+				options[key] = nil
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] update container registry protection rule %d of gitlab project %s", ruleID, project)
+
+	u := fmt.Sprintf("projects/%s/registry/protection/repository/rules/%d", gitlab.PathEscape(project), ruleID)
+	if _, err := resourceGitlabProjectContainerRegistryProtectionRuleDo(ctx, client, http.MethodPatch, u, options); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabProjectContainerRegistryProtectionRuleRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectContainerRegistryProtectionRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, ruleID, err := resourceGitlabProjectContainerRegistryProtectionRuleParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete container registry protection rule %d of gitlab project %s", ruleID, project)
+
+	u := fmt.Sprintf("projects/%s/registry/protection/repository/rules/%d", gitlab.PathEscape(project), ruleID)
+	req, err := client.NewRequest(http.MethodDelete, u, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabProjectContainerRegistryProtectionRuleParseID(id string) (string, int, error) {
+	project, rawRuleID, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	ruleID, err := strconv.Atoi(rawRuleID)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to parse protection rule ID %q: %w", rawRuleID, err)
+	}
+	return project, ruleID, nil
+}
+
+// resourceGitlabProjectContainerRegistryProtectionRuleGet gets a single protection rule.
+// The API doesn't provide an endpoint to get a single rule, thus we search the list of all rules
+// and return nil if the rule doesn't exist.
+func resourceGitlabProjectContainerRegistryProtectionRuleGet(ctx context.Context, client *gitlab.Client, project string, ruleID int) (*gitlabContainerRegistryProtectionRule, error) {
+	u := fmt.Sprintf("projects/%s/registry/protection/repository/rules", gitlab.PathEscape(project))
+	options := &gitlab.ListOptions{PerPage: 100, Page: 1}
+	for options.Page != 0 {
+		req, err := client.NewRequest(http.MethodGet, u, options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return nil, err
+		}
+
+		var rules []*gitlabContainerRegistryProtectionRule
+		resp, err := client.Do(req, &rules)
+		if err != nil {
+			return nil, err
+		}
+		for _, rule := range rules {
+			if rule.ID == ruleID {
+				return rule, nil
+			}
+		}
+		options.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
+
+// resourceGitlabProjectContainerRegistryProtectionRuleDo creates or updates a protection rule.
+func resourceGitlabProjectContainerRegistryProtectionRuleDo(ctx context.Context, client *gitlab.Client, method, u string, options map[string]interface{}) (*gitlabContainerRegistryProtectionRule, error) {
+	req, err := client.NewRequest(method, u, options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	rule := new(gitlabContainerRegistryProtectionRule)
+	if _, err := client.Do(req, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
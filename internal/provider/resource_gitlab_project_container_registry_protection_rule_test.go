@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabProjectContainerRegistryProtectionRule_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectContainerRegistryProtectionRuleDestroy,
+		Steps: []resource.TestStep{
+			// Create a protection rule
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_container_registry_protection_rule" "this" {
+						project                       = %d
+						repository_path_pattern       = "%s/production*"
+						minimum_access_level_for_push = "maintainer"
+					}
+				`, testProject.ID, testProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_project_container_registry_protection_rule.this", "rule_id"),
+					resource.TestCheckResourceAttr("gitlab_project_container_registry_protection_rule.this", "minimum_access_level_for_push", "maintainer"),
+					resource.TestCheckResourceAttr("gitlab_project_container_registry_protection_rule.this", "minimum_access_level_for_delete", ""),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_container_registry_protection_rule.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the protection rule
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_container_registry_protection_rule" "this" {
+						project                         = %d
+						repository_path_pattern         = "%s/release*"
+						minimum_access_level_for_push   = "owner"
+						minimum_access_level_for_delete = "admin"
+					}
+				`, testProject.ID, testProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_container_registry_protection_rule.this", "minimum_access_level_for_push", "owner"),
+					resource.TestCheckResourceAttr("gitlab_project_container_registry_protection_rule.this", "minimum_access_level_for_delete", "admin"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_container_registry_protection_rule.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Unset the minimum access level for push
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_container_registry_protection_rule" "this" {
+						project                         = %d
+						repository_path_pattern         = "%s/release*"
+						minimum_access_level_for_delete = "admin"
+					}
+				`, testProject.ID, testProject.PathWithNamespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_container_registry_protection_rule.this", "minimum_access_level_for_push", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectContainerRegistryProtectionRuleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_container_registry_protection_rule" {
+			continue
+		}
+
+		project, ruleID, err := resourceGitlabProjectContainerRegistryProtectionRuleParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		rule, err := resourceGitlabProjectContainerRegistryProtectionRuleGet(context.Background(), testGitlabClient, project, ruleID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if rule != nil {
+			return fmt.Errorf("container registry protection rule %d of project %s still exists", ruleID, project)
+		}
+	}
+	return nil
+}
@@ -181,7 +181,7 @@ func resourceGitlabProjectEnvironmentDelete(ctx context.Context, d *schema.Resou
 
 	if stopBeforeDestroy {
 		log.Printf("[DEBUG] Stopping environment %d for Project %s before destruction", environmentID, project)
-		_, err = client.Environments.StopEnvironment(project, environmentID, gitlab.WithContext(ctx))
+		_, _, err = client.Environments.StopEnvironment(project, environmentID, nil, gitlab.WithContext(ctx))
 		if err != nil {
 			return diag.Errorf("error stopping gitlab project %s environment %q: %v", project, environmentID, err)
 		}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_project_error_tracking", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_error_tracking`" + ` resource allows to manage the error tracking settings of a project.
+
+-> This resource requires GitLab 15.10 or newer.
+
+~> The GitLab REST API doesn't allow to configure the Sentry API URL and token, they must be configured in the GitLab UI for a Sentry-backed error tracking. Destroying this resource disables error tracking for the project.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/error_tracking.html)`,
+
+		CreateContext: resourceGitlabProjectErrorTrackingCreate,
+		ReadContext:   resourceGitlabProjectErrorTrackingRead,
+		UpdateContext: resourceGitlabProjectErrorTrackingUpdate,
+		DeleteContext: resourceGitlabProjectErrorTrackingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"active": {
+				Description: "Whether error tracking is enabled for the project.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"integrated": {
+				Description: "Whether to use the GitLab integrated error tracking (`true`) or a Sentry backend (`false`).",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"api_url": {
+				Description: "The Sentry API URL of a Sentry-backed error tracking.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"project_name": {
+				Description: "The name of the Sentry project of a Sentry-backed error tracking.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"sentry_external_url": {
+				Description: "The external URL of the Sentry project of a Sentry-backed error tracking.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabProjectErrorTrackingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("project").(string))
+	return resourceGitlabProjectErrorTrackingUpdate(ctx, d, meta)
+}
+
+func resourceGitlabProjectErrorTrackingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read error tracking settings of gitlab project %s", project)
+
+	settings, _, err := client.ErrorTracking.GetErrorTrackingSettings(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] error tracking settings of gitlab project %s not found, removing from state", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("active", settings.Active)
+	d.Set("integrated", settings.Integrated)
+	d.Set("api_url", settings.APIURL)
+	d.Set("project_name", settings.ProjectName)
+	d.Set("sentry_external_url", settings.SentryExternalURL)
+	return nil
+}
+
+func resourceGitlabProjectErrorTrackingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] update error tracking settings of gitlab project %s", project)
+
+	options := &gitlab.EnableDisableErrorTrackingOptions{
+		Active:     gitlab.Bool(d.Get("active").(bool)),
+		Integrated: gitlab.Bool(d.Get("integrated").(bool)),
+	}
+	if err := resourceGitlabProjectErrorTrackingPut(ctx, client, project, options); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabProjectErrorTrackingRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectErrorTrackingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] disable error tracking of gitlab project %s", project)
+
+	options := &gitlab.EnableDisableErrorTrackingOptions{
+		Active:     gitlab.Bool(false),
+		Integrated: gitlab.Bool(d.Get("integrated").(bool)),
+	}
+	if err := resourceGitlabProjectErrorTrackingPut(ctx, client, project, options); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceGitlabProjectErrorTrackingPut creates or updates the error tracking settings of a project.
+// In contrast to the PATCH endpoint, it also works for projects without existing error tracking settings.
+// NOTE: the EnableDisableErrorTracking method of go-gitlab only uses the PATCH endpoint.
+func resourceGitlabProjectErrorTrackingPut(ctx context.Context, client *gitlab.Client, project string, options *gitlab.EnableDisableErrorTrackingOptions) error {
+	u := fmt.Sprintf("projects/%s/error_tracking/settings", gitlab.PathEscape(project))
+	req, err := client.NewRequest(http.MethodPut, u, options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
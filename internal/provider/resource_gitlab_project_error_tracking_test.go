@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabProjectErrorTracking_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectErrorTrackingDestroy,
+		Steps: []resource.TestStep{
+			// Enable integrated error tracking
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_error_tracking" "this" {
+						project = %d
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_error_tracking.this", "active", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_error_tracking.this", "integrated", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_error_tracking.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Deactivate error tracking
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_error_tracking" "this" {
+						project = %d
+						active  = false
+					}
+				`, testProject.ID),
+				Check: resource.TestCheckResourceAttr("gitlab_project_error_tracking.this", "active", "false"),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_error_tracking.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectErrorTrackingDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_error_tracking" {
+			continue
+		}
+
+		settings, _, err := testGitlabClient.ErrorTracking.GetErrorTrackingSettings(rs.Primary.ID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if settings.Active {
+			return fmt.Errorf("error tracking of project %s is still active", rs.Primary.ID)
+		}
+	}
+	return nil
+}
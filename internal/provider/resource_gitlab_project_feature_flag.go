@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var validProjectFeatureFlagStrategyNames = []string{
+	"default", "gradualRolloutUserId", "flexibleRollout", "userWithId", "gitlabUserList",
+}
+
+var validProjectFeatureFlagStickinessValues = []string{
+	"default", "userId", "sessionId", "random",
+}
+
+var _ = registerResource("gitlab_project_feature_flag", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_feature_flag`" + ` resource allows to manage the lifecycle of a feature flag of a project.
+
+-> Changing the strategies of a feature flag replaces all its strategies.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/feature_flags.html)`,
+
+		CreateContext: resourceGitlabProjectFeatureFlagCreate,
+		ReadContext:   resourceGitlabProjectFeatureFlagRead,
+		UpdateContext: resourceGitlabProjectFeatureFlagUpdate,
+		DeleteContext: resourceGitlabProjectFeatureFlagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the feature flag.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "The description of the feature flag.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"active": {
+				Description: "Whether the feature flag is active.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"strategy": {
+				Description: "The strategies of the feature flag. The feature flag is enabled for a user if any of its strategies applies.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description:  fmt.Sprintf("The name of the strategy. Valid values are: %s.", renderValueListForDocs(validProjectFeatureFlagStrategyNames)),
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(validProjectFeatureFlagStrategyNames, false),
+						},
+						"percentage": {
+							Description:  "The percentage of users for the `gradualRolloutUserId` strategy, e.g. `50`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexpFeatureFlagPercentage, "must be a percentage between 0 and 100"),
+						},
+						"rollout": {
+							Description:  "The percentage of users for the `flexibleRollout` strategy, e.g. `50`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexpFeatureFlagPercentage, "must be a percentage between 0 and 100"),
+						},
+						"stickiness": {
+							Description:  fmt.Sprintf("The stickiness of the `flexibleRollout` strategy. Valid values are: %s.", renderValueListForDocs(validProjectFeatureFlagStickinessValues)),
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(validProjectFeatureFlagStickinessValues, false),
+						},
+						"group_id": {
+							Description: "The group ID of the `gradualRolloutUserId` and `flexibleRollout` strategies. GitLab defaults it to `default`.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+						"user_ids": {
+							Description: "A comma-separated list of user IDs for the `userWithId` strategy.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"user_list_id": {
+							Description: "The ID of the user list for the `gitlabUserList` strategy. Use the `user_list_id` attribute of the `gitlab_project_feature_flag_user_list` resource.",
+							Type:        schema.TypeInt,
+							Optional:    true,
+						},
+						"environment_scopes": {
+							Description: "The environment scopes the strategy applies to. Defaults to all environments (`*`).",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"version": {
+				Description: "The version of the feature flag.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "The date the feature flag was created, in RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "The date the feature flag was last updated, in RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+var regexpFeatureFlagPercentage = regexp.MustCompile(`^(100|[1-9]?[0-9])$`)
+
+// gitlabProjectFeatureFlag represents a feature flag including the user lists of its strategies.
+// NOTE: go-gitlab doesn't yet support the `gitlabUserList` strategy, thus we use our own types.
+type gitlabProjectFeatureFlag struct {
+	gitlab.ProjectFeatureFlag
+	Strategies []*gitlabProjectFeatureFlagStrategy `json:"strategies"`
+}
+
+type gitlabProjectFeatureFlagStrategy struct {
+	gitlab.ProjectFeatureFlagStrategy
+	UserList *gitlabFeatureFlagUserList `json:"user_list"`
+}
+
+type gitlabProjectFeatureFlagOptions struct {
+	Name        *string                                    `json:"name,omitempty"`
+	Description *string                                    `json:"description,omitempty"`
+	Version     *string                                    `json:"version,omitempty"`
+	Active      *bool                                      `json:"active,omitempty"`
+	Strategies  []*gitlabProjectFeatureFlagStrategyOptions `json:"strategies,omitempty"`
+}
+
+type gitlabProjectFeatureFlagStrategyOptions struct {
+	ID         *int                                        `json:"id,omitempty"`
+	Name       *string                                     `json:"name,omitempty"`
+	Parameters *gitlab.ProjectFeatureFlagStrategyParameter `json:"parameters,omitempty"`
+	Scopes     []*gitlab.ProjectFeatureFlagScopeOptions    `json:"scopes,omitempty"`
+	UserListID *int                                        `json:"user_list_id,omitempty"`
+	Destroy    *bool                                       `json:"_destroy,omitempty"`
+}
+
+func resourceGitlabProjectFeatureFlagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	name := d.Get("name").(string)
+
+	options := &gitlabProjectFeatureFlagOptions{
+		Name:        gitlab.String(name),
+		Description: gitlab.String(d.Get("description").(string)),
+		Version:     gitlab.String("new_version_flag"),
+		Active:      gitlab.Bool(d.Get("active").(bool)),
+		Strategies:  expandGitlabProjectFeatureFlagStrategies(d.Get("strategy").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] create feature flag %q in gitlab project %s", name, project)
+
+	u := fmt.Sprintf("projects/%s/feature_flags", gitlab.PathEscape(project))
+	if _, err := resourceGitlabProjectFeatureFlagDo(ctx, client, http.MethodPost, u, options); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildTwoPartID(&project, &name))
+	return resourceGitlabProjectFeatureFlagRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectFeatureFlagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, name, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read feature flag %q of gitlab project %s", name, project)
+
+	flag, err := resourceGitlabProjectFeatureFlagDo(ctx, client, http.MethodGet, resourceGitlabProjectFeatureFlagURL(project, name), nil)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] feature flag %q of gitlab project %s not found, removing from state", name, project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("name", flag.Name)
+	d.Set("description", flag.Description)
+	d.Set("active", flag.Active)
+	d.Set("version", flag.Version)
+	if err := d.Set("strategy", flattenGitlabProjectFeatureFlagStrategies(flag.Strategies)); err != nil {
+		return diag.FromErr(err)
+	}
+	if flag.CreatedAt != nil {
+		d.Set("created_at", flag.CreatedAt.Format(time.RFC3339))
+	}
+	if flag.UpdatedAt != nil {
+		d.Set("updated_at", flag.UpdatedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func resourceGitlabProjectFeatureFlagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, name, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &gitlabProjectFeatureFlagOptions{}
+	if d.HasChange("description") {
+		options.Description = gitlab.String(d.Get("description").(string))
+	}
+	if d.HasChange("active") {
+		options.Active = gitlab.Bool(d.Get("active").(bool))
+	}
+	if d.HasChange("strategy") {
+		// NOTE: the strategies are matched by their IDs, which aren't tracked in the state,
+		//       thus we destroy all existing strategies and create the configured ones.
+		flag, err := resourceGitlabProjectFeatureFlagDo(ctx, client, http.MethodGet, resourceGitlabProjectFeatureFlagURL(project, name), nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, strategy := range flag.Strategies {
+			options.Strategies = append(options.Strategies, &gitlabProjectFeatureFlagStrategyOptions{
+				ID:      gitlab.Int(strategy.ID),
+				Destroy: gitlab.Bool(true),
+			})
+		}
+		options.Strategies = append(options.Strategies, expandGitlabProjectFeatureFlagStrategies(d.Get("strategy").([]interface{}))...)
+	}
+
+	log.Printf("[DEBUG] update feature flag %q of gitlab project %s", name, project)
+
+	if _, err := resourceGitlabProjectFeatureFlagDo(ctx, client, http.MethodPut, resourceGitlabProjectFeatureFlagURL(project, name), options); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabProjectFeatureFlagRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectFeatureFlagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, name, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete feature flag %q of gitlab project %s", name, project)
+
+	if _, err := client.ProjectFeatureFlags.DeleteProjectFeatureFlag(project, name, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabProjectFeatureFlagURL(project, name string) string {
+	return fmt.Sprintf("projects/%s/feature_flags/%s", gitlab.PathEscape(project), gitlab.PathEscape(name))
+}
+
+// resourceGitlabProjectFeatureFlagDo does a request against the feature flags API.
+// NOTE: go-gitlab doesn't yet support the `gitlabUserList` strategy, thus we do the raw request.
+func resourceGitlabProjectFeatureFlagDo(ctx context.Context, client *gitlab.Client, method, u string, options *gitlabProjectFeatureFlagOptions) (*gitlabProjectFeatureFlag, error) {
+	var opt interface{}
+	if options != nil {
+		opt = options
+	}
+	req, err := client.NewRequest(method, u, opt, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	flag := new(gitlabProjectFeatureFlag)
+	if _, err := client.Do(req, flag); err != nil {
+		return nil, err
+	}
+	return flag, nil
+}
+
+func expandGitlabProjectFeatureFlagStrategies(strategies []interface{}) []*gitlabProjectFeatureFlagStrategyOptions {
+	result := make([]*gitlabProjectFeatureFlagStrategyOptions, 0, len(strategies))
+	for _, s := range strategies {
+		strategy := s.(map[string]interface{})
+		options := &gitlabProjectFeatureFlagStrategyOptions{
+			Name: gitlab.String(strategy["name"].(string)),
+			Parameters: &gitlab.ProjectFeatureFlagStrategyParameter{
+				GroupID:    strategy["group_id"].(string),
+				UserIDs:    strategy["user_ids"].(string),
+				Percentage: strategy["percentage"].(string),
+				Rollout:    strategy["rollout"].(string),
+				Stickiness: strategy["stickiness"].(string),
+			},
+		}
+		if userListID := strategy["user_list_id"].(int); userListID != 0 {
+			options.UserListID = gitlab.Int(userListID)
+		}
+
+		scopes := *stringSetToStringSlice(strategy["environment_scopes"].(*schema.Set))
+		if len(scopes) == 0 {
+			scopes = []string{"*"}
+		}
+		for _, scope := range scopes {
+			options.Scopes = append(options.Scopes, &gitlab.ProjectFeatureFlagScopeOptions{EnvironmentScope: gitlab.String(scope)})
+		}
+
+		result = append(result, options)
+	}
+	return result
+}
+
+func flattenGitlabProjectFeatureFlagStrategies(strategies []*gitlabProjectFeatureFlagStrategy) []interface{} {
+	result := make([]interface{}, 0, len(strategies))
+	for _, strategy := range strategies {
+		s := map[string]interface{}{
+			"name": strategy.Name,
+		}
+		if strategy.Parameters != nil {
+			s["group_id"] = strategy.Parameters.GroupID
+			s["user_ids"] = strategy.Parameters.UserIDs
+			s["percentage"] = strategy.Parameters.Percentage
+			s["rollout"] = strategy.Parameters.Rollout
+			s["stickiness"] = strategy.Parameters.Stickiness
+		}
+		if strategy.UserList != nil {
+			s["user_list_id"] = strategy.UserList.ID
+		}
+
+		scopes := make([]string, 0, len(strategy.Scopes))
+		for _, scope := range strategy.Scopes {
+			scopes = append(scopes, scope.EnvironmentScope)
+		}
+		s["environment_scopes"] = scopes
+
+		result = append(result, s)
+	}
+	return result
+}
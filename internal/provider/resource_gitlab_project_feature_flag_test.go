@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabProjectFeatureFlag_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	flagName := fmt.Sprintf("flag_%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectFeatureFlagDestroy,
+		Steps: []resource.TestStep{
+			// Create a feature flag with a default strategy
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_feature_flag" "this" {
+						project = %d
+						name    = "%s"
+
+						strategy {
+							name = "default"
+						}
+					}
+				`, testProject.ID, flagName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "active", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "strategy.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "strategy.0.environment_scopes.#", "1"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_feature_flag.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the feature flag with rollout and user list strategies
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_feature_flag_user_list" "this" {
+						project   = %[1]d
+						name      = "%[2]s_users"
+						user_xids = ["user1@example.com", "user2@example.com"]
+					}
+
+					resource "gitlab_project_feature_flag" "this" {
+						project     = %[1]d
+						name        = "%[2]s"
+						description = "Updated feature flag"
+						active      = false
+
+						strategy {
+							name               = "flexibleRollout"
+							rollout            = "25"
+							stickiness         = "userId"
+							environment_scopes = ["production"]
+						}
+
+						strategy {
+							name               = "gitlabUserList"
+							user_list_id       = gitlab_project_feature_flag_user_list.this.user_list_id
+							environment_scopes = ["staging", "review/*"]
+						}
+					}
+				`, testProject.ID, flagName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "description", "Updated feature flag"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "active", "false"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "strategy.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "strategy.0.rollout", "25"),
+					resource.TestCheckResourceAttrPair("gitlab_project_feature_flag.this", "strategy.1.user_list_id", "gitlab_project_feature_flag_user_list.this", "user_list_id"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag.this", "strategy.1.environment_scopes.#", "2"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_feature_flag.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectFeatureFlagDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_feature_flag" {
+			continue
+		}
+
+		project, name, err := parseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = testGitlabClient.ProjectFeatureFlags.GetProjectFeatureFlag(project, name)
+		if err == nil {
+			return fmt.Errorf("feature flag %q of project %s still exists", name, project)
+		}
+		if !is404(err) {
+			return err
+		}
+	}
+	return nil
+}
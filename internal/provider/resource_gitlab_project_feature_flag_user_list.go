@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_project_feature_flag_user_list", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_feature_flag_user_list`" + ` resource allows to manage the lifecycle of a feature flag user list of a project.
+
+-> User lists can be used in feature flag strategies of type ` + "`gitlabUserList`" + `, see the ` + "`gitlab_project_feature_flag`" + ` resource.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/feature_flag_user_lists.html)`,
+
+		CreateContext: resourceGitlabProjectFeatureFlagUserListCreate,
+		ReadContext:   resourceGitlabProjectFeatureFlagUserListRead,
+		UpdateContext: resourceGitlabProjectFeatureFlagUserListUpdate,
+		DeleteContext: resourceGitlabProjectFeatureFlagUserListDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the user list.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"user_xids": {
+				Description: "The external user IDs of the users in the list, as they are passed to the Unleash client, e.g. the email addresses of the users.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"user_list_id": {
+				Description: "The global ID of the user list. Use this ID in the `user_list_id` of a `gitlabUserList` feature flag strategy.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"iid": {
+				Description: "The internal ID of the user list within the project.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+// gitlabFeatureFlagUserList represents a feature flag user list.
+// NOTE: go-gitlab doesn't yet implement the feature flag user lists API.
+type gitlabFeatureFlagUserList struct {
+	ID       int    `json:"id"`
+	IID      int    `json:"iid"`
+	Name     string `json:"name"`
+	UserXIDs string `json:"user_xids"`
+}
+
+type gitlabFeatureFlagUserListOptions struct {
+	Name     *string `json:"name,omitempty"`
+	UserXIDs *string `json:"user_xids,omitempty"`
+}
+
+func resourceGitlabProjectFeatureFlagUserListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlabFeatureFlagUserListOptions{
+		Name:     gitlab.String(d.Get("name").(string)),
+		UserXIDs: gitlab.String(strings.Join(*stringSetToStringSlice(d.Get("user_xids").(*schema.Set)), ",")),
+	}
+
+	log.Printf("[DEBUG] create feature flag user list %q in gitlab project %s", *options.Name, project)
+
+	userList, err := resourceGitlabProjectFeatureFlagUserListDo(ctx, client, http.MethodPost, fmt.Sprintf("projects/%s/feature_flags_user_lists", gitlab.PathEscape(project)), options)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	iid := strconv.Itoa(userList.IID)
+	d.SetId(buildTwoPartID(&project, &iid))
+	return resourceGitlabProjectFeatureFlagUserListRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectFeatureFlagUserListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, iid, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read feature flag user list %s of gitlab project %s", iid, project)
+
+	userList, err := resourceGitlabProjectFeatureFlagUserListDo(ctx, client, http.MethodGet, resourceGitlabProjectFeatureFlagUserListURL(project, iid), nil)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] feature flag user list %s of gitlab project %s not found, removing from state", iid, project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("name", userList.Name)
+	d.Set("user_xids", strings.Split(userList.UserXIDs, ","))
+	d.Set("user_list_id", userList.ID)
+	d.Set("iid", userList.IID)
+	return nil
+}
+
+func resourceGitlabProjectFeatureFlagUserListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, iid, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &gitlabFeatureFlagUserListOptions{}
+	if d.HasChange("name") {
+		options.Name = gitlab.String(d.Get("name").(string))
+	}
+	if d.HasChange("user_xids") {
+		options.UserXIDs = gitlab.String(strings.Join(*stringSetToStringSlice(d.Get("user_xids").(*schema.Set)), ","))
+	}
+
+	log.Printf("[DEBUG] update feature flag user list %s of gitlab project %s", iid, project)
+
+	if _, err := resourceGitlabProjectFeatureFlagUserListDo(ctx, client, http.MethodPut, resourceGitlabProjectFeatureFlagUserListURL(project, iid), options); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabProjectFeatureFlagUserListRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectFeatureFlagUserListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, iid, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete feature flag user list %s of gitlab project %s", iid, project)
+
+	req, err := client.NewRequest(http.MethodDelete, resourceGitlabProjectFeatureFlagUserListURL(project, iid), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabProjectFeatureFlagUserListURL(project, iid string) string {
+	return fmt.Sprintf("projects/%s/feature_flags_user_lists/%s", gitlab.PathEscape(project), iid)
+}
+
+// resourceGitlabProjectFeatureFlagUserListDo does a request against the feature flag user lists API.
+func resourceGitlabProjectFeatureFlagUserListDo(ctx context.Context, client *gitlab.Client, method, u string, options *gitlabFeatureFlagUserListOptions) (*gitlabFeatureFlagUserList, error) {
+	var opt interface{}
+	if options != nil {
+		opt = options
+	}
+	req, err := client.NewRequest(method, u, opt, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	userList := new(gitlabFeatureFlagUserList)
+	if _, err := client.Do(req, userList); err != nil {
+		return nil, err
+	}
+	return userList, nil
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabProjectFeatureFlagUserList_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectFeatureFlagUserListDestroy,
+		Steps: []resource.TestStep{
+			// Create a user list
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_feature_flag_user_list" "this" {
+						project   = %d
+						name      = "beta-testers"
+						user_xids = ["user1@example.com"]
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_project_feature_flag_user_list.this", "user_list_id"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag_user_list.this", "iid", "1"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag_user_list.this", "user_xids.#", "1"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_feature_flag_user_list.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the user list
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_feature_flag_user_list" "this" {
+						project   = %d
+						name      = "alpha-testers"
+						user_xids = ["user1@example.com", "user2@example.com"]
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag_user_list.this", "name", "alpha-testers"),
+					resource.TestCheckResourceAttr("gitlab_project_feature_flag_user_list.this", "user_xids.#", "2"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_feature_flag_user_list.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectFeatureFlagUserListDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_feature_flag_user_list" {
+			continue
+		}
+
+		project, iid, err := parseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = resourceGitlabProjectFeatureFlagUserListDo(context.Background(), testGitlabClient, http.MethodGet, resourceGitlabProjectFeatureFlagUserListURL(project, iid), nil)
+		if err == nil {
+			return fmt.Errorf("feature flag user list %s of project %s still exists", iid, project)
+		}
+		if !is404(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_project_integration", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_integration`" + ` resource allows to manage the lifecycle of an arbitrary project integration, identified by its slug.
+
+-> Prefer the dedicated ` + "`gitlab_integration_*`" + ` resource if one exists for the integration. This resource is meant for integrations without a dedicated resource.
+
+~> The values of ` + "`sensitive_settings`" + ` are secrets and are never read back from the GitLab API, thus changes made to them outside of Terraform are not detected.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html)`,
+
+		CreateContext: resourceGitlabProjectIntegrationCreate,
+		ReadContext:   resourceGitlabProjectIntegrationRead,
+		UpdateContext: resourceGitlabProjectIntegrationUpdate,
+		DeleteContext: resourceGitlabProjectIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: constructSchema(
+			map[string]*schema.Schema{
+				"project": {
+					Description: "ID or full path of the project you want to activate the integration on.",
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+				},
+			},
+			gitlabIntegrationSettingsSchema(),
+		),
+	}
+})
+
+// gitlabIntegrationSettingsSchema returns the schema of an arbitrary integration identified by its slug,
+// shared by the project and group integration resources.
+func gitlabIntegrationSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"integration_slug": {
+			Description:  "The slug of the integration as used in the GitLab API, e.g. `asana`, `bamboo` or `pivotaltracker`.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"settings": {
+			Description: "The settings of the integration, as documented for the integration in the GitLab API. All values are given as strings, e.g. `\"true\"` for booleans. Only the configured keys are read back from the GitLab API, keys removed from the configuration are reset to an empty value. Use `sensitive_settings` for secrets.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"sensitive_settings": {
+			Description: "The settings of the integration which hold secrets, e.g. `token` or `password`. They are merged with `settings` and are never read back from the GitLab API.",
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Sensitive:   true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"active": {
+			Description: "Whether the integration is active.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	}
+}
+
+// gitlabIntegrationSettingsFromResourceData returns the settings to send to the GitLab API,
+// i.e. the `settings` merged with the `sensitive_settings`.
+// Settings which have been removed from the configuration are sent as empty values, otherwise GitLab would keep them.
+func gitlabIntegrationSettingsFromResourceData(d *schema.ResourceData) (map[string]interface{}, error) {
+	settings := make(map[string]interface{})
+	for key, value := range d.Get("settings").(map[string]interface{}) {
+		settings[key] = value
+	}
+	for key, value := range d.Get("sensitive_settings").(map[string]interface{}) {
+		if _, ok := settings[key]; ok {
+			return nil, fmt.Errorf("the setting %q must not be given in both `settings` and `sensitive_settings`", key)
+		}
+		settings[key] = value
+	}
+
+	for _, attribute := range []string{"settings", "sensitive_settings"} {
+		old, _ := d.GetChange(attribute)
+		for key := range old.(map[string]interface{}) {
+			if _, ok := settings[key]; !ok {
+				settings[key] = ""
+			}
+		}
+	}
+	return settings, nil
+}
+
+func resourceGitlabProjectIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	project := d.Get("project").(string)
+	slug := d.Get("integration_slug").(string)
+	d.SetId(buildTwoPartID(&project, &slug))
+
+	return resourceGitlabProjectIntegrationUpdate(ctx, d, meta)
+}
+
+func resourceGitlabProjectIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, slug, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read gitlab %s integration for project %s", slug, project)
+
+	integration, err := gitlabIntegrationGet(ctx, client, resourceGitlabProjectIntegrationURL(project, slug))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab %s integration not found for project %s, removing from state", slug, project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// NOTE: a deleted integration is just deactivated, but its settings are still returned.
+	if active, _ := integration["active"].(bool); !active {
+		log.Printf("[DEBUG] gitlab %s integration is not active for project %s, removing from state", slug, project)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", project)
+	d.Set("integration_slug", slug)
+	d.Set("settings", flattenGitlabIntegrationSettings(integration, d.Get("settings").(map[string]interface{})))
+	d.Set("active", true)
+	return nil
+}
+
+func resourceGitlabProjectIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, slug, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] set gitlab %s integration for project %s", slug, project)
+
+	settings, err := gitlabIntegrationSettingsFromResourceData(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := gitlabIntegrationSet(ctx, client, resourceGitlabProjectIntegrationURL(project, slug), settings); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabProjectIntegrationRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, slug, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete gitlab %s integration for project %s", slug, project)
+
+	if err := gitlabIntegrationDelete(ctx, client, resourceGitlabProjectIntegrationURL(project, slug)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabProjectIntegrationURL(project, slug string) string {
+	return fmt.Sprintf("projects/%s/integrations/%s", gitlab.PathEscape(project), gitlab.PathEscape(slug))
+}
+
+// gitlabIntegrationGet gets the raw settings of an integration.
+// NOTE: go-gitlab doesn't support arbitrary integrations, thus we do the raw request.
+func gitlabIntegrationGet(ctx context.Context, client *gitlab.Client, u string) (map[string]interface{}, error) {
+	req, err := client.NewRequest(http.MethodGet, u, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	integration := make(map[string]interface{})
+	if _, err := client.Do(req, &integration); err != nil {
+		return nil, err
+	}
+	return integration, nil
+}
+
+// gitlabIntegrationSet sets up or updates an integration with the given raw settings.
+// NOTE: go-gitlab doesn't support arbitrary integrations, thus we do the raw request.
+func gitlabIntegrationSet(ctx context.Context, client *gitlab.Client, u string, settings map[string]interface{}) error {
+	req, err := client.NewRequest(http.MethodPut, u, settings, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
+// gitlabIntegrationDelete disables an integration.
+// NOTE: go-gitlab doesn't support arbitrary integrations, thus we do the raw request.
+func gitlabIntegrationDelete(ctx context.Context, client *gitlab.Client, u string) error {
+	req, err := client.NewRequest(http.MethodDelete, u, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(req, nil)
+	return err
+}
+
+// flattenGitlabIntegrationSettings converts the configured settings keys of the given raw integration to strings.
+// The integration specific settings are nested in `properties`, while the event settings are on the top-level.
+// Keys unknown to the GitLab API keep their configured value.
+func flattenGitlabIntegrationSettings(integration map[string]interface{}, configured map[string]interface{}) map[string]string {
+	properties, _ := integration["properties"].(map[string]interface{})
+
+	settings := make(map[string]string, len(configured))
+	for key, configuredValue := range configured {
+		settings[key] = configuredValue.(string)
+
+		value, ok := properties[key]
+		if !ok {
+			value, ok = integration[key]
+		}
+		if ok {
+			settings[key] = flattenGitlabIntegrationSettingValue(value)
+		}
+	}
+	return settings
+}
+
+func flattenGitlabIntegrationSettingValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
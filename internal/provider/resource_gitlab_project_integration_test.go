@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestGitlabIntegrationSettingsFromResourceData(t *testing.T) {
+	r := New("dev")().ResourcesMap["gitlab_project_integration"]
+	d := r.Data(&terraform.InstanceState{
+		ID: "foo:bar",
+		Attributes: map[string]string{
+			"settings.%":                 "2",
+			"settings.url":               "https://example.com",
+			"settings.username":          "user",
+			"sensitive_settings.%":       "1",
+			"sensitive_settings.api_key": "secret",
+		},
+	})
+	if err := d.Set("settings", map[string]interface{}{"url": "https://example.org"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Set("sensitive_settings", map[string]interface{}{}); err != nil {
+		t.Fatal(err)
+	}
+
+	settings, err := gitlabIntegrationSettingsFromResourceData(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"url": "https://example.org", "username": "", "api_key": ""}
+	if !reflect.DeepEqual(settings, expected) {
+		t.Fatalf("got settings %v, expected %v", settings, expected)
+	}
+}
+
+func TestAccGitlabProjectIntegration_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+	integrationResourceName := "gitlab_project_integration.asana"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectIntegrationDestroy,
+		Steps: []resource.TestStep{
+			// Create an asana integration through the generic resource
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_integration" "asana" {
+						project          = %d
+						integration_slug = "asana"
+						sensitive_settings = {
+							api_key = "0123456789"
+						}
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(integrationResourceName, "active", "true"),
+					resource.TestCheckResourceAttr(integrationResourceName, "sensitive_settings.api_key", "0123456789"),
+				),
+			},
+			// Update the asana integration
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_integration" "asana" {
+						project          = %d
+						integration_slug = "asana"
+						settings = {
+							restrict_to_branch = "main"
+						}
+						sensitive_settings = {
+							api_key = "9876543210"
+						}
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(integrationResourceName, "sensitive_settings.api_key", "9876543210"),
+					resource.TestCheckResourceAttr(integrationResourceName, "settings.restrict_to_branch", "main"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            integrationResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings", "sensitive_settings"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectIntegrationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_integration" {
+			continue
+		}
+
+		project, slug, err := parseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		integration, err := gitlabIntegrationGet(context.Background(), testGitlabClient, resourceGitlabProjectIntegrationURL(project, slug))
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if active, _ := integration["active"].(bool); active {
+			return fmt.Errorf("%s integration for project %s still exists", slug, project)
+		}
+	}
+	return nil
+}
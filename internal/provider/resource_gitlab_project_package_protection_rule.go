@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var validPackageProtectionRulePackageTypes = []string{"conan", "generic", "helm", "maven", "npm", "nuget", "pypi"}
+
+var _ = registerResource("gitlab_project_package_protection_rule", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_package_protection_rule`" + ` resource allows to manage the lifecycle of a protection rule for packages of a project.
+
+-> This resource requires GitLab 17.1 or newer.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/project_packages_protection_rules.html)`,
+
+		CreateContext: resourceGitlabProjectPackageProtectionRuleCreate,
+		ReadContext:   resourceGitlabProjectPackageProtectionRuleRead,
+		UpdateContext: resourceGitlabProjectPackageProtectionRuleUpdate,
+		DeleteContext: resourceGitlabProjectPackageProtectionRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"package_name_pattern": {
+				Description: "The package name pattern protected by the rule, e.g. `@my-scope/my-package-*`. Wildcards (`*`) are allowed.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"package_type": {
+				Description:  fmt.Sprintf("The type of the packages protected by the rule. Valid values are: %s.", renderValueListForDocs(validPackageProtectionRulePackageTypes)),
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(validPackageProtectionRulePackageTypes, false),
+			},
+			"minimum_access_level_for_push": {
+				Description:  fmt.Sprintf("The minimum access level required to push packages matching the rule. Valid values are: %s.", renderValueListForDocs(validProtectionRuleMinimumAccessLevels)),
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(validProtectionRuleMinimumAccessLevels, false),
+			},
+			"rule_id": {
+				Description: "The ID of the protection rule.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+// gitlabPackageProtectionRule represents a package protection rule.
+// NOTE: go-gitlab doesn't yet implement the package protection rules API.
+type gitlabPackageProtectionRule struct {
+	ID                        int    `json:"id"`
+	ProjectID                 int    `json:"project_id"`
+	PackageNamePattern        string `json:"package_name_pattern"`
+	PackageType               string `json:"package_type"`
+	MinimumAccessLevelForPush string `json:"minimum_access_level_for_push"`
+}
+
+// gitlabPackageProtectionRuleOptions represents the options to create or update a package protection rule.
+type gitlabPackageProtectionRuleOptions struct {
+	PackageNamePattern        *string `json:"package_name_pattern,omitempty"`
+	PackageType               *string `json:"package_type,omitempty"`
+	MinimumAccessLevelForPush *string `json:"minimum_access_level_for_push,omitempty"`
+}
+
+func resourceGitlabProjectPackageProtectionRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	options := &gitlabPackageProtectionRuleOptions{
+		PackageNamePattern:        gitlab.String(d.Get("package_name_pattern").(string)),
+		PackageType:               gitlab.String(d.Get("package_type").(string)),
+		MinimumAccessLevelForPush: gitlab.String(d.Get("minimum_access_level_for_push").(string)),
+	}
+
+	log.Printf("[DEBUG] create package protection rule for gitlab project %s", project)
+
+	u := fmt.Sprintf("projects/%s/packages/protection/rules", gitlab.PathEscape(project))
+	rule, err := resourceGitlabProjectPackageProtectionRuleDo(ctx, client, http.MethodPost, u, options)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ruleID := strconv.Itoa(rule.ID)
+	d.SetId(buildTwoPartID(&project, &ruleID))
+	return resourceGitlabProjectPackageProtectionRuleRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectPackageProtectionRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, ruleID, err := resourceGitlabProjectPackageProtectionRuleParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read package protection rule %d of gitlab project %s", ruleID, project)
+
+	rule, err := resourceGitlabProjectPackageProtectionRuleGet(ctx, client, project, ruleID)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab project %s not found, removing package protection rule from state", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if rule == nil {
+		log.Printf("[DEBUG] package protection rule %d of gitlab project %s not found, removing from state", ruleID, project)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", project)
+	d.Set("package_name_pattern", rule.PackageNamePattern)
+	d.Set("package_type", rule.PackageType)
+	d.Set("minimum_access_level_for_push", rule.MinimumAccessLevelForPush)
+	d.Set("rule_id", rule.ID)
+	return nil
+}
+
+func resourceGitlabProjectPackageProtectionRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, ruleID, err := resourceGitlabProjectPackageProtectionRuleParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &gitlabPackageProtectionRuleOptions{}
+	if d.HasChange("package_name_pattern") {
+		options.PackageNamePattern = gitlab.String(d.Get("package_name_pattern").(string))
+	}
+	if d.HasChange("package_type") {
+		options.PackageType = gitlab.String(d.Get("package_type").(string))
+	}
+	if d.HasChange("minimum_access_level_for_push") {
+		options.MinimumAccessLevelForPush = gitlab.String(d.Get("minimum_access_level_for_push").(string))
+	}
+
+	log.Printf("[DEBUG] update package protection rule %d of gitlab project %s", ruleID, project)
+
+	u := fmt.Sprintf("projects/%s/packages/protection/rules/%d", gitlab.PathEscape(project), ruleID)
+	if _, err := resourceGitlabProjectPackageProtectionRuleDo(ctx, client, http.MethodPatch, u, options); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabProjectPackageProtectionRuleRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectPackageProtectionRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, ruleID, err := resourceGitlabProjectPackageProtectionRuleParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete package protection rule %d of gitlab project %s", ruleID, project)
+
+	u := fmt.Sprintf("projects/%s/packages/protection/rules/%d", gitlab.PathEscape(project), ruleID)
+	req, err := client.NewRequest(http.MethodDelete, u, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if _, err := client.Do(req, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabProjectPackageProtectionRuleParseID(id string) (string, int, error) {
+	project, rawRuleID, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	ruleID, err := strconv.Atoi(rawRuleID)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to parse protection rule ID %q: %w", rawRuleID, err)
+	}
+	return project, ruleID, nil
+}
+
+// resourceGitlabProjectPackageProtectionRuleGet gets a single protection rule.
+// The API doesn't provide an endpoint to get a single rule, thus we search the list of all rules
+// and return nil if the rule doesn't exist.
+func resourceGitlabProjectPackageProtectionRuleGet(ctx context.Context, client *gitlab.Client, project string, ruleID int) (*gitlabPackageProtectionRule, error) {
+	u := fmt.Sprintf("projects/%s/packages/protection/rules", gitlab.PathEscape(project))
+	options := &gitlab.ListOptions{PerPage: 100, Page: 1}
+	for options.Page != 0 {
+		req, err := client.NewRequest(http.MethodGet, u, options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return nil, err
+		}
+
+		var rules []*gitlabPackageProtectionRule
+		resp, err := client.Do(req, &rules)
+		if err != nil {
+			return nil, err
+		}
+		for _, rule := range rules {
+			if rule.ID == ruleID {
+				return rule, nil
+			}
+		}
+		options.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
+
+// resourceGitlabProjectPackageProtectionRuleDo creates or updates a protection rule.
+func resourceGitlabProjectPackageProtectionRuleDo(ctx context.Context, client *gitlab.Client, method, u string, options *gitlabPackageProtectionRuleOptions) (*gitlabPackageProtectionRule, error) {
+	req, err := client.NewRequest(method, u, options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	rule := new(gitlabPackageProtectionRule)
+	if _, err := client.Do(req, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
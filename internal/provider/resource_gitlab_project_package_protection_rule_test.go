@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabProjectPackageProtectionRule_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectPackageProtectionRuleDestroy,
+		Steps: []resource.TestStep{
+			// Create a protection rule
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_package_protection_rule" "this" {
+						project                       = %d
+						package_name_pattern          = "@my-scope/release-*"
+						package_type                  = "npm"
+						minimum_access_level_for_push = "maintainer"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_project_package_protection_rule.this", "rule_id"),
+					resource.TestCheckResourceAttr("gitlab_project_package_protection_rule.this", "minimum_access_level_for_push", "maintainer"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_package_protection_rule.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the protection rule
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_package_protection_rule" "this" {
+						project                       = %d
+						package_name_pattern          = "@my-scope/stable-*"
+						package_type                  = "npm"
+						minimum_access_level_for_push = "owner"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_package_protection_rule.this", "package_name_pattern", "@my-scope/stable-*"),
+					resource.TestCheckResourceAttr("gitlab_project_package_protection_rule.this", "minimum_access_level_for_push", "owner"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_package_protection_rule.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectPackageProtectionRuleDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_package_protection_rule" {
+			continue
+		}
+
+		project, ruleID, err := resourceGitlabProjectPackageProtectionRuleParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		rule, err := resourceGitlabProjectPackageProtectionRuleGet(context.Background(), testGitlabClient, project, ruleID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if rule != nil {
+			return fmt.Errorf("package protection rule %d of project %s still exists", ruleID, project)
+		}
+	}
+	return nil
+}
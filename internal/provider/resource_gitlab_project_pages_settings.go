@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_project_pages_settings", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_pages_settings`" + ` resource allows to manage the GitLab Pages settings of a project.
+
+-> This resource requires administration privileges.
+
+~> Destroying this resource doesn't change the Pages settings of the project, unless ` + "`force_delete_pages`" + ` is set, in which case the Pages site of the project is unpublished.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/pages.html)`,
+
+		CreateContext: resourceGitlabProjectPagesSettingsCreate,
+		ReadContext:   resourceGitlabProjectPagesSettingsRead,
+		UpdateContext: resourceGitlabProjectPagesSettingsUpdate,
+		DeleteContext: resourceGitlabProjectPagesSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"unique_domain_enabled": {
+				Description: "Whether to serve the Pages site on a unique domain instead of the namespace domain.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"https_only": {
+				Description: "Whether to redirect all HTTP requests to the Pages site to HTTPS.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"primary_domain": {
+				Description: "The custom domain to redirect all requests to the Pages site to, e.g. `https://docs.example.com`. Must be one of the Pages domains of the project. Requires GitLab 17.8 or newer.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"force_delete_pages": {
+				Description: "Whether to unpublish the Pages site of the project when this resource is destroyed.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"url": {
+				Description: "The URL of the Pages site.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+// gitlabProjectPagesSettings represents the Pages settings of a project.
+// NOTE: go-gitlab doesn't yet implement the `primary_domain` attribute.
+type gitlabProjectPagesSettings struct {
+	gitlab.Pages
+	PrimaryDomain string `json:"primary_domain"`
+}
+
+// gitlabProjectPagesSettingsOptions represents the options to update the Pages settings of a project.
+type gitlabProjectPagesSettingsOptions struct {
+	gitlab.UpdatePagesOptions
+	PagesPrimaryDomain *string `url:"pages_primary_domain,omitempty" json:"pages_primary_domain,omitempty"`
+}
+
+func resourceGitlabProjectPagesSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	project := d.Get("project").(string)
+	d.SetId(project)
+
+	log.Printf("[DEBUG] create pages settings for gitlab project %s", project)
+
+	return resourceGitlabProjectPagesSettingsUpdate(ctx, d, meta)
+}
+
+func resourceGitlabProjectPagesSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read pages settings of gitlab project %s", project)
+
+	settings, err := resourceGitlabProjectPagesSettingsDo(ctx, client, http.MethodGet, project, nil)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] pages settings of gitlab project %s not found, removing from state", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("unique_domain_enabled", settings.IsUniqueDomainEnabled)
+	d.Set("https_only", settings.ForceHTTPS)
+	d.Set("primary_domain", settings.PrimaryDomain)
+	d.Set("url", settings.URL)
+	return nil
+}
+
+func resourceGitlabProjectPagesSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	options := &gitlabProjectPagesSettingsOptions{}
+	if d.HasChange("unique_domain_enabled") {
+		options.PagesUniqueDomainEnabled = gitlab.Bool(d.Get("unique_domain_enabled").(bool))
+	}
+	if d.HasChange("https_only") {
+		options.PagesHTTPSOnly = gitlab.Bool(d.Get("https_only").(bool))
+	}
+	if d.HasChange("primary_domain") {
+		options.PagesPrimaryDomain = gitlab.String(d.Get("primary_domain").(string))
+	}
+
+	if *options != (gitlabProjectPagesSettingsOptions{}) {
+		log.Printf("[DEBUG] update pages settings of gitlab project %s", project)
+
+		if _, err := resourceGitlabProjectPagesSettingsDo(ctx, client, http.MethodPatch, project, options); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGitlabProjectPagesSettingsRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectPagesSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	if !d.Get("force_delete_pages").(bool) {
+		log.Printf("[DEBUG] keep pages of gitlab project %s, only removing pages settings from state", project)
+		return nil
+	}
+
+	log.Printf("[DEBUG] unpublish pages of gitlab project %s", project)
+
+	if _, err := client.Pages.UnpublishPages(project, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceGitlabProjectPagesSettingsDo gets or updates the Pages settings of a project.
+// NOTE: go-gitlab doesn't yet implement the `primary_domain` attribute, thus we do the raw request.
+func resourceGitlabProjectPagesSettingsDo(ctx context.Context, client *gitlab.Client, method, project string, options *gitlabProjectPagesSettingsOptions) (*gitlabProjectPagesSettings, error) {
+	var opt interface{}
+	if options != nil {
+		opt = options
+	}
+
+	u := fmt.Sprintf("projects/%s/pages", gitlab.PathEscape(project))
+	req, err := client.NewRequest(method, u, opt, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	settings := new(gitlabProjectPagesSettings)
+	if _, err := client.Do(req, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
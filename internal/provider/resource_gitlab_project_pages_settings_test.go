@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGitlabProjectPagesSettings_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// Configure the pages settings
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_pages_settings" "this" {
+						project               = %d
+						unique_domain_enabled = false
+						https_only            = false
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_pages_settings.this", "unique_domain_enabled", "false"),
+					resource.TestCheckResourceAttr("gitlab_project_pages_settings.this", "https_only", "false"),
+					resource.TestCheckResourceAttrSet("gitlab_project_pages_settings.this", "url"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_project_pages_settings.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete_pages"},
+			},
+			// Update the pages settings
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_pages_settings" "this" {
+						project               = %d
+						unique_domain_enabled = true
+						https_only            = true
+						force_delete_pages    = true
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_pages_settings.this", "unique_domain_enabled", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_pages_settings.this", "https_only", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_project_pages_settings.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete_pages"},
+			},
+		},
+	})
+}
@@ -70,7 +70,7 @@ func resourceGitlabProjectRunnerEnablementRead(ctx context.Context, d *schema.Re
 	log.Printf("[DEBUG] read gitlab project runner %s/%v", project, runnerID)
 
 	// Get the project id from `project`, which can be either the numeric ID or a name
-	projectDetails, _, err := client.Projects.GetProject(project, &gitlab.GetProjectOptions{}, gitlab.WithContext(ctx))
+	projectID, err := gitlabProjectID(ctx, client, project)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -83,7 +83,7 @@ func resourceGitlabProjectRunnerEnablementRead(ctx context.Context, d *schema.Re
 	// Check if the project exists in the runner details
 	found := false
 	for _, p := range runnerdetails.Projects {
-		if p.ID == projectDetails.ID {
+		if p.ID == projectID {
 			found = true
 			break
 		}
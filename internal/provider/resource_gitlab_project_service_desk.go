@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_project_service_desk", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_service_desk`" + ` resource allows to enable Service Desk for a project.
+
+-> Service Desk requires incoming email to be configured on the GitLab instance.
+
+~> Destroying this resource disables Service Desk for the project. The issue template, the outgoing name and the custom email address are not exposed by the GitLab REST API and thus can't be managed with Terraform.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/projects.html#edit-project)`,
+
+		CreateContext: resourceGitlabProjectServiceDeskCreate,
+		ReadContext:   resourceGitlabProjectServiceDeskRead,
+		DeleteContext: resourceGitlabProjectServiceDeskDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project to enable Service Desk for.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"address": {
+				Description: "The email address to which the issues can be sent to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabProjectServiceDeskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+
+	log.Printf("[DEBUG] enable service desk for gitlab project %s", project)
+
+	options := &gitlab.EditProjectOptions{
+		ServiceDeskEnabled: gitlab.Bool(true),
+	}
+	p, _, err := client.Projects.EditProject(project, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !p.ServiceDeskEnabled {
+		return diag.Errorf("failed to enable service desk for project %s, make sure incoming email is configured on the GitLab instance", project)
+	}
+
+	d.SetId(project)
+	return resourceGitlabProjectServiceDeskRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectServiceDeskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] read service desk of gitlab project %s", project)
+
+	p, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab project %s not found, removing service desk from state", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if !p.ServiceDeskEnabled {
+		log.Printf("[DEBUG] service desk of gitlab project %s is disabled, removing from state", project)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", project)
+	d.Set("address", p.ServiceDeskAddress)
+	return nil
+}
+
+func resourceGitlabProjectServiceDeskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Id()
+
+	log.Printf("[DEBUG] disable service desk for gitlab project %s", project)
+
+	options := &gitlab.EditProjectOptions{
+		ServiceDeskEnabled: gitlab.Bool(false),
+	}
+	if _, _, err := client.Projects.EditProject(project, options, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
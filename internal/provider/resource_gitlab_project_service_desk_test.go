@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabProjectServiceDesk_basic(t *testing.T) {
+	testAccCheck(t)
+
+	testProject := testAccCreateProject(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectServiceDeskDestroy,
+		Steps: []resource.TestStep{
+			// Enable service desk
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_service_desk" "this" {
+						project = %d
+					}
+				`, testProject.ID),
+				Check: resource.TestCheckResourceAttr("gitlab_project_service_desk.this", "project", fmt.Sprintf("%d", testProject.ID)),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_service_desk.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectServiceDeskDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_service_desk" {
+			continue
+		}
+
+		project, _, err := testGitlabClient.Projects.GetProject(rs.Primary.ID, nil)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if project.ServiceDeskEnabled {
+			return fmt.Errorf("service desk of project %s is still enabled", rs.Primary.ID)
+		}
+	}
+	return nil
+}
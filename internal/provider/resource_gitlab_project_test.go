@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -483,6 +487,21 @@ func TestAccGitlabProject_archiveOnDestroy(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProject_permanentlyRemoveOnDestroy(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectPermanentlyRemovedOnDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabProjectConfigPermanentlyRemoveOnDestroy(rInt),
+			},
+		},
+	})
+}
+
 func TestAccGitlabProject_setSinglePushRuleToDefault(t *testing.T) {
 	rInt := acctest.RandInt()
 
@@ -784,7 +803,7 @@ func TestAccGitlabProject_importURL(t *testing.T) {
 		t.Fatalf("failed to create base project: %v", err)
 	}
 
-	defer testGitlabClient.Projects.DeleteProject(baseProject.ID) // nolint // TODO: Resolve this golangci-lint issue: Error return value of `testGitlabClient.Projects.DeleteProject` is not checked (errcheck)
+	defer testGitlabClient.Projects.DeleteProject(baseProject.ID, nil) // nolint // TODO: Resolve this golangci-lint issue: Error return value of `testGitlabClient.Projects.DeleteProject` is not checked (errcheck)
 
 	// Add a file to the base project, for later verifying the import.
 	_, _, err = testGitlabClient.RepositoryFiles.CreateFile(baseProject.ID, "foo.txt", &gitlab.CreateFileOptions{
@@ -821,6 +840,341 @@ func TestAccGitlabProject_importURL(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProject_fork(t *testing.T) {
+	testAccCheck(t)
+
+	upstream := testAccCreateProject(t)
+	group := testAccCreateGroups(t, 1)[0]
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			// Create a fork with additional settings
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project" "fork" {
+  name                   = "fork-%d"
+  namespace_id           = %d
+  forked_from_project_id = %d
+  visibility_level       = "public"
+  mr_default_target_self = true
+  issues_enabled         = false
+}
+`, rInt, group.ID, upstream.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project.fork", "forked_from_project_id", fmt.Sprintf("%d", upstream.ID)),
+					resource.TestCheckResourceAttr("gitlab_project.fork", "mr_default_target_self", "true"),
+					resource.TestCheckResourceAttr("gitlab_project.fork", "issues_enabled", "false"),
+					func(s *terraform.State) error {
+						project, _, err := testGitlabClient.Projects.GetProject(s.RootModule().Resources["gitlab_project.fork"].Primary.ID, nil)
+						if err != nil {
+							return err
+						}
+						if project.ForkedFromProject == nil || project.ForkedFromProject.ID != upstream.ID {
+							return fmt.Errorf("expected project to be a fork of project %d", upstream.ID)
+						}
+						return nil
+					},
+				),
+			},
+			// Remove the fork relationship
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project" "fork" {
+  name                     = "fork-%d"
+  namespace_id             = %d
+  forked_from_project_id   = %d
+  remove_fork_relationship = true
+  visibility_level         = "public"
+  mr_default_target_self   = true
+  issues_enabled           = false
+}
+`, rInt, group.ID, upstream.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project.fork", "forked_from_project_id", fmt.Sprintf("%d", upstream.ID)),
+					func(s *terraform.State) error {
+						project, _, err := testGitlabClient.Projects.GetProject(s.RootModule().Resources["gitlab_project.fork"].Primary.ID, nil)
+						if err != nil {
+							return err
+						}
+						if project.ForkedFromProject != nil {
+							return fmt.Errorf("expected fork relationship to be removed")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProject_importFork(t *testing.T) {
+	testAccCheck(t)
+
+	upstream := testAccCreateProject(t)
+	group := testAccCreateGroups(t, 1)[0]
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project" "fork" {
+  name                   = "fork-%d"
+  namespace_id           = %d
+  forked_from_project_id = %d
+}
+`, rInt, group.ID, upstream.ID),
+			},
+			// Verify the forked project is read on import
+			{
+				ResourceName:      "gitlab_project.fork",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Verify the fork isn't replaced when the forked project isn't configured, as for imported forks
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project" "fork" {
+  name         = "fork-%d"
+  namespace_id = %d
+}
+`, rInt, group.ID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccGitlabProject_defaultBranchRename(t *testing.T) {
+	rInt := acctest.RandInt()
+	var mergeRequest *gitlab.MergeRequest
+
+	config := func(defaultBranch string) string {
+		return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name                   = "foo-%d"
+  default_branch         = "%s"
+  default_branch_rename  = true
+  initialize_with_readme = true
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+`, rInt, defaultBranch)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config("main"),
+				Check: func(state *terraform.State) error {
+					projectID := state.RootModule().Resources["gitlab_project.foo"].Primary.ID
+
+					if _, _, err := testGitlabClient.Branches.CreateBranch(projectID, &gitlab.CreateBranchOptions{
+						Branch: gitlab.String("feature"),
+						Ref:    gitlab.String("main"),
+					}); err != nil {
+						return fmt.Errorf("failed to create feature branch: %w", err)
+					}
+
+					var err error
+					mergeRequest, _, err = testGitlabClient.MergeRequests.CreateMergeRequest(projectID, &gitlab.CreateMergeRequestOptions{
+						Title:        gitlab.String("Feature"),
+						SourceBranch: gitlab.String("feature"),
+						TargetBranch: gitlab.String("main"),
+					})
+					if err != nil {
+						return fmt.Errorf("failed to create merge request: %w", err)
+					}
+					return nil
+				},
+			},
+			{
+				Config: config("trunk"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project.foo", "default_branch", "trunk"),
+					func(state *terraform.State) error {
+						projectID := state.RootModule().Resources["gitlab_project.foo"].Primary.ID
+
+						if _, _, err := testGitlabClient.Branches.GetBranch(projectID, "main"); !is404(err) {
+							return fmt.Errorf("expected previous default branch to be deleted, got: %v", err)
+						}
+						if _, _, err := testGitlabClient.ProtectedBranches.GetProtectedBranch(projectID, "trunk"); err != nil {
+							return fmt.Errorf("expected new default branch to be protected: %w", err)
+						}
+
+						mr, _, err := testGitlabClient.MergeRequests.GetMergeRequest(projectID, mergeRequest.IID, nil)
+						if err != nil {
+							return fmt.Errorf("failed to get merge request: %w", err)
+						}
+						if mr.TargetBranch != "trunk" {
+							return fmt.Errorf("expected merge request to target %q, got %q", "trunk", mr.TargetBranch)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProject_importURLStripCredentials(t *testing.T) {
+	testAccCheck(t)
+
+	rInt := acctest.RandInt()
+
+	// Create a private base project, which requires credentials to be imported.
+	baseProject, _, err := testGitlabClient.Projects.CreateProject(&gitlab.CreateProjectOptions{
+		Name:                 gitlab.String(fmt.Sprintf("base-%d", rInt)),
+		Visibility:           gitlab.Visibility(gitlab.PrivateVisibility),
+		InitializeWithReadme: gitlab.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("failed to create base project: %v", err)
+	}
+
+	defer testGitlabClient.Projects.DeleteProject(baseProject.ID, nil) // nolint // TODO: Resolve this golangci-lint issue: Error return value of `testGitlabClient.Projects.DeleteProject` is not checked (errcheck)
+
+	currentUser, _, err := testGitlabClient.Users.CurrentUser()
+	if err != nil {
+		t.Fatalf("failed to get current user: %v", err)
+	}
+	importURL, err := url.Parse(baseProject.HTTPURLToRepo)
+	if err != nil {
+		t.Fatalf("failed to parse base project url: %v", err)
+	}
+	importURL.User = url.UserPassword(currentUser.Username, os.Getenv("GITLAB_TOKEN"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			// Pull mirrors need the credentials
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project" "imported" {
+  name                         = "imported-%d"
+  import_url                   = "%s"
+  import_url_strip_credentials = true
+  mirror                       = true
+}
+`, rInt, importURL.String()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"import_url_strip_credentials": conflicts with mirror`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project" "imported" {
+  name                         = "imported-%d"
+  default_branch               = "main"
+  import_url                   = "%s"
+  import_url_strip_credentials = true
+  visibility_level             = "private"
+
+  timeouts {
+    create = "5m"
+  }
+}
+`, rInt, importURL.String()),
+				Check: func(state *terraform.State) error {
+					projectID := state.RootModule().Resources["gitlab_project.imported"].Primary.ID
+
+					_, _, err := testGitlabClient.RepositoryFiles.GetFile(projectID, "README.md", &gitlab.GetFileOptions{Ref: gitlab.String("main")}, nil)
+					if err != nil {
+						return fmt.Errorf("failed to get file from imported project: %w", err)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccGitlabProject_importArchive(t *testing.T) {
+	testAccCheck(t)
+
+	rInt := acctest.RandInt()
+
+	// Export a project with an issue, for later verifying the import.
+	baseProject := testAccCreateProject(t)
+	testAccCreateProjectIssues(t, baseProject.ID, 1)
+
+	if _, err := testGitlabClient.ProjectImportExport.ScheduleExport(baseProject.ID, nil); err != nil {
+		t.Fatalf("failed to schedule export of base project: %v", err)
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"none", "queued", "started", "regeneration_in_progress"},
+		Target:  []string{"finished"},
+		Timeout: 5 * time.Minute,
+		Refresh: func() (interface{}, string, error) {
+			status, _, err := testGitlabClient.ProjectImportExport.ExportStatus(baseProject.ID)
+			if err != nil {
+				return nil, "", err
+			}
+			return status, status.ExportStatus, nil
+		},
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		t.Fatalf("failed to wait for export of base project: %v", err)
+	}
+	archive, _, err := testGitlabClient.ProjectImportExport.ExportDownload(baseProject.ID)
+	if err != nil {
+		t.Fatalf("failed to download export of base project: %v", err)
+	}
+	archivePath := filepath.Join(t.TempDir(), "export.tar.gz")
+	if err := os.WriteFile(archivePath, archive, 0600); err != nil {
+		t.Fatalf("failed to write export of base project: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project" "imported" {
+  name                = "imported-%[1]d"
+  path                = "imported-%[1]d"
+  description         = "Imported from an export archive"
+  import_archive_path = %[2]q
+  visibility_level    = "public"
+}
+`, rInt, archivePath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project.imported", "description", "Imported from an export archive"),
+					func(state *terraform.State) error {
+						projectID := state.RootModule().Resources["gitlab_project.imported"].Primary.ID
+
+						issues, _, err := testGitlabClient.Issues.ListProjectIssues(projectID, nil)
+						if err != nil {
+							return fmt.Errorf("failed to list issues of imported project: %w", err)
+						}
+						if len(issues) != 1 {
+							return fmt.Errorf("expected the issue of the exported project to be imported, got %d issues", len(issues))
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccGitlabProject_initializeWithReadmeAndCustomDefaultBranch(t *testing.T) {
 	var project gitlab.Project
 	rInt := acctest.RandInt()
@@ -947,7 +1301,7 @@ func TestAccGitlabProject_ImportURLMirrored(t *testing.T) {
 		t.Fatalf("failed to create base project: %v", err)
 	}
 
-	defer testGitlabClient.Projects.DeleteProject(baseProject.ID) // nolint // TODO: Resolve this golangci-lint issue: Error return value of `testGitlabClient.Projects.DeleteProject` is not checked (errcheck)
+	defer testGitlabClient.Projects.DeleteProject(baseProject.ID, nil) // nolint // TODO: Resolve this golangci-lint issue: Error return value of `testGitlabClient.Projects.DeleteProject` is not checked (errcheck)
 
 	// Add a file to the base project, for later verifying the import.
 	_, _, err = testGitlabClient.RepositoryFiles.CreateFile(baseProject.ID, "foo.txt", &gitlab.CreateFileOptions{
@@ -1065,6 +1419,28 @@ func TestAccGitlabProject_templateMutualExclusiveNameAndID(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProject_groupTemplateRequiresCustomTemplate(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project" "template-group" {
+  name                            = "template-group-%d"
+  template_name                   = "myrails"
+  group_with_project_templates_id = 999
+}
+`, rInt),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("`group_with_project_templates_id,use_custom_template` must be specified")),
+			},
+		},
+	})
+}
+
 func TestAccGitlabProject_containerExpirationPolicy(t *testing.T) {
 	var received gitlab.Project
 	rInt := acctest.RandInt()
@@ -1207,6 +1583,24 @@ func testAccCheckGitlabProjectArchivedOnDestroy(s *terraform.State) error {
 	return fmt.Errorf("no project resources found in state, but expected a `gitlab_project` resource marked as archvied")
 }
 
+func testAccCheckGitlabProjectPermanentlyRemovedOnDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project" {
+			continue
+		}
+
+		_, _, err := testGitlabClient.Projects.GetProject(rs.Primary.ID, nil)
+		if err == nil {
+			return fmt.Errorf("expected project to be permanently removed, but it still exists")
+		}
+		if !is404(err) {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
+
 func testAccCheckAggregateGitlabProject(expected, received *gitlab.Project) resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 
@@ -1877,6 +2271,21 @@ resource "gitlab_project" "foo" {
 	`, rInt, rInt)
 }
 
+func testAccGitlabProjectConfigPermanentlyRemoveOnDestroy(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name = "foo-%d"
+  path = "foo.%d"
+  description = "Terraform acceptance tests"
+  permanently_remove_on_destroy = true
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+	`, rInt, rInt)
+}
+
 func testAccGitLabProjectMergePipelinesEnabled(rInt int) string {
 	return fmt.Sprintf(`
 resource "gitlab_project" "foo" {
@@ -16,6 +16,8 @@ var _ = registerResource("gitlab_project_variable", func() *schema.Resource {
 
 ~> **Important:** If your GitLab version is older than 13.4, you may see nondeterministic behavior when updating or deleting gitlab_project_variable resources with non-unique keys, for example if there is another variable with the same key and different environment scope. See [this GitLab issue](https://gitlab.com/gitlab-org/gitlab/-/issues/9912).
 
+~> The value of a ` + "`hidden`" + ` variable is never returned by the GitLab API, thus changes made to it outside of Terraform are not detected.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/project_level_variables.html)`,
 
 		CreateContext: resourceGitlabProjectVariableCreate,
@@ -26,7 +28,8 @@ var _ = registerResource("gitlab_project_variable", func() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: gitlabProjectVariableGetSchema(),
+		Schema:        gitlabProjectVariableGetSchema(),
+		CustomizeDiff: validateMaskedVariableValueDiff,
 	}
 })
 
@@ -49,6 +52,9 @@ func resourceGitlabProjectVariableCreate(ctx context.Context, d *schema.Resource
 		Masked:           &masked,
 		EnvironmentScope: &environmentScope,
 	}
+	if d.Get("hidden").(bool) {
+		options.MaskedAndHidden = gitlab.Bool(true)
+	}
 
 	id := strings.Join([]string{project, key, environmentScope}, ":")
 
@@ -102,6 +108,11 @@ func resourceGitlabProjectVariableRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	stateMap := gitlabProjectVariableToStateMap(project, variable)
+	// NOTE: the value of hidden variables is never returned by the API,
+	//       thus we keep the value from the state.
+	if variable.Hidden {
+		stateMap["value"] = d.Get("value").(string)
+	}
 	if err = setStateMapInResourceData(stateMap, d); err != nil {
 		return diag.FromErr(err)
 	}
@@ -291,3 +291,80 @@ resource "gitlab_project_variable" "bar" {
 		},
 	})
 }
+
+func TestAccGitlabProjectVariable_hidden(t *testing.T) {
+	ctx := testAccGitlabProjectStart(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccGitlabProjectVariableCheckAllVariablesDestroyed(ctx),
+		Steps: []resource.TestStep{
+			// Hidden variables must be masked
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_variable" "this" {
+  project = %d
+  key     = "my_key"
+  value   = "my-hidden-value"
+  hidden  = true
+}
+`, ctx.project.ID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`hidden` variables must also be `masked`"),
+			},
+			// Create a hidden variable
+			{
+				SkipFunc: isGitLabVersionLessThan(context.Background(), testGitlabClient, "17.4"),
+				Config: fmt.Sprintf(`
+resource "gitlab_project_variable" "this" {
+  project = %d
+  key     = "my_key"
+  value   = "my-hidden-value"
+  masked  = true
+  hidden  = true
+}
+`, ctx.project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_variable.this", "hidden", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_variable.this", "value", "my-hidden-value"),
+					func(s *terraform.State) error {
+						variable, _, err := testGitlabClient.ProjectVariables.GetVariable(ctx.project.ID, "my_key", nil)
+						if err != nil {
+							return err
+						}
+						if !variable.Hidden {
+							return fmt.Errorf("expected project variable to be hidden")
+						}
+						return nil
+					},
+				),
+			},
+			// Update the value of the hidden variable
+			{
+				SkipFunc: isGitLabVersionLessThan(context.Background(), testGitlabClient, "17.4"),
+				Config: fmt.Sprintf(`
+resource "gitlab_project_variable" "this" {
+  project = %d
+  key     = "my_key"
+  value   = "my-updated-hidden-value"
+  masked  = true
+  hidden  = true
+}
+`, ctx.project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_variable.this", "hidden", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_variable.this", "value", "my-updated-hidden-value"),
+				),
+			},
+			// Verify import, the value of hidden variables can't be imported
+			{
+				SkipFunc:                isGitLabVersionLessThan(context.Background(), testGitlabClient, "17.4"),
+				ResourceName:            "gitlab_project_variable.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+		},
+	})
+}
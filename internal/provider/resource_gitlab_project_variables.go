@@ -0,0 +1,373 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// gitlabProjectVariablesParallelism is the maximum number of concurrent API requests
+// made to reconcile the variables of a `gitlab_project_variables` resource.
+const gitlabProjectVariablesParallelism = 10
+
+var _ = registerResource("gitlab_project_variables", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_variables`" + ` resource allows to manage the lifecycle of multiple CI/CD variables of a project in a single environment scope.
+
+Compared to one ` + "`gitlab_project_variable`" + ` resource per variable, the variables are reconciled with concurrent API requests, which considerably speeds up applying many variables.
+
+-> This resource only manages the variables configured in ` + "`variables`" + `. Other variables of the project are left untouched, unless they are imported.
+
+~> Do not manage the same variables with both this resource and the ` + "`gitlab_project_variable`" + ` resource.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/project_level_variables.html)`,
+
+		CreateContext: resourceGitlabProjectVariablesCreate,
+		ReadContext:   resourceGitlabProjectVariablesRead,
+		UpdateContext: resourceGitlabProjectVariablesUpdate,
+		DeleteContext: resourceGitlabProjectVariablesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGitlabProjectVariablesImport,
+		},
+		CustomizeDiff: resourceGitlabProjectVariablesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The name or id of the project.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"environment_scope": {
+				Description: "The environment scope of the variables. Defaults to all environment (`*`).",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "*",
+				ForceNew:    true,
+			},
+			"variables": {
+				Description: "The variables to manage, as a map of the variable name to its value.",
+				Type:        schema.TypeMap,
+				Required:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: func(i interface{}, p cty.Path) diag.Diagnostics {
+					var diags diag.Diagnostics
+					for key := range i.(map[string]interface{}) {
+						_, errs := StringIsGitlabVariableName(key, "variables")
+						for _, err := range errs {
+							diags = append(diags, diag.Diagnostic{
+								Severity:      diag.Error,
+								Summary:       err.Error(),
+								AttributePath: p,
+							})
+						}
+					}
+					return diags
+				},
+			},
+			"variable_type": {
+				Description:      fmt.Sprintf("The type of all variables. Valid values are: %s. Default is `env_var`.", renderValueListForDocs(gitlabVariableTypeValues)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "env_var",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(gitlabVariableTypeValues, false)),
+			},
+			"protected": {
+				Description: "If set to `true`, the variables will be passed only to pipelines running on protected branches and tags. Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"masked": {
+				Description: "If set to `true`, the values of the variables will be hidden in job logs. All values must meet the [masking requirements](https://docs.gitlab.com/ee/ci/variables/#masked-variables). Defaults to `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+})
+
+func resourceGitlabProjectVariablesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	environmentScope := d.Get("environment_scope").(string)
+
+	log.Printf("[DEBUG] create gitlab project variables for project %s in environment scope %s", project, environmentScope)
+
+	d.SetId(buildTwoPartID(&project, &environmentScope))
+	if diags := resourceGitlabProjectVariablesReconcile(ctx, client, d, map[string]interface{}{}); diags.HasError() {
+		return diags
+	}
+
+	return resourceGitlabProjectVariablesRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectVariablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project, environmentScope, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read gitlab project variables for project %s in environment scope %s", project, environmentScope)
+
+	variables, err := resourceGitlabProjectVariablesList(ctx, client, project, environmentScope)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab project %s not found, removing project variables from state", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	managed := d.Get("variables").(map[string]interface{})
+	values := make(map[string]interface{}, len(managed))
+	for _, variable := range variables {
+		if _, ok := managed[variable.Key]; !ok {
+			continue
+		}
+		values[variable.Key] = variable.Value
+
+		// NOTE: the attributes are shared by all variables, thus a single deviating variable
+		//       is enough to produce a diff, which reconciles all variables again.
+		if string(variable.VariableType) != d.Get("variable_type").(string) {
+			d.Set("variable_type", variable.VariableType)
+		}
+		if variable.Protected != d.Get("protected").(bool) {
+			d.Set("protected", variable.Protected)
+		}
+		if variable.Masked != d.Get("masked").(bool) {
+			d.Set("masked", variable.Masked)
+		}
+	}
+
+	d.Set("project", project)
+	d.Set("environment_scope", environmentScope)
+	if err := d.Set("variables", values); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabProjectVariablesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	log.Printf("[DEBUG] update gitlab project variables %s", d.Id())
+
+	old, _ := d.GetChange("variables")
+	if diags := resourceGitlabProjectVariablesReconcile(ctx, client, d, old.(map[string]interface{})); diags.HasError() {
+		return diags
+	}
+
+	return resourceGitlabProjectVariablesRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectVariablesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	project := d.Get("project").(string)
+	environmentScope := d.Get("environment_scope").(string)
+
+	log.Printf("[DEBUG] delete gitlab project variables %s", d.Id())
+
+	var tasks []func() error
+	for key := range d.Get("variables").(map[string]interface{}) {
+		key := key
+		tasks = append(tasks, func() error {
+			_, err := client.ProjectVariables.RemoveVariable(project, key, nil, withEnvironmentScopeFilter(ctx, environmentScope))
+			if err != nil && !is404(err) {
+				return fmt.Errorf("failed to delete project variable %q: %w", key, err)
+			}
+			return nil
+		})
+	}
+
+	return resourceGitlabProjectVariablesRunConcurrently(tasks)
+}
+
+func resourceGitlabProjectVariablesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*gitlab.Client)
+	project, environmentScope, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	variables, err := resourceGitlabProjectVariablesList(ctx, client, project, environmentScope)
+	if err != nil {
+		return nil, err
+	}
+	if len(variables) == 0 {
+		return nil, fmt.Errorf("project %s has no variables in environment scope %q", project, environmentScope)
+	}
+
+	// NOTE: when importing, all variables of the environment scope are managed by the resource.
+	values := make(map[string]interface{}, len(variables))
+	for _, variable := range variables {
+		values[variable.Key] = variable.Value
+	}
+	d.Set("variable_type", variables[0].VariableType)
+	d.Set("protected", variables[0].Protected)
+	d.Set("masked", variables[0].Masked)
+	if err := d.Set("variables", values); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceGitlabProjectVariablesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("masked").(bool) || !d.NewValueKnown("variables") {
+		return nil
+	}
+
+	var keys []string
+	for key, value := range d.Get("variables").(map[string]interface{}) {
+		if !maskedVariableValueRegexp.MatchString(value.(string)) {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) > 0 {
+		sort.Strings(keys)
+		return fmt.Errorf("%s (variables: %v)", maskedVariableRequirementsErrorMessage, keys)
+	}
+	return nil
+}
+
+// resourceGitlabProjectVariablesReconcile creates, updates and deletes the project variables concurrently,
+// so that the variables in GitLab match the configured ones, given the previously managed variables.
+func resourceGitlabProjectVariablesReconcile(ctx context.Context, client *gitlab.Client, d *schema.ResourceData, old map[string]interface{}) diag.Diagnostics {
+	project := d.Get("project").(string)
+	environmentScope := d.Get("environment_scope").(string)
+	variableType := stringToVariableType(d.Get("variable_type").(string))
+	protected := d.Get("protected").(bool)
+	masked := d.Get("masked").(bool)
+	attributesChanged := d.HasChanges("variable_type", "protected", "masked")
+
+	var tasks []func() error
+	for key, value := range d.Get("variables").(map[string]interface{}) {
+		key := key
+		value := value.(string)
+
+		oldValue, exists := old[key]
+		switch {
+		case !exists:
+			tasks = append(tasks, func() error {
+				options := &gitlab.CreateProjectVariableOptions{
+					Key:              gitlab.String(key),
+					Value:            gitlab.String(value),
+					VariableType:     variableType,
+					Protected:        gitlab.Bool(protected),
+					Masked:           gitlab.Bool(masked),
+					EnvironmentScope: gitlab.String(environmentScope),
+				}
+				if _, _, err := client.ProjectVariables.CreateVariable(project, options, gitlab.WithContext(ctx)); err != nil {
+					return fmt.Errorf("failed to create project variable %q: %w", key, augmentProjectVariablesClientError(masked, err))
+				}
+				return nil
+			})
+		case oldValue.(string) != value || attributesChanged:
+			tasks = append(tasks, func() error {
+				options := &gitlab.UpdateProjectVariableOptions{
+					Value:            gitlab.String(value),
+					VariableType:     variableType,
+					Protected:        gitlab.Bool(protected),
+					Masked:           gitlab.Bool(masked),
+					EnvironmentScope: gitlab.String(environmentScope),
+				}
+				if _, _, err := client.ProjectVariables.UpdateVariable(project, key, options, withEnvironmentScopeFilter(ctx, environmentScope)); err != nil {
+					return fmt.Errorf("failed to update project variable %q: %w", key, augmentProjectVariablesClientError(masked, err))
+				}
+				return nil
+			})
+		}
+	}
+
+	for key := range old {
+		key := key
+		if _, ok := d.Get("variables").(map[string]interface{})[key]; ok {
+			continue
+		}
+		tasks = append(tasks, func() error {
+			_, err := client.ProjectVariables.RemoveVariable(project, key, nil, withEnvironmentScopeFilter(ctx, environmentScope))
+			if err != nil && !is404(err) {
+				return fmt.Errorf("failed to delete project variable %q: %w", key, err)
+			}
+			return nil
+		})
+	}
+
+	return resourceGitlabProjectVariablesRunConcurrently(tasks)
+}
+
+// resourceGitlabProjectVariablesRunConcurrently runs the given tasks with at most
+// gitlabProjectVariablesParallelism tasks at the same time and collects all their errors.
+func resourceGitlabProjectVariablesRunConcurrently(tasks []func() error) diag.Diagnostics {
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		diags diag.Diagnostics
+	)
+
+	semaphore := make(chan struct{}, gitlabProjectVariablesParallelism)
+	for _, task := range tasks {
+		task := task
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := task(); err != nil {
+				mu.Lock()
+				diags = append(diags, diag.FromErr(err)...)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return diags
+}
+
+// resourceGitlabProjectVariablesList lists all variables of the project in the given environment scope.
+func resourceGitlabProjectVariablesList(ctx context.Context, client *gitlab.Client, project, environmentScope string) ([]*gitlab.ProjectVariable, error) {
+	options := &gitlab.ListProjectVariablesOptions{
+		Page:    1,
+		PerPage: 100,
+	}
+
+	var variables []*gitlab.ProjectVariable
+	for options.Page != 0 {
+		paginatedVariables, resp, err := client.ProjectVariables.ListVariables(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, variable := range paginatedVariables {
+			if variable.EnvironmentScope == environmentScope {
+				variables = append(variables, variable)
+			}
+		}
+		options.Page = resp.NextPage
+	}
+	return variables, nil
+}
+
+func augmentProjectVariablesClientError(masked bool, err error) error {
+	if masked && isInvalidValueError(err) {
+		log.Printf("[ERROR] %v", err)
+		return errors.New(maskedVariableRequirementsErrorMessage)
+	}
+	return err
+}
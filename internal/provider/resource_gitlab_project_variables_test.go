@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabProjectVariables_basic(t *testing.T) {
+	ctx := testAccGitlabProjectStart(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccGitlabProjectVariableCheckAllVariablesDestroyed(ctx),
+		Steps: []resource.TestStep{
+			// Masked values are validated during plan
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_variables" "this" {
+  project = %d
+  masked  = true
+  variables = {
+    VALID   = "valid-value"
+    INVALID = "short"
+  }
+}
+`, ctx.project.ID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`variables: \[INVALID\]`),
+			},
+			// Create multiple variables
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_variables" "this" {
+  project = %d
+  variables = {
+    FOO = "foo"
+    BAR = "bar"
+    BAZ = "baz"
+  }
+}
+`, ctx.project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_variables.this", "variables.%", "3"),
+					testAccCheckGitlabProjectVariablesInGitLab(ctx, map[string]string{"FOO": "foo", "BAR": "bar", "BAZ": "baz"}, false),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_variables.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update, add and remove variables at once
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_variables" "this" {
+  project = %d
+  variables = {
+    FOO = "updated-foo"
+    BAR = "bar"
+    QUX = "qux"
+  }
+}
+`, ctx.project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_variables.this", "variables.%", "3"),
+					testAccCheckGitlabProjectVariablesInGitLab(ctx, map[string]string{"FOO": "updated-foo", "BAR": "bar", "QUX": "qux"}, false),
+				),
+			},
+			// Update the shared attributes of all variables
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_variables" "this" {
+  project   = %d
+  protected = true
+  variables = {
+    FOO = "updated-foo"
+    BAR = "bar"
+    QUX = "qux"
+  }
+}
+`, ctx.project.ID),
+				Check: testAccCheckGitlabProjectVariablesInGitLab(ctx, map[string]string{"FOO": "updated-foo", "BAR": "bar", "QUX": "qux"}, true),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectVariablesInGitLab(ctx testAccGitlabProjectContext, expected map[string]string, protected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		variables, _, err := testGitlabClient.ProjectVariables.ListVariables(ctx.project.ID, nil)
+		if err != nil {
+			return err
+		}
+
+		if len(variables) != len(expected) {
+			return fmt.Errorf("expected %d project variables, but found %d", len(expected), len(variables))
+		}
+		for _, variable := range variables {
+			value, ok := expected[variable.Key]
+			if !ok {
+				return fmt.Errorf("unexpected project variable %q", variable.Key)
+			}
+			if variable.Value != value {
+				return fmt.Errorf("expected value %q for project variable %q, but got %q", value, variable.Key, variable.Value)
+			}
+			if variable.Protected != protected {
+				return fmt.Errorf("expected project variable %q to have protected=%t", variable.Key, protected)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_service_account", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_service_account`" + ` resource allows to manage the lifecycle of an instance-level service account.
+
+-> This resource requires administration privileges and GitLab Premium on a self-managed instance.
+
+-> Use the ` + "`gitlab_personal_access_token`" + ` resource with the ` + "`id`" + ` of the service account as ` + "`user_id`" + ` to manage its tokens.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/user_service_accounts.html)`,
+
+		CreateContext: resourceGitlabServiceAccountCreate,
+		ReadContext:   resourceGitlabServiceAccountRead,
+		UpdateContext: resourceGitlabServiceAccountUpdate,
+		DeleteContext: resourceGitlabServiceAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the service account. Defaults to a name generated by GitLab.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"username": {
+				Description: "The username of the service account. Defaults to a username generated by GitLab.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabServiceAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+
+	options := &gitlab.CreateServiceAccountUserOptions{}
+	if v, ok := d.GetOk("name"); ok {
+		options.Name = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("username"); ok {
+		options.Username = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create gitlab service account")
+
+	user, _, err := client.Users.CreateServiceAccountUser(options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", user.ID))
+	return resourceGitlabServiceAccountRead(ctx, d, meta)
+}
+
+func resourceGitlabServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read gitlab service account %d", id)
+
+	user, _, err := client.Users.GetUser(id, gitlab.GetUsersOptions{}, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab service account %d not found, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if !user.Bot {
+		return diag.Errorf("gitlab user %d is not a service account", id)
+	}
+
+	d.Set("name", user.Name)
+	d.Set("username", user.Username)
+	return nil
+}
+
+func resourceGitlabServiceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &gitlab.ModifyUserOptions{}
+	if d.HasChange("name") {
+		options.Name = gitlab.String(d.Get("name").(string))
+	}
+	if d.HasChange("username") {
+		options.Username = gitlab.String(d.Get("username").(string))
+	}
+
+	log.Printf("[DEBUG] update gitlab service account %d", id)
+
+	if _, _, err := client.Users.ModifyUser(id, options, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitlabServiceAccountRead(ctx, d, meta)
+}
+
+func resourceGitlabServiceAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*gitlab.Client)
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete gitlab service account %d", id)
+
+	if _, err := client.Users.DeleteUser(id, gitlab.WithContext(ctx)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Deleting a user in gitlab is async.
+	stateConf := &resource.StateChangeConf{
+		Timeout: 5 * time.Minute,
+		Target:  []string{"Deleted"},
+		Refresh: func() (interface{}, string, error) {
+			user, resp, err := client.Users.GetUser(id, gitlab.GetUsersOptions{}, gitlab.WithContext(ctx))
+			if resp != nil && resp.StatusCode == 404 {
+				return user, "Deleted", nil
+			}
+			if err != nil {
+				return user, "Error", err
+			}
+			return user, "Deleting", nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("could not finish deleting service account %d: %s", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabServiceAccount_basic(t *testing.T) {
+	testAccCheck(t)
+	testAccCheckEE(t)
+
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			// Create a service account with generated name and username
+			{
+				Config: `resource "gitlab_service_account" "this" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_service_account.this", "name"),
+					resource.TestCheckResourceAttrSet("gitlab_service_account.this", "username"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_service_account.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Customize the name and username, and create a token
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_service_account" "this" {
+						name     = "CI bot %[1]d"
+						username = "ci-bot-%[1]d"
+					}
+
+					resource "gitlab_personal_access_token" "this" {
+						user_id    = gitlab_service_account.this.id
+						name       = "ci"
+						expires_at = %[2]q
+						scopes     = ["api"]
+					}
+				`, rInt, time.Now().Add(time.Hour*48).Format("2006-01-02")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_account.this", "name", fmt.Sprintf("CI bot %d", rInt)),
+					resource.TestCheckResourceAttr("gitlab_service_account.this", "username", fmt.Sprintf("ci-bot-%d", rInt)),
+					resource.TestCheckResourceAttrSet("gitlab_personal_access_token.this", "token"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_service_account.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabServiceAccountDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_service_account" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = testGitlabClient.Users.GetUser(id, gitlab.GetUsersOptions{})
+		if err == nil {
+			return fmt.Errorf("service account %d still exists", id)
+		}
+		if !is404(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -99,7 +99,7 @@ func resourceGitlabServiceGithubCreate(ctx context.Context, d *schema.ResourceDa
 		StaticContext: gitlab.Bool(d.Get("static_context").(bool)),
 	}
 
-	_, err := client.Services.SetGithubService(project, opts, gitlab.WithContext(ctx))
+	_, _, err := client.Services.SetGithubService(project, opts, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
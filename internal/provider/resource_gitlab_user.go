@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"time"
 
@@ -18,6 +19,7 @@ var validUserStateValues = []string{
 	"active",
 	"deactivated",
 	"blocked",
+	"banned",
 }
 
 var _ = registerResource("gitlab_user", func() *schema.Resource {
@@ -99,10 +101,30 @@ var _ = registerResource("gitlab_user", func() *schema.Resource {
 				ForceNew:    true,
 			},
 			"note": {
-				Description: "The note associated to the user.",
+				Description: "The note associated to the user. It's only visible to admins and may be used to annotate the user for auditors.",
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"identities": {
+				Description: "The external identities of the user, e.g. to link the user to an SSO identity provider. Identities which are not configured are not removed if this attribute is omitted entirely.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider": {
+							Description: "The name of the external identity provider, e.g. `saml`, `ldapmain` or `github`.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"extern_uid": {
+							Description: "The external UID of the user at the identity provider.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
 			"state": {
 				Description:      fmt.Sprintf("String, defaults to 'active'. The state of the user account. Valid values are %s.", renderValueListForDocs(validUserStateValues)),
 				Type:             schema.TypeString,
@@ -131,6 +153,7 @@ func resourceGitlabUserSetToState(d *schema.ResourceData, user *gitlab.User) {
 	d.Set("note", user.Note)
 	d.Set("state", user.State)
 	d.Set("namespace_id", user.NamespaceID)
+	d.Set("identities", flattenGitlabUserIdentities(user.Identities))
 }
 
 func resourceGitlabUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -162,6 +185,13 @@ func resourceGitlabUserCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprintf("%d", user.ID))
 
+	// NOTE: the create API only supports a single identity, thus we add all of them afterwards.
+	if v, ok := d.GetOk("identities"); ok {
+		if err := resourceGitlabUserSetIdentities(ctx, client, user.ID, expandGitlabUserIdentities(v.(*schema.Set).List())); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.Get("state") == "blocked" {
 		err := client.Users.BlockUser(user.ID, gitlab.WithContext(ctx))
 
@@ -171,6 +201,12 @@ func resourceGitlabUserCreate(ctx context.Context, d *schema.ResourceData, meta
 	} else if d.Get("state") == "deactivated" {
 		err := client.Users.DeactivateUser(user.ID, gitlab.WithContext(ctx))
 
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if d.Get("state") == "banned" {
+		err := client.Users.BanUser(user.ID, gitlab.WithContext(ctx))
+
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -246,6 +282,25 @@ func resourceGitlabUserUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("identities") {
+		oldIdentities, newIdentities := d.GetChange("identities")
+		wantIdentities := expandGitlabUserIdentities(newIdentities.(*schema.Set).List())
+
+		for _, identity := range expandGitlabUserIdentities(oldIdentities.(*schema.Set).List()) {
+			if _, ok := wantIdentities[identity.Provider]; ok {
+				// NOTE: the identity for this provider will be updated below.
+				continue
+			}
+			if err := resourceGitlabUserDeleteIdentity(ctx, client, id, identity.Provider); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if err := resourceGitlabUserSetIdentities(ctx, client, id, wantIdentities); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("state") {
 		oldState, newState := d.GetChange("state")
 		var err error
@@ -270,6 +325,37 @@ func resourceGitlabUserUpdate(ctx context.Context, d *schema.ResourceData, meta
 				return diag.FromErr(err)
 			}
 			err = client.Users.DeactivateUser(id, gitlab.WithContext(ctx))
+		} else if newState == "banned" && oldState == "active" {
+			err = client.Users.BanUser(id, gitlab.WithContext(ctx))
+		} else if newState == "banned" && oldState == "blocked" {
+			// only active users can be banned, thus we have to unblock the user first
+			err = client.Users.UnblockUser(id, gitlab.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			err = client.Users.BanUser(id, gitlab.WithContext(ctx))
+		} else if newState == "banned" && oldState == "deactivated" {
+			// only active users can be banned, thus we have to activate the user first
+			err = client.Users.ActivateUser(id, gitlab.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			err = client.Users.BanUser(id, gitlab.WithContext(ctx))
+		} else if newState == "active" && oldState == "banned" {
+			err = client.Users.UnbanUser(id, gitlab.WithContext(ctx))
+		} else if newState == "blocked" && oldState == "banned" {
+			// a banned user is technically blocked, thus it has to be unbanned before it can be blocked
+			err = client.Users.UnbanUser(id, gitlab.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			err = client.Users.BlockUser(id, gitlab.WithContext(ctx))
+		} else if newState == "deactivated" && oldState == "banned" {
+			err = client.Users.UnbanUser(id, gitlab.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			err = client.Users.DeactivateUser(id, gitlab.WithContext(ctx))
 		}
 
 		if err != nil {
@@ -311,3 +397,59 @@ func resourceGitlabUserDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	return nil
 }
+
+func expandGitlabUserIdentities(identities []interface{}) map[string]*gitlab.UserIdentity {
+	result := make(map[string]*gitlab.UserIdentity, len(identities))
+	for _, v := range identities {
+		identity := v.(map[string]interface{})
+		provider := identity["provider"].(string)
+		result[provider] = &gitlab.UserIdentity{
+			Provider:  provider,
+			ExternUID: identity["extern_uid"].(string),
+		}
+	}
+	return result
+}
+
+func flattenGitlabUserIdentities(identities []*gitlab.UserIdentity) []interface{} {
+	result := make([]interface{}, 0, len(identities))
+	for _, identity := range identities {
+		result = append(result, map[string]interface{}{
+			"provider":   identity.Provider,
+			"extern_uid": identity.ExternUID,
+		})
+	}
+	return result
+}
+
+// resourceGitlabUserSetIdentities adds or updates the given identities of a user.
+// The modify user API upserts a single identity per call, keyed by its provider.
+func resourceGitlabUserSetIdentities(ctx context.Context, client *gitlab.Client, userID int, identities map[string]*gitlab.UserIdentity) error {
+	for _, identity := range identities {
+		log.Printf("[DEBUG] set identity %q for gitlab user %d", identity.Provider, userID)
+		options := &gitlab.ModifyUserOptions{
+			Provider:  gitlab.String(identity.Provider),
+			ExternUID: gitlab.String(identity.ExternUID),
+		}
+		if _, _, err := client.Users.ModifyUser(userID, options, gitlab.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to set identity %q for user %d: %w", identity.Provider, userID, err)
+		}
+	}
+	return nil
+}
+
+// resourceGitlabUserDeleteIdentity deletes the identity of the given provider from a user.
+// NOTE: the UsersService of go-gitlab can't delete the identities of a user.
+func resourceGitlabUserDeleteIdentity(ctx context.Context, client *gitlab.Client, userID int, provider string) error {
+	log.Printf("[DEBUG] delete identity %q from gitlab user %d", provider, userID)
+	u := fmt.Sprintf("users/%d/identities/%s", userID, gitlab.PathEscape(provider))
+	req, err := client.NewRequest(http.MethodDelete, u, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Do(req, nil); err != nil && !is404(err) {
+		return fmt.Errorf("failed to delete identity %q from user %d: %w", provider, userID, err)
+	}
+	return nil
+}
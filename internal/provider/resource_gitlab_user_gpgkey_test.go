@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+var testGPGPubKey string = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mDMEas9c1BYJKwYBBAHaRw8BAQdAGnhOrSA7DRj7KY/0M71cHDfvqVEi5j0yY15l
+jgGHbGO0LFRlcnJhZm9ybSBBY2NlcHRhbmNlIDx0ZXJyYWZvcm1AZXhhbXBsZS5j
+b20+iJAEExYIADgWIQRq9CMC1spEIEbySFvxO4H3+MfiuQUCas9c1AIbAwULCQgH
+AgYVCgkICwIEFgIDAQIeAQIXgAAKCRDxO4H3+MfiuQKWAP9KnMb0qZLoZGM9CCAc
+TYHACnDynBWdowFK3UdNLCZyNgD/bo2xY4MN1E7I9cUxLZTKKi6oif69J8RUgMd2
+G6eR8ww=
+=1MNa
+-----END PGP PUBLIC KEY BLOCK-----`
+
+var testGPGPubKeyUpdated string = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mDMEas9c1BYJKwYBBAHaRw8BAQdAvYoWIJDXXSVin4G6u+k3U/nc6aDGrDpreGjb
+5pDPz3m0L1RlcnJhZm9ybSBBY2NlcHRhbmNlIDIgPHRlcnJhZm9ybTJAZXhhbXBs
+ZS5jb20+iJAEExYIADgWIQTV8yRTemvWPs/aoh/wh4s0DAc8VwUCas9c1AIbAwUL
+CQgHAgYVCgkICwIEFgIDAQIeAQIXgAAKCRDwh4s0DAc8V5f/AP40UicOc7r1wiYf
+d7cdAU/YkR2kKV8hDphm+U8QtsRrdwEA5+shxzIriUKPkLwV5HUomP9WTcmyGKeq
+HgWSabv/dgs=
+=xIVR
+-----END PGP PUBLIC KEY BLOCK-----`
+
+func TestAccGitlabUserGPGKey_basic(t *testing.T) {
+	testAccCheck(t)
+
+	var key gitlab.GPGKey
+	testUser := testAccCreateUsers(t, 1)[0]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabUserGPGKeyDestroy,
+		Steps: []resource.TestStep{
+			// Create a gpg key for a specific user
+			{
+				Config: testAccGitlabUserGPGKeyConfig(testUser.ID, testGPGPubKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserGPGKeyExists("gitlab_user_gpgkey.foo_key", &key),
+					testAccCheckGitlabUserGPGKeyAttributes(&key, testGPGPubKey),
+					resource.TestCheckResourceAttr("gitlab_user_gpgkey.foo_key", "user_id", fmt.Sprintf("%d", testUser.ID)),
+				),
+			},
+			{
+				ResourceName:      "gitlab_user_gpgkey.foo_key",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Replace the key
+			{
+				Config: testAccGitlabUserGPGKeyConfig(testUser.ID, testGPGPubKeyUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserGPGKeyExists("gitlab_user_gpgkey.foo_key", &key),
+					testAccCheckGitlabUserGPGKeyAttributes(&key, testGPGPubKeyUpdated),
+				),
+			},
+			{
+				ResourceName:      "gitlab_user_gpgkey.foo_key",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Create a gpg key for the current user
+			{
+				Config: testAccGitlabUserGPGKeyCurrentUserConfig(testGPGPubKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserGPGKeyExists("gitlab_user_gpgkey.foo_key", &key),
+					testAccCheckGitlabUserGPGKeyAttributes(&key, testGPGPubKey),
+					resource.TestCheckNoResourceAttr("gitlab_user_gpgkey.foo_key", "user_id"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_user_gpgkey.foo_key",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGitlabUserGPGKeyGet(id string) (*gitlab.GPGKey, error) {
+	userID, keyID, err := resourceGitlabUserGPGKeyParseID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse user gpg key resource ID: %s", err)
+	}
+
+	var key *gitlab.GPGKey
+	if userID != 0 {
+		key, _, err = testGitlabClient.Users.GetGPGKeyForUser(userID, keyID)
+	} else {
+		key, _, err = testGitlabClient.Users.GetGPGKey(keyID)
+	}
+	return key, err
+}
+
+func testAccCheckGitlabUserGPGKeyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_user_gpgkey" {
+			continue
+		}
+
+		_, err := testAccGitlabUserGPGKeyGet(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("GPG Key still exists")
+		}
+		if !is404(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccCheckGitlabUserGPGKeyExists(n string, key *gitlab.GPGKey) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		gotKey, err := testAccGitlabUserGPGKeyGet(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*key = *gotKey
+		return nil
+	}
+}
+
+func testAccCheckGitlabUserGPGKeyAttributes(key *gitlab.GPGKey, wantKey string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if strings.TrimSpace(key.Key) != strings.TrimSpace(wantKey) {
+			return fmt.Errorf("got key %q; want %q", key.Key, wantKey)
+		}
+
+		return nil
+	}
+}
+
+func testAccGitlabUserGPGKeyConfig(userID int, pubKey string) string {
+	return fmt.Sprintf(`
+resource "gitlab_user_gpgkey" "foo_key" {
+  user_id = %d
+  key     = <<EOT
+%s
+EOT
+}
+  `, userID, pubKey)
+}
+
+func testAccGitlabUserGPGKeyCurrentUserConfig(pubKey string) string {
+	return fmt.Sprintf(`
+resource "gitlab_user_gpgkey" "foo_key" {
+  key = <<EOT
+%s
+EOT
+}
+  `, pubKey)
+}
@@ -320,6 +320,114 @@ func TestAccGitlabUser_basic(t *testing.T) {
 					}),
 				),
 			},
+			// Ban the user
+			{
+				Config: testAccGitlabUserConfigBanned(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserExists("gitlab_user.foo", &user),
+					testAccCheckGitlabUserAttributes(&user, &testAccGitlabUserExpectedAttributes{
+						Email:          fmt.Sprintf("listest%d@ssss.com", rInt),
+						Username:       fmt.Sprintf("listest%d", rInt),
+						Name:           fmt.Sprintf("foo %d", rInt),
+						NamespaceID:    user.NamespaceID,
+						ProjectsLimit:  0,
+						Admin:          false,
+						CanCreateGroup: false,
+						External:       false,
+						State:          "banned",
+					}),
+				),
+			},
+			// Block the user from banned state
+			{
+				Config: testAccGitlabUserConfigBlocked(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserExists("gitlab_user.foo", &user),
+					testAccCheckGitlabUserAttributes(&user, &testAccGitlabUserExpectedAttributes{
+						Email:          fmt.Sprintf("listest%d@ssss.com", rInt),
+						Username:       fmt.Sprintf("listest%d", rInt),
+						Name:           fmt.Sprintf("foo %d", rInt),
+						NamespaceID:    user.NamespaceID,
+						ProjectsLimit:  0,
+						Admin:          false,
+						CanCreateGroup: false,
+						External:       false,
+						State:          "blocked",
+					}),
+				),
+			},
+			// Ban the user from blocked state
+			{
+				Config: testAccGitlabUserConfigBanned(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserExists("gitlab_user.foo", &user),
+					testAccCheckGitlabUserAttributes(&user, &testAccGitlabUserExpectedAttributes{
+						Email:          fmt.Sprintf("listest%d@ssss.com", rInt),
+						Username:       fmt.Sprintf("listest%d", rInt),
+						Name:           fmt.Sprintf("foo %d", rInt),
+						NamespaceID:    user.NamespaceID,
+						ProjectsLimit:  0,
+						Admin:          false,
+						CanCreateGroup: false,
+						External:       false,
+						State:          "banned",
+					}),
+				),
+			},
+			// Deactivate the user from banned state
+			{
+				Config: testAccGitlabUserConfigDeactivated(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserExists("gitlab_user.foo", &user),
+					testAccCheckGitlabUserAttributes(&user, &testAccGitlabUserExpectedAttributes{
+						Email:          fmt.Sprintf("listest%d@ssss.com", rInt),
+						Username:       fmt.Sprintf("listest%d", rInt),
+						Name:           fmt.Sprintf("foo %d", rInt),
+						NamespaceID:    user.NamespaceID,
+						ProjectsLimit:  0,
+						Admin:          false,
+						CanCreateGroup: false,
+						External:       false,
+						State:          "deactivated",
+					}),
+				),
+			},
+			// Ban the user from deactivated state
+			{
+				Config: testAccGitlabUserConfigBanned(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserExists("gitlab_user.foo", &user),
+					testAccCheckGitlabUserAttributes(&user, &testAccGitlabUserExpectedAttributes{
+						Email:          fmt.Sprintf("listest%d@ssss.com", rInt),
+						Username:       fmt.Sprintf("listest%d", rInt),
+						Name:           fmt.Sprintf("foo %d", rInt),
+						NamespaceID:    user.NamespaceID,
+						ProjectsLimit:  0,
+						Admin:          false,
+						CanCreateGroup: false,
+						External:       false,
+						State:          "banned",
+					}),
+				),
+			},
+			// Unban the user
+			{
+				Config: testAccGitlabUserConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserExists("gitlab_user.foo", &user),
+					testAccCheckGitlabUserAttributes(&user, &testAccGitlabUserExpectedAttributes{
+						Email:          fmt.Sprintf("listest%d@ssss.com", rInt),
+						Username:       fmt.Sprintf("listest%d", rInt),
+						Name:           fmt.Sprintf("foo %d", rInt),
+						NamespaceID:    user.NamespaceID,
+						ProjectsLimit:  0,
+						Admin:          false,
+						CanCreateGroup: false,
+						External:       false,
+						State:          "active",
+					}),
+				),
+			},
 		},
 	})
 }
@@ -357,6 +465,75 @@ func TestAccGitlabUser_password_reset(t *testing.T) {
 	})
 }
 
+func TestAccGitlabUser_identities(t *testing.T) {
+	var user gitlab.User
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabUserDestroy,
+		Steps: []resource.TestStep{
+			// Create a user with an identity and a note
+			{
+				Config: testAccGitlabUserConfigIdentities(rInt, map[string]string{"github": fmt.Sprintf("gh-%d", rInt)}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserExists("gitlab_user.foo", &user),
+					resource.TestCheckResourceAttr("gitlab_user.foo", "note", "Managed by Terraform"),
+					resource.TestCheckResourceAttr("gitlab_user.foo", "identities.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("gitlab_user.foo", "identities.*", map[string]string{
+						"provider":   "github",
+						"extern_uid": fmt.Sprintf("gh-%d", rInt),
+					}),
+				),
+			},
+			{
+				ResourceName:            "gitlab_user.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "skip_confirmation"},
+			},
+			// Update the identity and add another one
+			{
+				Config: testAccGitlabUserConfigIdentities(rInt, map[string]string{
+					"github":    fmt.Sprintf("gh-updated-%d", rInt),
+					"bitbucket": fmt.Sprintf("bb-%d", rInt),
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserExists("gitlab_user.foo", &user),
+					resource.TestCheckResourceAttr("gitlab_user.foo", "identities.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("gitlab_user.foo", "identities.*", map[string]string{
+						"provider":   "github",
+						"extern_uid": fmt.Sprintf("gh-updated-%d", rInt),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("gitlab_user.foo", "identities.*", map[string]string{
+						"provider":   "bitbucket",
+						"extern_uid": fmt.Sprintf("bb-%d", rInt),
+					}),
+				),
+			},
+			// Remove an identity
+			{
+				Config: testAccGitlabUserConfigIdentities(rInt, map[string]string{"bitbucket": fmt.Sprintf("bb-%d", rInt)}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserExists("gitlab_user.foo", &user),
+					resource.TestCheckResourceAttr("gitlab_user.foo", "identities.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("gitlab_user.foo", "identities.*", map[string]string{
+						"provider":   "bitbucket",
+						"extern_uid": fmt.Sprintf("bb-%d", rInt),
+					}),
+				),
+			},
+			{
+				ResourceName:            "gitlab_user.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "skip_confirmation"},
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabUserExists(n string, user *gitlab.User) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -572,3 +749,42 @@ resource "gitlab_user" "foo" {
 }
   `, rInt, rInt, rInt, rInt)
 }
+
+func testAccGitlabUserConfigIdentities(rInt int, identities map[string]string) string {
+	var identityBlocks string
+	for provider, externUID := range identities {
+		identityBlocks += fmt.Sprintf(`
+  identities {
+    provider   = %q
+    extern_uid = %q
+  }
+`, provider, externUID)
+	}
+
+	return fmt.Sprintf(`
+resource "gitlab_user" "foo" {
+  name             = "foo %d"
+  username         = "listest%d"
+  password         = "test%dtt"
+  email            = "listest%d@ssss.com"
+  note             = "Managed by Terraform"
+%s
+}
+  `, rInt, rInt, rInt, rInt, identityBlocks)
+}
+
+func testAccGitlabUserConfigBanned(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_user" "foo" {
+  name             = "foo %d"
+  username         = "listest%d"
+  password         = "test%dtt"
+  email            = "listest%d@ssss.com"
+  is_admin         = false
+  projects_limit   = 0
+  can_create_group = false
+  is_external      = false
+  state            = "banned"
+}
+  `, rInt, rInt, rInt, rInt)
+}
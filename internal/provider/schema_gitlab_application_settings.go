@@ -0,0 +1,585 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// gitlabApplicationSettingsGetSchema returns the schema of the instance application settings.
+// The attribute names map 1:1 to the keys of the application settings API.
+// It's a selection of the settings, see the description of the resource for the settings which are left out.
+func gitlabApplicationSettingsGetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"signup_enabled": {
+			Description: "Enable registration.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"require_admin_approval_after_user_signup": {
+			Description: "When enabled, any user that signs up for an account using the registration form is placed under a Pending approval state and has to be explicitly approved by an administrator.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"email_confirmation_setting": {
+			Description: "Specifies whether users must confirm their email before sign in. Possible values are `off`, `soft`, and `hard`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"domain_allowlist": {
+			Description: "Force people to use only corporate emails for sign-up. Null means there is no restriction.",
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Computed:    true,
+		},
+		"domain_denylist_enabled": {
+			Description: "Enables blocking of registrations from specific domains (see `domain_denylist`).",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"domain_denylist": {
+			Description: "Users with email addresses that match these domain(s) cannot sign up. Wildcards allowed. Use separate lines for multiple entries. Ex: domain.com, *.domain.com.",
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Computed:    true,
+		},
+		"email_restrictions_enabled": {
+			Description: "Enable restriction for sign-up by email.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"email_restrictions": {
+			Description: "Regular expression that is checked against the email used during registration.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"minimum_password_length": {
+			Description: "Indicates whether passwords require a minimum length.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"password_number_required": {
+			Description: "Indicates whether passwords require at least one number. Premium and Ultimate only.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"password_symbol_required": {
+			Description: "Indicates whether passwords require at least one symbol character. Premium and Ultimate only.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"password_uppercase_required": {
+			Description: "Indicates whether passwords require at least one uppercase letter. Premium and Ultimate only.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"password_lowercase_required": {
+			Description: "Indicates whether passwords require at least one lowercase letter. Premium and Ultimate only.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"new_user_signups_cap": {
+			Description: "Maximum number of new users allowed to sign up, after which any new sign ups require administrator approval.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"user_default_external": {
+			Description: "Newly registered users are external by default.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"user_default_internal_regex": {
+			Description: "Specify an email address regex pattern to identify default internal users.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"user_oauth_applications": {
+			Description: "Allow users to register any application to use GitLab as an OAuth provider.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"after_sign_up_text": {
+			Description: "Text shown to the user after signing up.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"password_authentication_enabled_for_web": {
+			Description: "Enable authentication for the web interface via a GitLab account password.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"password_authentication_enabled_for_git": {
+			Description: "Enable authentication for Git over HTTP(S) via a GitLab account password.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"require_two_factor_authentication": {
+			Description: "Require all users to set up two-factor authentication.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"two_factor_grace_period": {
+			Description: "Amount of time (in hours) that users are allowed to skip forced configuration of two-factor authentication.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"admin_mode": {
+			Description: "Require administrators to enable Admin Mode by re-authenticating for administrative tasks.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"disabled_oauth_sign_in_sources": {
+			Description: "Disabled OAuth sign-in sources.",
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Computed:    true,
+		},
+		"session_expire_delay": {
+			Description: "Session duration in minutes. GitLab restart is required to apply changes.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"home_page_url": {
+			Description: "Redirect to this URL when not logged in.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"after_sign_out_path": {
+			Description: "Where to redirect users after logout.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"help_page_text": {
+			Description: "Custom text displayed on the help page.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"help_page_support_url": {
+			Description: "Alternate support URL for help page and help dropdown list.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"enforce_terms": {
+			Description: "Enforce application ToS to all users.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"terms": {
+			Description: "Markdown content for the ToS. Required when `enforce_terms` is enabled.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"allow_local_requests_from_web_hooks_and_services": {
+			Description: "Allow requests to the local network from webhooks and integrations.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"allow_local_requests_from_system_hooks": {
+			Description: "Allow requests to the local network from system hooks.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"outbound_local_requests_whitelist": {
+			Description: "Define a list of trusted domains or IP addresses to which local requests are allowed when local requests for webhooks and integrations are disabled.",
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Computed:    true,
+		},
+		"dns_rebinding_protection_enabled": {
+			Description: "Enforce DNS-rebinding attack protection.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"deny_all_requests_except_allowed": {
+			Description: "Indicates whether to deny all requests by default. Requires GitLab 15.10.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_authenticated_api_enabled": {
+			Description: "Enable authenticated API request rate limit. Helps reduce request volume (for example, from crawlers or abusive bots).",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_authenticated_api_period_in_seconds": {
+			Description: "Rate limit period (in seconds).",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_authenticated_api_requests_per_period": {
+			Description: "Maximum requests per period per user.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_authenticated_web_enabled": {
+			Description: "Enable authenticated web request rate limit. Helps reduce request volume (for example, from crawlers or abusive bots).",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_authenticated_web_period_in_seconds": {
+			Description: "Rate limit period (in seconds).",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_authenticated_web_requests_per_period": {
+			Description: "Maximum requests per period per user.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_unauthenticated_api_enabled": {
+			Description: "Enable unauthenticated API request rate limit. Helps reduce request volume (for example, from crawlers or abusive bots).",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_unauthenticated_api_period_in_seconds": {
+			Description: "Rate limit period in seconds.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_unauthenticated_api_requests_per_period": {
+			Description: "Max requests per period per IP.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_unauthenticated_web_enabled": {
+			Description: "Enable unauthenticated web request rate limit. Helps reduce request volume (for example, from crawlers or abusive bots).",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_unauthenticated_web_period_in_seconds": {
+			Description: "Rate limit period in seconds.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_unauthenticated_web_requests_per_period": {
+			Description: "Max requests per period per IP.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_protected_paths_enabled": {
+			Description: "Enable protected paths rate limit.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_protected_paths_period_in_seconds": {
+			Description: "Rate limit period (in seconds).",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"throttle_protected_paths_requests_per_period": {
+			Description: "Maximum requests per period per user.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"protected_paths": {
+			Description: "List of paths to protect against rate limiting.",
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Computed:    true,
+		},
+		"issues_create_limit": {
+			Description: "Max number of issue creation requests per minute per user.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"notes_create_limit": {
+			Description: "Max number of note creation requests per minute per user.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"raw_blob_request_limit": {
+			Description: "Max number of requests per minute for each raw path. To disable throttling set to 0.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"search_rate_limit": {
+			Description: "Max number of requests per minute for performing a search while authenticated.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"search_rate_limit_unauthenticated": {
+			Description: "Max number of requests per minute for performing a search while unauthenticated.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"users_get_by_id_limit": {
+			Description: "Max number of calls to the `/api/v4/users/:id` API endpoint per user in 10 minutes. To disable throttling set to 0.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"project_export_limit": {
+			Description: "Maximum number of project export requests per minute.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"project_import_limit": {
+			Description: "Maximum number of project import requests per minute.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"group_export_limit": {
+			Description: "Maximum number of group export requests per minute.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"group_import_limit": {
+			Description: "Maximum number of group import requests per minute.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"default_branch_name": {
+			Description: "Instance-level custom initial branch name.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"default_project_visibility": {
+			Description: "What visibility level new projects receive. Can take `private`, `internal` and `public` as a parameter.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"default_group_visibility": {
+			Description: "What visibility level new groups receive. Can take `private`, `internal` and `public` as a parameter.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"default_snippet_visibility": {
+			Description: "What visibility level new snippets receive. Can take `private`, `internal` and `public` as a parameter.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"restricted_visibility_levels": {
+			Description: "Selected levels cannot be used by non-Administrator users for groups, projects or snippets. Can take `private`, `internal` and `public` as a parameter.",
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Computed:    true,
+		},
+		"default_project_creation": {
+			Description: "Default project creation protection. Can take: `0` (No one), `1` (Maintainers) or `2` (Developers + Maintainers).",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"default_projects_limit": {
+			Description: "Project limit per user.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"default_project_deletion_protection": {
+			Description: "Enable default project deletion protection so only administrators can delete projects. Premium and Ultimate only.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"deletion_adjourned_period": {
+			Description: "The number of days to wait before deleting a project or group that is marked for deletion.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"import_sources": {
+			Description: "Sources to allow project import from, possible values: `github`, `bitbucket`, `bitbucket_server`, `fogbugz`, `git`, `gitlab_project`, `gitea`, and `manifest`.",
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Computed:    true,
+		},
+		"project_export_enabled": {
+			Description: "Enable project export.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"max_attachment_size": {
+			Description: "Limit attachment size in MB.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"max_import_size": {
+			Description: "Maximum import size in MB. 0 for unlimited.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"max_export_size": {
+			Description: "Maximum export size in MB. 0 for unlimited.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"mirror_available": {
+			Description: "Allow repository mirroring to configured by project Maintainers. If disabled, only Administrators can configure repository mirroring.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"gravatar_enabled": {
+			Description: "Enable Gravatar.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"housekeeping_enabled": {
+			Description: "Enable or disable Git housekeeping.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"usage_ping_enabled": {
+			Description: "Every week GitLab reports license usage back to GitLab, Inc.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"auto_devops_enabled": {
+			Description: "Enable Auto DevOps for projects by default. It automatically builds, tests, and deploys applications based on a predefined CI/CD configuration.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"auto_devops_domain": {
+			Description: "Specify a domain to use by default for every project's Auto Review Apps and Auto Deploy stages.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"default_ci_config_path": {
+			Description: "Default CI/CD configuration file and path for new projects (`.gitlab-ci.yml` if not set).",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"default_artifacts_expire_in": {
+			Description: "Set the default expiration time for each job's artifacts.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"max_artifacts_size": {
+			Description: "Maximum artifacts size in MB.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"keep_latest_artifact": {
+			Description: "Prevent the deletion of the artifacts from the most recent successful jobs, regardless of the expiry time.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"shared_runners_enabled": {
+			Description: "Enable shared runners for new projects.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"shared_runners_text": {
+			Description: "Shared runners text.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"ci_max_includes": {
+			Description: "The maximum number of includes per pipeline.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"ci_max_total_yaml_size_bytes": {
+			Description: "The maximum amount of memory, in bytes, that can be allocated for the pipeline configuration, with all included YAML configuration files.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"pipeline_limit_per_project_user_sha": {
+			Description: "Maximum number of pipeline creation requests per minute per user and commit.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+		"valid_runner_registrars": {
+			Description: "List of types which are allowed to register a GitLab Runner. Can be `[]`, `['group']`, `['project']` or `['group', 'project']`.",
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Computed:    true,
+		},
+		"allow_runner_registration_token": {
+			Description: "Allow using a registration token to create a runner.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"container_registry_token_expire_delay": {
+			Description: "Container Registry token duration in minutes.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+		},
+	}
+}
@@ -42,6 +42,17 @@ func gitlabInstanceVariableGetSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Default:     false,
 		},
+		"raw": {
+			Description: "If set to `true`, the variable will be treated as a raw string and variable references in its value are not expanded. Defaults to `false`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"description": {
+			Description: "The description of the variable.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
 	}
 }
 
@@ -52,5 +63,7 @@ func gitlabInstanceVariableToStateMap(variable *gitlab.InstanceVariable) map[str
 	stateMap["variable_type"] = variable.VariableType
 	stateMap["protected"] = variable.Protected
 	stateMap["masked"] = variable.Masked
+	stateMap["raw"] = variable.Raw
+	stateMap["description"] = variable.Description
 	return stateMap
 }
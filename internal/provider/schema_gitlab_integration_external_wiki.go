@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func gitlabIntegrationExternalWikiGetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project": {
+			Description:  "ID of the project you want to activate integration on.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"external_wiki_url": {
+			Description:  "The URL of the external wiki.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+		"title": {
+			Description: "Title of the integration.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created_at": {
+			Description: "The ISO8601 date/time that this integration was activated at in UTC.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"updated_at": {
+			Description: "The ISO8601 date/time that this integration was last updated at in UTC.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"slug": {
+			Description: "The name of the integration in lowercase, shortened to 63 bytes, and with everything except 0-9 and a-z replaced with -. No leading / trailing -. Use in URLs, host names and domain names.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"active": {
+			Description: "Whether the integration is active.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func gitlabIntegrationJiraGetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project": {
+			Description: "ID of the project you want to activate integration on.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"title": {
+			Description: "Title.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created_at": {
+			Description: "Create time.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"updated_at": {
+			Description: "Update time.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"active": {
+			Description: "Whether the integration is active.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"url": {
+			Description:  "The URL to the JIRA project which is being linked to this GitLab project. For example, https://jira.example.com.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateURLFunc,
+		},
+		"api_url": {
+			Description:  "The base URL to the Jira instance API. Web URL value is used if not set. For example, https://jira-api.example.com.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateURLFunc,
+		},
+		"project_key": {
+			Description: "The short identifier for your JIRA project, all uppercase, e.g., PROJ.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+		},
+		"jira_auth_type": {
+			Description:  "The authentication method to use with Jira. `0` means Basic Authentication, which is used for Jira Cloud with an email and API token or for Jira Data Center and Server with a username and password. `1` means Jira personal access token, which is only available for Jira Data Center and Server. Defaults to `0`.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntInSlice([]int{0, 1}),
+		},
+		"username": {
+			Description: "The username of the user created to be used with GitLab/JIRA. For Jira Cloud, this is the email of the user. Required when using Basic Authentication (`jira_auth_type = 0`).",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"password": {
+			Description: "The password of the user created to be used with GitLab/JIRA. For Jira Cloud, this is an API token. When using a Jira personal access token (`jira_auth_type = 1`), this is the personal access token.",
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+		},
+		"jira_issue_transition_automatic": {
+			Description: "Enable automatic issue transitions. Takes precedence over `jira_issue_transition_id` if enabled.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"jira_issue_transition_id": {
+			Description: "The ID of one or more transitions that move issues to a closed state, separated by a comma or semicolon, e.g. `11,21`. You can find this number under the JIRA workflow administration (Administration > Issues > Workflows) by selecting View under Operations of the desired workflow of your project. By default, this ID is set to 2.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"jira_issue_prefix": {
+			Description: "Prefix to match Jira issue keys.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"jira_issue_regex": {
+			Description: "Regular expression to match Jira issue keys.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"push_events": {
+			Description: "Enable notifications for push events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"issues_events": {
+			Description: "Enable notifications for issues events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"commit_events": {
+			Description: "Enable notifications for commit events",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"merge_requests_events": {
+			Description: "Enable notifications for merge request events",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"tag_push_events": {
+			Description: "Enable notifications for tag_push events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"note_events": {
+			Description: "Enable notifications for note events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"pipeline_events": {
+			Description: "Enable notifications for pipeline events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"job_events": {
+			Description: "Enable notifications for job events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"comment_on_event_enabled": {
+			Description: "Enable comments inside Jira issues on each GitLab event (commit / merge request)",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+	}
+}
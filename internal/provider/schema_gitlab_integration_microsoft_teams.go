@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func gitlabIntegrationMicrosoftTeamsGetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project": {
+			Description: "ID of the project you want to activate integration on.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"created_at": {
+			Description: "Create time.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"updated_at": {
+			Description: "Update time.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"active": {
+			Description: "Whether the integration is active.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"webhook": {
+			Description:  "The Microsoft Teams webhook. For example, https://outlook.office.com/webhook/...",
+			Type:         schema.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validateURLFunc,
+		},
+		"notify_only_broken_pipelines": {
+			Description: "Send notifications for broken pipelines",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"branches_to_be_notified": {
+			Description:  fmt.Sprintf("Branches to send notifications for. Valid options are: %s. The default value is `default`.", renderValueListForDocs(validBranchesToBeNotified)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(validBranchesToBeNotified, false),
+		},
+		"push_events": {
+			Description: "Enable notifications for push events",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"issues_events": {
+			Description: "Enable notifications for issue events",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"confidential_issues_events": {
+			Description: "Enable notifications for confidential issue events",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"merge_requests_events": {
+			Description: "Enable notifications for merge request events",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"tag_push_events": {
+			Description: "Enable notifications for tag push events",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"note_events": {
+			Description: "Enable notifications for note events",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"confidential_note_events": {
+			Description: "Enable notifications for confidential note events",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"pipeline_events": {
+			Description: "Enable notifications for pipeline events",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"wiki_page_events": {
+			Description: "Enable notifications for wiki page events",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func gitlabIntegrationPipelinesEmailGetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project": {
+			Description: "ID of the project you want to activate integration on.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"recipients": {
+			Description: "Email addresses where notifications are sent.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"notify_only_broken_pipelines": {
+			Description: "Notify only broken pipelines. Default is true.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"branches_to_be_notified": {
+			Description:  fmt.Sprintf("Branches to send notifications for. Valid options are: %s. Default is `default`.", renderValueListForDocs(validBranchesToBeNotified)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(validBranchesToBeNotified, true),
+			Default:      "default",
+		},
+	}
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var validBranchesToBeNotified = []string{"all", "default", "protected", "default_and_protected"}
+
+func gitlabIntegrationSlackGetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project": {
+			Description: "ID of the project you want to activate integration on.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"webhook": {
+			Description: "Webhook URL (ex.: https://hooks.slack.com/services/...)",
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+		},
+		"username": {
+			Description: "Username to use.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"channel": {
+			Description: "Default channel to use if no other channel is configured.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"notify_only_broken_pipelines": {
+			Description: "Send notifications for broken pipelines.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"notify_only_default_branch": {
+			Description: "This parameter has been replaced with `branches_to_be_notified`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Deprecated:  "use 'branches_to_be_notified' argument instead",
+		},
+		"branches_to_be_notified": {
+			Description:  fmt.Sprintf("Branches to send notifications for. Valid options are: %s.", renderValueListForDocs(validBranchesToBeNotified)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(validBranchesToBeNotified, false),
+		},
+		"alert_channel": {
+			Description: "The name of the channel to receive alert events notifications.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"alert_events": {
+			Description: "Enable notifications for alert events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"confidential_issue_channel": {
+			Description: "The name of the channel to receive confidential issue events notifications.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"confidential_issues_events": {
+			Description: "Enable notifications for confidential issues events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"confidential_note_channel": {
+			Description: "The name of the channel to receive confidential note events notifications.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"confidential_note_events": {
+			Description: "Enable notifications for confidential note events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"deployment_channel": {
+			Description: "The name of the channel to receive deployment events notifications.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"deployment_events": {
+			Description: "Enable notifications for deployment events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"issue_channel": {
+			Description: "The name of the channel to receive issue events notifications.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"issues_events": {
+			Description: "Enable notifications for issues events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		// NOTE: the SetSlackServiceOptions of go-gitlab have no `job_events`, thus it can only be read.
+		"job_events": {
+			Description: "Whether notifications for job events are enabled. It can't be configured, because go-gitlab doesn't support setting it.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"merge_request_channel": {
+			Description: "The name of the channel to receive merge request events notifications.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"merge_requests_events": {
+			Description: "Enable notifications for merge requests events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"note_channel": {
+			Description: "The name of the channel to receive note events notifications.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"note_events": {
+			Description: "Enable notifications for note events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"pipeline_channel": {
+			Description: "The name of the channel to receive pipeline events notifications.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"pipeline_events": {
+			Description: "Enable notifications for pipeline events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"push_channel": {
+			Description: "The name of the channel to receive push events notifications.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"push_events": {
+			Description: "Enable notifications for push events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"tag_push_channel": {
+			Description: "The name of the channel to receive tag push events notifications.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"tag_push_events": {
+			Description: "Enable notifications for tag push events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"wiki_page_channel": {
+			Description: "The name of the channel to receive wiki page events notifications.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"wiki_page_events": {
+			Description: "Enable notifications for wiki page events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+	}
+}
@@ -50,6 +50,13 @@ func gitlabProjectVariableGetSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Default:     false,
 		},
+		"hidden": {
+			Description: "If set to `true`, the value of the variable is hidden in job logs and can never be revealed again, not even through the API. Requires `masked` to be `true` and can only be set when creating the variable. Because the value can't be read back, changes made to it outside of Terraform are not detected. Requires GitLab 17.4 or newer. Defaults to `false`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    true,
+		},
 		"environment_scope": {
 			Description: "The environment scope of the variable. Defaults to all environment (`*`). Note that in Community Editions of Gitlab, values other than `*` will cause inconsistent plans.",
 			Type:        schema.TypeString,
@@ -69,6 +76,7 @@ func gitlabProjectVariableToStateMap(project string, variable *gitlab.ProjectVar
 	stateMap["variable_type"] = variable.VariableType
 	stateMap["protected"] = variable.Protected
 	stateMap["masked"] = variable.Masked
+	stateMap["hidden"] = variable.Hidden
 	stateMap["environment_scope"] = variable.EnvironmentScope
 	return stateMap
 }
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"regexp"
@@ -279,6 +280,10 @@ func parseVersionMajorMinor(version string) (int, int, error) {
 }
 
 func is404(err error) bool {
+	// NOTE: go-gitlab returns gitlab.ErrNotFound instead of a *gitlab.ErrorResponse for 404 responses.
+	if errors.Is(err, gitlab.ErrNotFound) {
+		return true
+	}
 	if errResponse, ok := err.(*gitlab.ErrorResponse); ok &&
 		errResponse.Response != nil &&
 		errResponse.Response.StatusCode == 404 {
@@ -287,6 +292,17 @@ func is404(err error) bool {
 	return false
 }
 
+// transferRedirectWarning returns a warning about the redirects GitLab creates
+// for the previous path of a transferred project or group.
+// NOTE: it's only returned by the update which transfers it, because a CustomizeDiff can't return warnings.
+func transferRedirectWarning(kind string, previousPath string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("The %s has been transferred", kind),
+		Detail:   fmt.Sprintf("GitLab redirects the previous path %q to the new location until another group or project uses this path. Update any git remotes, CI/CD configurations and integrations which still reference the previous path.", previousPath),
+	}
+}
+
 func isCurrentUserAdmin(client *gitlab.Client) (bool, error) {
 	currentUser, _, err := client.Users.CurrentUser()
 	if err != nil {
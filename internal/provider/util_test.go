@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"errors"
+	"net/http"
 	"testing"
 
 	gitlab "github.com/xanzy/go-gitlab"
@@ -69,3 +71,18 @@ func TestValidateURLFunc(t *testing.T) {
 		}
 	}
 }
+
+func TestIs404(t *testing.T) {
+	if !is404(gitlab.ErrNotFound) {
+		t.Fatal("expected gitlab.ErrNotFound to be a 404")
+	}
+	if !is404(&gitlab.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}) {
+		t.Fatal("expected an error response with status 404 to be a 404")
+	}
+	if is404(&gitlab.ErrorResponse{Response: &http.Response{StatusCode: http.StatusForbidden}}) {
+		t.Fatal("expected an error response with status 403 not to be a 404")
+	}
+	if is404(errors.New("404 Not Found")) {
+		t.Fatal("expected an arbitrary error not to be a 404")
+	}
+}
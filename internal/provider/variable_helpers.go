@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"log"
 	"net/http"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -11,12 +13,62 @@ import (
 	"github.com/xanzy/go-gitlab"
 )
 
+const maskedVariableRequirementsErrorMessage = "Invalid value for a masked variable. Check the masked variable requirements: https://docs.gitlab.com/ee/ci/variables/#masked-variable-requirements"
+
+var (
+	// maskedVariableValueRegexp mirrors the masking requirements enforced by GitLab for masked variables.
+	maskedVariableValueRegexp = regexp.MustCompile(`^[a-zA-Z0-9_+=/@:.~-]{8,}$`)
+	// maskedRawVariableValueRegexp mirrors the relaxed masking requirements for masked variables which are not expanded.
+	maskedRawVariableValueRegexp = regexp.MustCompile(`^\S{8,}$`)
+)
+
+// validateMaskedVariableValueDiff validates the value of a masked variable during plan,
+// so that an apply doesn't fail half way through because of an invalid value.
+// Hidden variables are masked variables, too, thus they must also be configured as masked.
+// Existing variables are only validated if one of the validated attributes changes, so that variables
+// accepted by GitLab don't fail to plan. The error of the GitLab API, see augmentVariableClientError,
+// remains authoritative for the requirements which aren't mirrored here.
+func validateMaskedVariableValueDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("value", "masked", "raw", "hidden") {
+		return nil
+	}
+
+	masked := d.Get("masked").(bool)
+	if hidden, ok := d.GetOk("hidden"); ok && hidden.(bool) && !masked && d.NewValueKnown("masked") {
+		return errors.New("`hidden` variables must also be `masked`")
+	}
+
+	if !masked || !d.NewValueKnown("value") {
+		return nil
+	}
+
+	value := d.Get("value").(string)
+	valueRegexp := maskedVariableValueRegexp
+	if raw, ok := d.GetOk("raw"); ok && raw.(bool) {
+		valueRegexp = maskedRawVariableValueRegexp
+	}
+	if !valueRegexp.MatchString(value) {
+		return errors.New(maskedVariableRequirementsErrorMessage)
+	}
+	return nil
+}
+
+// redactListedVariableValue removes the value from the state map of a listed variable if it's masked or hidden.
+// The values of masked and hidden variables are secrets, thus the data sources listing variables don't expose them,
+// contrary to the data sources of a single variable, which are explicitly requested.
+func redactListedVariableValue(stateMap map[string]interface{}, masked, hidden bool) map[string]interface{} {
+	if masked || hidden {
+		stateMap["value"] = ""
+	}
+	return stateMap
+}
+
 func augmentVariableClientError(d *schema.ResourceData, err error) diag.Diagnostics {
 	// Masked values will commonly error due to their strict requirements, and the error message from the GitLab API is not very informative,
 	// so we return a custom error message in this case.
 	if d.Get("masked").(bool) && isInvalidValueError(err) {
 		log.Printf("[ERROR] %v", err)
-		return diag.Errorf("Invalid value for a masked variable. Check the masked variable requirements: https://docs.gitlab.com/ee/ci/variables/#masked-variable-requirements")
+		return diag.Errorf(maskedVariableRequirementsErrorMessage)
 	}
 
 	if err != nil {
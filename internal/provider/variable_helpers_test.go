@@ -0,0 +1,25 @@
+package provider
+
+import "testing"
+
+func TestRedactListedVariableValue(t *testing.T) {
+	cases := []struct {
+		masked, hidden bool
+		expected       string
+	}{
+		{masked: false, hidden: false, expected: "value"},
+		{masked: true, hidden: false, expected: ""},
+		{masked: true, hidden: true, expected: ""},
+		{masked: false, hidden: true, expected: ""},
+	}
+
+	for _, c := range cases {
+		stateMap := redactListedVariableValue(map[string]interface{}{"key": "key", "value": "value"}, c.masked, c.hidden)
+		if stateMap["value"] != c.expected {
+			t.Errorf("got value %q for masked=%t and hidden=%t, expected %q", stateMap["value"], c.masked, c.hidden, c.expected)
+		}
+		if stateMap["key"] != "key" {
+			t.Errorf("expected the key to be kept for masked=%t and hidden=%t", c.masked, c.hidden)
+		}
+	}
+}